@@ -1,10 +1,13 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"time"
 
 	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/lsp"
+	"github.com/champii/og/lib/og"
 
 	"github.com/urfave/cli"
 )
@@ -12,21 +15,29 @@ import (
 func parseArgs(done func(*common.OgConfig)) {
 	cli_ := setupCli()
 
+	args, runArgs := splitArgs(os.Args)
+
 	cli_.Action = func(c *cli.Context) error {
 		options := common.OgConfig{
-			Blocks:      c.Bool("b"),
-			Dirty:       c.Bool("d"),
-			Print:       c.Bool("p"),
-			Ast:         c.Bool("a"),
-			SimpleAst:   c.Bool("s"),
-			Quiet:       c.Bool("q"),
-			Workers:     c.Int("w"),
-			OutPath:     c.String("o"),
-			Interpreter: c.Bool("i"),
-			NoBuild:     c.Bool("n"),
-			Run:         c.Bool("r"),
-			Force:       c.Bool("f"),
-			Paths:       []string(c.Args()),
+			Blocks:         c.Bool("b"),
+			Dirty:          c.Bool("d"),
+			Print:          c.Bool("p"),
+			Ast:            c.Bool("a"),
+			SimpleAst:      c.Bool("s"),
+			Quiet:          c.Bool("q"),
+			Workers:        c.Int("w"),
+			OutPath:        c.String("o"),
+			Interpreter:    c.Bool("i"),
+			NoBuild:        c.Bool("n"),
+			Run:            c.Bool("r"),
+			Force:          c.Bool("f"),
+			LineDirectives: c.Bool("line-directives"),
+			NoCheck:        c.Bool("no-check"),
+			Check:          c.Bool("check"),
+			Generics:       c.Bool("generics"),
+			Watch:          c.Bool("watch"),
+			RunArgs:        runArgs,
+			Paths:          []string(c.Args()),
 		}
 
 		done(&options)
@@ -34,7 +45,77 @@ func parseArgs(done func(*common.OgConfig)) {
 		return nil
 	}
 
-	cli_.Run(os.Args)
+	cli_.Commands = []cli.Command{
+		{
+			Name:  "lsp",
+			Usage: "Start a language server on stdin/stdout",
+			Action: func(c *cli.Context) error {
+				server := lsp.NewServer(os.Stdin, os.Stdout)
+				server.Version = cli_.Version
+
+				return server.Run()
+			},
+		},
+		{
+			Name:      "fmt",
+			Usage:     "Format Og sources",
+			ArgsUsage: "[paths...]",
+			Flags: []cli.Flag{
+				cli.BoolFlag{
+					Name:  "w",
+					Usage: "Write the result to the source files",
+				},
+				cli.BoolFlag{
+					Name:  "l",
+					Usage: "List the files that are not formatted",
+				},
+				cli.BoolFlag{
+					Name:  "d",
+					Usage: "Print the diffs instead of the formatted sources",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				command := og.FormatCommand{
+					Write: c.Bool("w"),
+					List:  c.Bool("l"),
+					Diff:  c.Bool("d"),
+				}
+
+				if err := command.Run([]string(c.Args())); err != nil {
+					common.Print = common.NewPrinter(&common.OgConfig{Quiet: true})
+					common.Print.Errors(err)
+					os.Exit(1)
+				}
+
+				return nil
+			},
+		},
+		{
+			Name:  "clean",
+			Usage: "Remove the compilation cache",
+			Action: func(c *cli.Context) error {
+				if err := og.CleanCache(); err != nil {
+					fmt.Println(err)
+					os.Exit(1)
+				}
+
+				return nil
+			},
+		},
+	}
+
+	cli_.Run(args)
+}
+
+// The arguments after `--` are given to the binary run with `-r`
+func splitArgs(args []string) ([]string, []string) {
+	for i, arg := range args {
+		if arg == "--" {
+			return args[:i], args[i+1:]
+		}
+	}
+
+	return args, nil
 }
 
 func setupCli() *cli.App {
@@ -45,15 +126,21 @@ VERSION:
 	{{.Version}}
 
 USAGE:
-	{{if .VisibleFlags}}{{.HelpName}} [options] [folders...|files...]
+	{{if .VisibleFlags}}{{.HelpName}} [options] [folders...|files...] [-- arguments...]
+	{{.HelpName}} command
 
 	If a Print argument (-p, -b, -d, -a) is given, no write on disk is done.
 
-	If run without files, it will compile and build '.'{{end}}
+	If run without files, it will compile and build '.'
+
+	With -r, the arguments after '--' are given to the binary{{end}}
 	{{if len .Authors}}
 AUTHOR:
 	{{range .Authors}}{{ . }}{{end}}
 	{{end}}{{if .Commands}}
+COMMANDS:
+	{{range .VisibleCommands}}{{join .Names ", "}}{{"\t"}}{{.Usage}}
+	{{end}}
 OPTIONS:
 	{{range .VisibleFlags}}{{.}}
 	{{end}}{{end}}{{if .Copyright }}
@@ -77,7 +164,7 @@ COPYRIGHT:
 	app := cli.NewApp()
 
 	app.Name = "Oglang"
-	app.Version = "v0.7.2"
+	app.Version = common.Version
 	app.Compiled = time.Now()
 
 	app.Usage = "Golang on steroids"
@@ -85,7 +172,7 @@ COPYRIGHT:
 	app.Flags = []cli.Flag{
 		cli.BoolFlag{
 			Name:  "r, run",
-			Usage: "Run the binary",
+			Usage: "Run the binary, with the arguments given after '--'",
 		},
 		cli.StringFlag{
 			Name:  "o, out",
@@ -111,7 +198,7 @@ COPYRIGHT:
 		},
 		cli.BoolFlag{
 			Name:  "b, blocks",
-			Usage: "Print the file after it goes to preprocessor.",
+			Usage: "Print the file with the blocks found by the lexer",
 		},
 		cli.BoolFlag{
 			Name:  "a, ast",
@@ -123,7 +210,7 @@ COPYRIGHT:
 		},
 		cli.BoolFlag{
 			Name:  "i, interpreter",
-			Usage: "Run a small interpreter (ALPHA)",
+			Usage: "Run an interactive interpreter",
 		},
 		cli.BoolFlag{
 			Name:  "q, quiet",
@@ -133,6 +220,26 @@ COPYRIGHT:
 			Name:  "n, no-build",
 			Usage: "Don't run 'go build'",
 		},
+		cli.BoolFlag{
+			Name:  "watch",
+			Usage: "Recompile the changed files and rebuild, until interrupted",
+		},
+		cli.BoolFlag{
+			Name:  "line-directives",
+			Usage: "Add '//line' directives pointing to the Og sources",
+		},
+		cli.BoolFlag{
+			Name:  "check",
+			Usage: "Type check the generated Go without writing it nor running 'go build'",
+		},
+		cli.BoolFlag{
+			Name:  "generics",
+			Usage: "Compile the templates to Go type parameters (Go 1.18+) instead of a copy for each type",
+		},
+		cli.BoolFlag{
+			Name:  "no-check",
+			Usage: "Skip the checks of the names, the assignments and the calls",
+		},
 	}
 
 	app.UsageText = "og [options] [folders|files]"
@@ -2,7 +2,6 @@ package ast
 
 import (
 	"github.com/champii/og/lib/common"
-	"os"
 	"path"
 	"strings"
 )
@@ -25,8 +24,11 @@ func (this SourceFile) Eval() string {
 	for _, t := range this.TopLevels {
 		res += t.Eval() + "\n"
 	}
-	return res
+	// The comments after the last declaration
+	return res + this.WithComments("")
 }
+
+// alias -> import path
 func (this SourceFile) GetImports() map[string]string {
 	res := make(map[string]string)
 	if this.Import == nil {
@@ -34,13 +36,14 @@ func (this SourceFile) GetImports() map[string]string {
 	}
 	for _, imp := range this.Import.Items {
 		if imp.Path[0] != '"' {
+			// ignore system paths
 			continue
 		}
 		alias := imp.Alias
 		if imp.Alias == "" {
 			alias = path.Base(imp.Path[1 : len(imp.Path)-2])
 		}
-		res[alias] = path.Join(os.Getenv("GOPATH"), "src", imp.Path[1:len(imp.Path)-2])
+		res[alias] = imp.Path[1 : len(imp.Path)-2]
 	}
 	return res
 }
@@ -51,7 +54,7 @@ type Package struct {
 }
 
 func (this Package) Eval() string {
-	return "package " + this.Name
+	return this.WithComments("package " + this.Name)
 }
 
 type Import struct {
@@ -64,7 +67,7 @@ func (this Import) Eval() string {
 	for _, i := range this.Items {
 		res += i.Eval()
 	}
-	return res + "\n)\n"
+	return this.WithComments(res + "\n)\n")
 }
 
 type ImportSpec struct {
@@ -74,11 +77,11 @@ type ImportSpec struct {
 }
 
 func (this ImportSpec) Eval() string {
+	res := this.Path
 	if len(this.Alias) > 0 {
-		return this.Alias + " " + this.Path
-	} else {
-		return this.Path
+		res = this.Alias + " " + this.Path
 	}
+	return this.WithComments(strings.TrimRight(res, "\n")) + "\n"
 }
 
 type TopLevel struct {
@@ -89,13 +92,20 @@ type TopLevel struct {
 }
 
 func (this TopLevel) Eval() string {
+	res := ""
 	if this.Declaration != nil {
-		return this.Declaration.Eval()
+		res = this.Declaration.Eval()
 	} else if this.FunctionDecl != nil {
-		return this.FunctionDecl.Eval()
+		res = this.FunctionDecl.Eval()
 	} else {
-		return this.MethodDecl.Eval()
+		res = this.MethodDecl.Eval()
+	}
+	/* gofmt would put a blank line before a marker in front of the declaration */
+	idx := strings.Index(res, " ")
+	if idx < 0 {
+		return this.WithComments(res)
 	}
+	return this.WithComments(res[:idx+1] + this.Marker() + res[idx+1:len(res)])
 }
 
 type Declaration struct {
@@ -103,6 +113,7 @@ type Declaration struct {
 	ConstDecl *ConstDecl
 	TypeDecl  *TypeDecl
 	VarDecl   *VarDecl
+	EnumDecl  *EnumDecl
 }
 
 func (this Declaration) Eval() string {
@@ -110,6 +121,8 @@ func (this Declaration) Eval() string {
 		return this.ConstDecl.Eval()
 	} else if this.TypeDecl != nil {
 		return this.TypeDecl.Eval()
+	} else if this.EnumDecl != nil {
+		return this.EnumDecl.Eval()
 	} else {
 		return this.VarDecl.Eval()
 	}
@@ -121,13 +134,25 @@ type ConstDecl struct {
 }
 
 func (this ConstDecl) Eval() string {
-	res := "const ("
+	res := "const (\n"
 	for _, spec := range this.ConstSpecs {
 		res += spec.Eval() + "\n"
 	}
 	return res + ")"
 }
 
+// An `enum`, that has a value for each of its constants
+type EnumDecl struct {
+	*common.Node
+	Name       string
+	ConstSpecs []*ConstSpec
+	Fmt        string // Name of the imported fmt package
+}
+
+func (this EnumDecl) Eval() string {
+	return this.lower()
+}
+
 type ConstSpec struct {
 	*common.Node
 	IdentifierList *IdentifierList
@@ -146,7 +171,7 @@ func (this ConstSpec) Eval() string {
 	if this.ExpressionList != nil {
 		res += " = " + this.ExpressionList.Eval()
 	}
-	return res
+	return this.WithComments(res)
 }
 
 type ExpressionList struct {
@@ -186,6 +211,7 @@ type TypeDecl struct {
 	TypeSpecs     []*TypeSpec
 	StructType    *StructType
 	InterfaceType *InterfaceType
+	DataType      *DataType
 }
 
 func (this TypeDecl) Eval() string {
@@ -206,6 +232,8 @@ func (this TypeDecl) Eval() string {
 		res += this.StructType.Eval()
 	} else if this.InterfaceType != nil {
 		res += this.InterfaceType.Eval()
+	} else if this.DataType != nil {
+		res += this.DataType.Eval()
 	}
 	return res
 }
@@ -289,7 +317,7 @@ type VarDecl struct {
 }
 
 func (this VarDecl) Eval() string {
-	res := "var ("
+	res := "var (\n"
 	for _, spec := range this.VarSpecs {
 		res += spec.Eval() + "\n"
 	}
@@ -318,7 +346,7 @@ func (this VarSpec) Eval() string {
 	if this.Statement != nil {
 		res += "=" + this.Statement.Eval()
 	}
-	return res
+	return this.WithComments(res)
 }
 
 type Block struct {
@@ -329,31 +357,149 @@ type Block struct {
 func (this Block) Eval() string {
 	res := "{\n"
 	for _, spec := range this.Statements {
-		res += spec.Eval() + "\n"
+		res += spec.Marker() + spec.Eval() + "\n"
 	}
 	return res + "}"
 }
 func (this *Block) AddReturn() {
+	this.endWith(returnOf)
+}
+
+// Appends the value of the block to the slice `name`
+func (this *Block) AddCollect(name string) {
+	this.endWith(func(stmt *Statement) *Statement {
+		return collectOf(name, stmt)
+	})
+}
+
+// Calls `f` on the value of the block, in each of its branches
+func (this *Block) EachValue(f func(*Expression)) {
+	this.endWith(func(stmt *Statement) *Statement {
+		return keepValue(f, stmt)
+	})
+}
+
+// Replaces the statement that gives the value of the block, in each of its
+// branches
+func (this *Block) endWith(f func(*Statement) *Statement) {
+	if len(this.Statements) == 0 {
+		return
+	}
 	last := this.Statements[len(this.Statements)-1]
-	if last.ReturnStmt == nil {
-		if last.IfStmt != nil {
-			last.IfStmt.AddReturn()
-		}
-		if last.SimpleStmt != nil {
-			this.Statements[len(this.Statements)-1] = &Statement{
-				Node: common.NewNodeNoCtx(&Statement{}),
-				ReturnStmt: &ReturnStmt{
-					Node: common.NewNodeNoCtx(&ReturnStmt{}),
-					Expressions: &ExpressionList{
-						Node:        common.NewNodeNoCtx(&ExpressionList{}),
-						Expressions: []*Expression{last.SimpleStmt.Expression},
-					},
-				},
-			}
-		}
+	if last.IfStmt != nil {
+		last.IfStmt.endWith(f)
+	}
+	if last.SwitchStmt != nil {
+		last.SwitchStmt.endWith(f)
+	}
+	if last.SelectStmt != nil {
+		last.SelectStmt.endWith(f)
+	}
+	if last.Block != nil {
+		last.Block.endWith(f)
+	}
+	if last.SimpleStmt != nil && last.SimpleStmt.Expression != nil {
+		this.Statements[len(this.Statements)-1] = f(last)
+	}
+}
+
+// Gives the value of `stmt` to `f`, and keeps it
+func keepValue(f func(*Expression), stmt *Statement) *Statement {
+	f(stmt.SimpleStmt.Expression)
+	return stmt
+}
+
+// `return value`, that keeps the position of the replaced statement for the
+// source map
+func returnOf(stmt *Statement) *Statement {
+	res := &Statement{
+		Node: stmt.Node,
+		ReturnStmt: &ReturnStmt{
+			Node: common.NewNodeNoCtx(&ReturnStmt{}),
+			Expressions: &ExpressionList{
+				Node:        common.NewNodeNoCtx(&ExpressionList{}),
+				Expressions: []*Expression{stmt.SimpleStmt.Expression},
+			},
+		},
+	}
+	return res
+}
+
+// `name = append(name, value)`
+func collectOf(name string, stmt *Statement) *Statement {
+	call := &PrimaryExpr{
+		Node:        common.NewNodeNoCtx(&PrimaryExpr{}),
+		PrimaryExpr: namePrimary("append"),
+		SecondaryExpr: &SecondaryExpr{
+			Node: common.NewNodeNoCtx(&SecondaryExpr{}),
+			Arguments: &Arguments{
+				Node:        common.NewNodeNoCtx(&Arguments{}),
+				Expressions: expressions(nameExpression(name), stmt.SimpleStmt.Expression),
+			},
+		},
+	}
+	assignment := &Assignment{
+		Node:  common.NewNodeNoCtx(&Assignment{}),
+		Left:  expressions(nameExpression(name)),
+		Op:    "=",
+		Right: expressions(primaryExpression(call)),
+	}
+	res := &Statement{
+		Node: stmt.Node,
+		SimpleStmt: &SimpleStmt{
+			Node:       common.NewNodeNoCtx(&SimpleStmt{}),
+			Assignment: assignment,
+		},
+	}
+	return res
+}
+func namePrimary(name string) *PrimaryExpr {
+	res := &PrimaryExpr{
+		Node: common.NewNodeNoCtx(&PrimaryExpr{}),
+		Operand: &Operand{
+			Node: common.NewNodeNoCtx(&Operand{}),
+			OperandName: &OperandName{
+				Node: common.NewNodeNoCtx(&OperandName{}),
+				Name: name,
+			},
+		},
+	}
+	return res
+}
+func primaryExpression(primary *PrimaryExpr) *Expression {
+	res := &Expression{
+		Node: common.NewNodeNoCtx(&Expression{}),
+		UnaryExpr: &UnaryExpr{
+			Node:        common.NewNodeNoCtx(&UnaryExpr{}),
+			PrimaryExpr: primary,
+		},
+	}
+	return res
+}
+func nameExpression(name string) *Expression {
+	return primaryExpression(namePrimary(name))
+}
+func expressions(exprs ...*Expression) *ExpressionList {
+	return &ExpressionList{
+		Node:        common.NewNodeNoCtx(&ExpressionList{}),
+		Expressions: exprs,
 	}
 }
 
+// The statements of a case, that are either a block or a list
+func endStatements(stmts []*Statement, node *common.Node, f func(*Statement) *Statement) []*Statement {
+	if len(stmts) == 1 && stmts[0].Block != nil {
+		stmts[0].Block.endWith(f)
+		return stmts
+	}
+	block := &Block{
+		Node:       node,
+		Statements: stmts,
+	}
+	block.endWith(f)
+	return block.Statements
+}
+
 type Statement struct {
 	*common.Node
 	SimpleStmt      *SimpleStmt
@@ -374,37 +520,39 @@ type Statement struct {
 }
 
 func (this Statement) Eval() string {
+	res := ""
 	if this.SimpleStmt != nil {
-		return this.SimpleStmt.Eval()
+		res = this.SimpleStmt.Eval()
 	} else if this.LabeledStmt != nil {
-		return this.LabeledStmt.Eval()
+		res = this.LabeledStmt.Eval()
 	} else if this.GoStmt != nil {
-		return this.GoStmt.Eval()
+		res = this.GoStmt.Eval()
 	} else if this.ReturnStmt != nil {
-		return this.ReturnStmt.Eval()
+		res = this.ReturnStmt.Eval()
 	} else if this.BreakStmt != nil {
-		return this.BreakStmt.Eval()
+		res = this.BreakStmt.Eval()
 	} else if this.ContinueStmt != nil {
-		return this.ContinueStmt.Eval()
+		res = this.ContinueStmt.Eval()
 	} else if this.GotoStmt != nil {
-		return this.GotoStmt.Eval()
+		res = this.GotoStmt.Eval()
 	} else if this.FallthroughStmt != nil {
-		return this.FallthroughStmt.Eval()
+		res = this.FallthroughStmt.Eval()
 	} else if this.IfStmt != nil {
-		return this.IfStmt.Eval()
+		res = this.IfStmt.Eval()
 	} else if this.SwitchStmt != nil {
-		return this.SwitchStmt.Eval()
+		res = this.SwitchStmt.Eval()
 	} else if this.SelectStmt != nil {
-		return this.SelectStmt.Eval()
+		res = this.SelectStmt.Eval()
 	} else if this.ForStmt != nil {
-		return this.ForStmt.Eval()
+		res = this.ForStmt.Eval()
 	} else if this.Block != nil {
-		return this.Block.Eval()
+		res = this.Block.Eval()
 	} else if this.DeferStmt != nil {
-		return this.DeferStmt.Eval()
+		res = this.DeferStmt.Eval()
 	} else {
-		return this.Declaration.Eval()
+		res = this.Declaration.Eval()
 	}
+	return this.WithComments(res)
 }
 
 type SimpleStmt struct {
@@ -473,6 +621,7 @@ type ShortVarDecl struct {
 	*common.Node
 	IdentifierList *IdentifierList
 	Expressions    *ExpressionList
+	Statement      *Statement // An `if`, a `switch`, a `select` or a `for`, that Returnable turns into a closure
 }
 
 func (this ShortVarDecl) Eval() string {
@@ -580,18 +729,30 @@ func (this IfStmt) Eval() string {
 	return res
 }
 func (this *IfStmt) AddReturn() {
+	this.endWith(returnOf)
+}
+func (this *IfStmt) endWith(f func(*Statement) *Statement) {
 	if this.Block != nil {
-		this.Block.AddReturn()
+		this.Block.endWith(f)
 	}
 	if this.IfStmt != nil {
-		this.IfStmt.AddReturn()
+		this.IfStmt.endWith(f)
 	}
 	if this.BlockElse != nil {
-		this.BlockElse.AddReturn()
+		this.BlockElse.endWith(f)
 	}
 }
 func (this *IfStmt) MakeReturnClosureStatement(t *Type) *Statement {
 	this.AddReturn()
+	return returnClosure(t, &Statement{
+		Node:   common.NewNodeNoCtx(&Statement{}),
+		IfStmt: this,
+	})
+}
+
+// `func() t { stmts }()`, where stmts return the value
+func returnClosure(t *Type, stmts ...*Statement) *Statement {
+	/* FIXME: Problem with large nested struct decl */
 	funcLit := &FunctionLit{
 		Node: common.NewNodeNoCtx(&FunctionLit{}),
 		Function: &Function{
@@ -608,12 +769,8 @@ func (this *IfStmt) MakeReturnClosureStatement(t *Type) *Statement {
 				},
 			},
 			Block: &Block{
-				Node: common.NewNodeNoCtx(&Block{}),
-				Statements: []*Statement{&Statement{
-					Node:   common.NewNodeNoCtx(&Statement{}),
-					IfStmt: this,
-				},
-				},
+				Node:       common.NewNodeNoCtx(&Block{}),
+				Statements: stmts,
 			},
 		},
 	}
@@ -642,20 +799,21 @@ func (this *IfStmt) MakeReturnClosureStatement(t *Type) *Statement {
 		Node:      common.NewNodeNoCtx(&Expression{}),
 		UnaryExpr: unary,
 	}
-	stmt := &Statement{
+	res := &Statement{
 		Node: common.NewNodeNoCtx(&Statement{}),
 		SimpleStmt: &SimpleStmt{
 			Node:       common.NewNodeNoCtx(&SimpleStmt{}),
 			Expression: expr,
 		},
 	}
-	return stmt
+	return res
 }
 
 type SwitchStmt struct {
 	*common.Node
 	ExprSwitchStmt *ExprSwitchStmt
 	TypeSwitchStmt *TypeSwitchStmt
+	MatchStmt      *MatchStmt
 }
 
 func (this SwitchStmt) Eval() string {
@@ -665,8 +823,32 @@ func (this SwitchStmt) Eval() string {
 	if this.TypeSwitchStmt != nil {
 		return this.TypeSwitchStmt.Eval()
 	}
+	if this.MatchStmt != nil {
+		return this.MatchStmt.Eval()
+	}
 	return ""
 }
+func (this *SwitchStmt) AddReturn() {
+	this.endWith(returnOf)
+}
+func (this *SwitchStmt) endWith(f func(*Statement) *Statement) {
+	if this.ExprSwitchStmt != nil {
+		this.ExprSwitchStmt.endWith(f)
+	}
+	if this.TypeSwitchStmt != nil {
+		this.TypeSwitchStmt.endWith(f)
+	}
+	if this.MatchStmt != nil {
+		this.MatchStmt.endWith(f)
+	}
+}
+func (this *SwitchStmt) MakeReturnClosureStatement(t *Type) *Statement {
+	this.AddReturn()
+	return returnClosure(t, &Statement{
+		Node:       common.NewNodeNoCtx(&Statement{}),
+		SwitchStmt: this,
+	})
+}
 
 type ExprSwitchStmt struct {
 	*common.Node
@@ -689,6 +871,11 @@ func (this ExprSwitchStmt) Eval() string {
 	}
 	return res + "}"
 }
+func (this *ExprSwitchStmt) endWith(f func(*Statement) *Statement) {
+	for _, clause := range this.ExprCaseClauses {
+		clause.Statements = endStatements(clause.Statements, clause.Node, f)
+	}
+}
 
 type ExprCaseClause struct {
 	*common.Node
@@ -726,6 +913,7 @@ type TypeSwitchStmt struct {
 	SimpleStmt      *SimpleStmt
 	TypeSwitchGuard *TypeSwitchGuard
 	TypeCaseClauses []*TypeCaseClause
+	Exhaustive      bool // Handles each variant of a data
 }
 
 func (this TypeSwitchStmt) Eval() string {
@@ -738,8 +926,16 @@ func (this TypeSwitchStmt) Eval() string {
 	for _, spec := range this.TypeCaseClauses {
 		res += spec.Eval() + "\n"
 	}
+	if this.Exhaustive {
+		res += unreachable + "\n"
+	}
 	return res + "}"
 }
+func (this *TypeSwitchStmt) endWith(f func(*Statement) *Statement) {
+	for _, clause := range this.TypeCaseClauses {
+		clause.Statements = endStatements(clause.Statements, clause.Node, f)
+	}
+}
 
 type TypeSwitchGuard struct {
 	*common.Node
@@ -790,6 +986,41 @@ func (this TypeSwitchCase) Eval() string {
 	return res
 }
 
+// A `match`, lowered to a switch by its Eval
+type MatchStmt struct {
+	*common.Node
+	Expression *Expression
+	Arms       []*MatchArm
+	Exhaustive bool // Matches each variant of a data
+}
+
+func (this MatchStmt) Eval() string {
+	return this.lower()
+}
+func (this *MatchStmt) AddReturn() {
+	this.endWith(returnOf)
+}
+func (this *MatchStmt) endWith(f func(*Statement) *Statement) {
+	for _, arm := range this.Arms {
+		arm.Statements = endStatements(arm.Statements, arm.Node, f)
+	}
+}
+
+type MatchArm struct {
+	*common.Node
+	Patterns   []*Pattern
+	Guard      *Expression
+	Statements []*Statement
+}
+
+// `_` when it has no Value nor Type
+type Pattern struct {
+	*common.Node
+	Value   *Expression   // Compared to the matched value
+	Type    string        // Dynamic type of the matched value
+	Fields  *LiteralValue // Field values of the matched struct
+	Binding string        // Name of the matched value in its arm
+}
 type SelectStmt struct {
 	*common.Node
 	CommClauses []*CommClause
@@ -802,6 +1033,21 @@ func (this SelectStmt) Eval() string {
 	}
 	return res + "}"
 }
+func (this *SelectStmt) AddReturn() {
+	this.endWith(returnOf)
+}
+func (this *SelectStmt) endWith(f func(*Statement) *Statement) {
+	for _, clause := range this.CommClauses {
+		clause.Block.endWith(f)
+	}
+}
+func (this *SelectStmt) MakeReturnClosureStatement(t *Type) *Statement {
+	this.AddReturn()
+	return returnClosure(t, &Statement{
+		Node:       common.NewNodeNoCtx(&Statement{}),
+		SelectStmt: this,
+	})
+}
 
 type CommClause struct {
 	*common.Node
@@ -878,6 +1124,62 @@ func (this ForStmt) Eval() string {
 	return res + this.Block.Eval()
 }
 
+// A loop without condition nor range only stops with a `break` or a `return`
+func (this ForStmt) Ends() bool {
+	if this.ForClause != nil {
+		return this.ForClause.Expression != nil
+	}
+	return this.Expression != nil || this.RangeClause != nil
+}
+
+// The loop that appends the value of each iteration to a slice of type `t`,
+// returned at its end
+func (this *ForStmt) Collect(t *Type) []*Statement {
+	this.Block.AddCollect(forValues)
+	spec := &VarSpec{
+		Node: common.NewNodeNoCtx(&VarSpec{}),
+		IdentifierList: &IdentifierList{
+			Node: common.NewNodeNoCtx(&IdentifierList{}),
+			List: []string{forValues},
+		},
+		Type: t,
+	}
+	decl := &Declaration{
+		Node: common.NewNodeNoCtx(&Declaration{}),
+		VarDecl: &VarDecl{
+			Node:     common.NewNodeNoCtx(&VarDecl{}),
+			VarSpecs: []*VarSpec{spec},
+		},
+	}
+	ret := &ReturnStmt{
+		Node:        common.NewNodeNoCtx(&ReturnStmt{}),
+		Expressions: expressions(nameExpression(forValues)),
+	}
+	res := []*Statement{
+		&Statement{
+			Node:        common.NewNodeNoCtx(&Statement{}),
+			Declaration: decl,
+		},
+		&Statement{
+			Node:    this.Node,
+			ForStmt: this,
+		},
+		&Statement{
+			Node:       common.NewNodeNoCtx(&Statement{}),
+			ReturnStmt: ret,
+		},
+	}
+	return res
+}
+func (this *ForStmt) MakeReturnClosureStatement(t *Type) *Statement {
+	return returnClosure(t, this.Collect(t)...)
+}
+
+// Name of the slice a collecting `for` appends to
+const (
+	forValues = "__og_for"
+)
+
 type ForClause struct {
 	*common.Node
 	LeftSimpleStmt  *SimpleStmt
@@ -903,12 +1205,14 @@ func (this ForClause) Eval() string {
 
 type RangeClause struct {
 	*common.Node
+	// Expressions *ExpressionList
 	IdentifierList *IdentifierList
 	Expression     *Expression
 }
 
 func (this RangeClause) Eval() string {
 	res := ""
+	// if @Expressions != nil => res += @Expressions.Eval()
 	if this.IdentifierList != nil {
 		res += this.IdentifierList.Eval()
 	}
@@ -1071,7 +1375,7 @@ func (this MethodSpec) Eval() string {
 		res += this.Result.Eval()
 	}
 	res += this.Type
-	return res
+	return this.WithComments(res)
 }
 
 type FunctionType struct {
@@ -1092,6 +1396,9 @@ type Signature struct {
 
 func (this Signature) Eval() string {
 	res := ""
+	if this.TemplateSpec != nil && this.TemplateSpec.Native {
+		res += this.TemplateSpec.TypeParams()
+	}
 	if this.Parameters != nil {
 		res += this.Parameters.Eval()
 	}
@@ -1103,7 +1410,9 @@ func (this Signature) Eval() string {
 
 type TemplateSpec struct {
 	*common.Node
-	Result *Result
+	Result      *Result
+	Constraints []string // The constraint of each type, "" when it has none
+	Native      bool     // Go type parameters instead of a copy for each usage
 }
 type Result struct {
 	*common.Node
@@ -1195,7 +1504,11 @@ type CompositeLit struct {
 }
 
 func (this CompositeLit) Eval() string {
-	return this.LiteralType.Eval() + this.LiteralValue.Eval()
+	res := this.LiteralType.Eval()
+	if this.TemplateSpec != nil && this.TemplateSpec.Native {
+		res += this.TemplateSpec.TypeArgs()
+	}
+	return res + this.LiteralValue.Eval()
 }
 
 type LiteralType struct {
@@ -1298,22 +1611,45 @@ type StructType struct {
 }
 
 func (this *StructType) Eval() string {
-	res := this.Name + " struct {\n"
+	name := this.Name
+	params := ""
+	if this.TemplateSpec != nil && this.TemplateSpec.Native {
+		name += this.TemplateSpec.TypeArgs()
+		params = this.TemplateSpec.TypeParams()
+	}
+	res := this.Name + params + " struct {\n"
 	methods := ""
 	for _, spec := range this.Fields {
 		if spec.InlineStructMethod != nil {
-			receiver := this.Name
+			receiver := name
 			if spec.InlineStructMethod.IsPointerReceiver {
 				receiver = "*" + receiver
 			}
-			methods += "\nfunc (this " + receiver + ")" + spec.InlineStructMethod.Eval()
+			methods += "\n" + spec.WithComments("func "+spec.Marker()+"(this "+receiver+")"+spec.InlineStructMethod.Eval())
 		} else {
-			res += spec.Eval() + "\n"
+			res += spec.Marker() + spec.Eval() + "\n"
 		}
 	}
 	return res + "}" + methods
 }
 
+// A `data`, a sealed type of its variants
+type DataType struct {
+	*common.Node
+	Name     string
+	Variants []*Variant
+}
+
+func (this DataType) Eval() string {
+	return this.lower()
+}
+
+// A variant of a `data`, that has no fields when it is a single name
+type Variant struct {
+	*common.Node
+	Name   string
+	Fields []*FieldDecl
+}
 type FieldDecl struct {
 	*common.Node
 	IdentifierList     *IdentifierList
@@ -1337,7 +1673,7 @@ func (this FieldDecl) Eval() string {
 	if this.Anonymous != nil {
 		res += this.Anonymous.Eval()
 	}
-	return res + " " + this.Tag
+	return this.WithComments(res + " " + this.Tag)
 }
 
 type IdentifierList struct {
@@ -1410,6 +1746,7 @@ type SecondaryExpr struct {
 	Slice         *Slice
 	TypeAssertion *TypeAssertion
 	Arguments     *Arguments
+	Bubble        bool // The `?` that returns the error of a call, removed by the desugar
 }
 
 func (this SecondaryExpr) Eval() string {
@@ -1481,6 +1818,9 @@ type Arguments struct {
 
 func (this Arguments) Eval() string {
 	res := "("
+	if this.TemplateSpec != nil && this.TemplateSpec.Native {
+		res = this.TemplateSpec.TypeArgs() + res
+	}
 	if this.Type != nil {
 		res += this.Type.Eval()
 		if this.Expressions != nil {
@@ -1527,7 +1867,8 @@ func (this *ReceiverType) Eval() string {
 
 type Expression struct {
 	*common.Node
-	UnaryExpr       *UnaryExpr
+	UnaryExpr *UnaryExpr
+	// FunctionLit     *FunctionLit
 	LeftExpression  *Expression
 	Op              string
 	RightExpression *Expression
@@ -1537,6 +1878,7 @@ func (this Expression) Eval() string {
 	if this.UnaryExpr != nil {
 		return this.UnaryExpr.Eval()
 	}
+	// if @FunctionLit != nil => return @FunctionLit.Eval()
 	return this.LeftExpression.Eval() + this.Op + this.RightExpression.Eval()
 }
 
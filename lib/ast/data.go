@@ -0,0 +1,71 @@
+package ast
+
+import (
+	"strings"
+	"unicode"
+)
+
+// The default case of a switch that handles each variant of a data, for Go
+// to see that it returns
+const (
+	unreachable = "default:\npanic(\"unreachable\")"
+)
+
+// A sealed interface, that only the variants implement with an unexported
+// method, and a struct and a constructor for each variant
+func (this DataType) lower() string {
+	marker := "is" + capitalize(this.Name)
+	res := this.Name + " interface {\n" + marker + "()\n}"
+	for _, variant := range this.Variants {
+		st := &StructType{
+			Node:   variant.Node,
+			Name:   variant.Name,
+			Fields: variant.Fields,
+		}
+		res += "\n" + variant.WithComments("type "+variant.Marker()+st.Eval())
+		res += "\nfunc (" + variant.Name + ") " + marker + "() {}"
+		res += "\n" + variant.constructor(this.Name)
+	}
+	return res
+}
+
+// `NewName`, or `newName` for an unexported variant
+func (this Variant) Constructor() string {
+	return prefixed("new", this.Name)
+}
+
+// `NewName(fields): Data`
+func (this Variant) constructor(data string) string {
+	params := []string{}
+	values := []string{}
+	for _, field := range this.Fields {
+		if field.InlineStructMethod != nil {
+			continue
+		}
+		if field.Anonymous != nil {
+			names := strings.Split(field.Anonymous.Type, ".")
+			name := names[len(names)-1]
+			params = append(params, name+" "+field.Anonymous.Eval())
+			values = append(values, name+": "+name)
+			continue
+		}
+		for _, name := range field.IdentifierList.List {
+			params = append(params, name+" "+field.Type.Eval())
+			values = append(values, name+": "+name)
+		}
+	}
+	return "func " + this.Constructor() + "(" + strings.Join(params, ", ") + ") " + data + " {\nreturn " + this.Name + "{" + strings.Join(values, ", ") + "}\n}"
+}
+
+// `PrefixName`, or `prefixName` when the name is not exported
+func prefixed(prefix, name string) string {
+	if unicode.IsUpper([]rune(name)[0]) {
+		return capitalize(prefix) + name
+	}
+	return prefix + capitalize(name)
+}
+func capitalize(name string) string {
+	runes := []rune(name)
+	runes[0] = unicode.ToUpper(runes[0])
+	return string(runes)
+}
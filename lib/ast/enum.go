@@ -0,0 +1,57 @@
+package ast
+
+import (
+	"strings"
+)
+
+// The names of the constants, but the blank ones
+func (this EnumDecl) Values() []string {
+	res := []string{}
+	for _, spec := range this.ConstSpecs {
+		for _, name := range spec.IdentifierList.List {
+			if name != "_" {
+				res = append(res, name)
+			}
+		}
+	}
+	return res
+}
+func (this EnumDecl) ParseFunc() string {
+	return prefixed("parse", this.Name)
+}
+func (this EnumDecl) ValuesFunc() string {
+	return this.Name + "Values"
+}
+
+// Typed constants that count from iota, unless a value is given, along with
+// the functions that print, parse and list them
+func (this EnumDecl) lower() string {
+	res := "type " + this.Name + " int\nconst (\n"
+	for i, spec := range this.ConstSpecs {
+		text := spec.IdentifierList.Eval()
+		if spec.ExpressionList != nil {
+			text += " " + this.Name + " = " + spec.ExpressionList.Eval()
+		} else if i == 0 {
+			text += " " + this.Name + " = iota"
+		}
+		res += spec.Marker() + spec.WithComments(text) + "\n"
+	}
+	res += ")\n"
+	values := this.Values()
+	pkg := this.Fmt
+	if pkg == "" {
+		pkg = "fmt"
+	}
+	// Two names can have the same value, the first one is printed
+	res += "func (this " + this.Name + ") String() string {\nswitch {\n"
+	for _, value := range values {
+		res += "case this == " + value + ":\nreturn \"" + value + "\"\n"
+	}
+	res += "}\nreturn " + pkg + ".Sprintf(\"" + this.Name + "(%d)\", int(this))\n}\n"
+	res += "func " + this.ParseFunc() + "(s string) (" + this.Name + ", error) {\nswitch s {\n"
+	for _, value := range values {
+		res += "case \"" + value + "\":\nreturn " + value + ", nil\n"
+	}
+	res += "}\nreturn 0, " + pkg + ".Errorf(\"unknown " + this.Name + " %q\", s)\n}\n"
+	return res + "func " + this.ValuesFunc() + "() []" + this.Name + " {\nreturn []" + this.Name + "{" + strings.Join(values, ", ") + "}\n}"
+}
@@ -0,0 +1,1244 @@
+package ast
+
+import (
+	"github.com/champii/og/lib/common"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Separators of the cells aligned once the whole file is printed
+const (
+	cellSep = "\x01"
+)
+const (
+	commentSep = "\x03"
+)
+
+// Stands for the new lines of raw strings, that must not be indented
+const (
+	rawNewline = "\x02"
+)
+
+// Import paths that can be written without quotes
+var (
+	simpleImport = regexp.MustCompile(`^"[a-zA-Z_][a-zA-Z0-9_]*"$`)
+)
+
+// Comment found in the source, the lexer drops them before the parser
+type Comment struct {
+	Line     int
+	Text     string
+	Trailing bool // Some code is before it on its line
+}
+
+// Printed item of a list (statements, fields, cases, ...) with its comments
+type formatItem struct {
+	Lead  string
+	Text  string
+	Trail string
+	Sep   string // Added at its end when the next item would continue its expression
+	line  int
+}
+
+// Prints an AST back to canonical Og, keeping the comments of its source
+type Formatter struct {
+	lines    []string
+	comments []*Comment
+	next     int
+	literals int // Depth of the literal types being printed
+}
+
+func (this *Formatter) Format(tree *SourceFile) string {
+	pkg := this.begin(tree.Package, true, "")
+	pkg.Text = "!" + tree.Package.Name
+	res := renderItems([]*formatItem{pkg}) + "\n"
+	if tree.Import != nil {
+		res += "\n" + this.imports(tree.Import) + "\n"
+	}
+	items := []*formatItem{}
+	for i, top := range tree.TopLevels {
+		item := this.begin(top, i == 0, ";")
+		item.Text = this.topLevel(top)
+		items = append(items, item)
+	}
+	if len(items) > 0 {
+		res += "\n" + renderItems(items) + "\n"
+	}
+	res += this.rest()
+	res = align(align(res, cellSep), commentSep)
+	return strings.Replace(strings.TrimRight(res, "\n"), rawNewline, "\n", -1) + "\n"
+}
+
+// Full line comments before `line`, with the blank lines of the source.
+// The first item of a block is never preceded by a blank line
+func (this *Formatter) lead(line int, first bool) string {
+	res := ""
+	for len(this.comments) > this.next && line > this.comments[this.next].Line {
+		comment := this.comments[this.next]
+		this.next++
+		if this.blankBefore(comment.Line) && (len(res) > 0 || !first) {
+			res += "\n"
+		}
+		res += comment.Text + "\n"
+	}
+	if this.blankBefore(line) && (len(res) > 0 || !first) {
+		res += "\n"
+	}
+	return res
+}
+
+// Comments at the end of `line`
+func (this *Formatter) trail(line int) string {
+	res := ""
+	for len(this.comments) > this.next && this.comments[this.next].Line == line {
+		if len(res) > 0 {
+			res += " "
+		}
+		res += this.comments[this.next].Text
+		this.next++
+	}
+	return res
+}
+
+// Comments after the last node
+func (this *Formatter) rest() string {
+	res := ""
+	for len(this.comments) > this.next {
+		comment := this.comments[this.next]
+		this.next++
+		if this.blankBefore(comment.Line) {
+			res += "\n"
+		}
+		res += comment.Text + "\n"
+	}
+	return res
+}
+func (this Formatter) blankBefore(line int) bool {
+	return line >= 2 && len(this.lines) >= line-1 && len(strings.TrimSpace(this.lines[line-2])) == 0
+}
+
+// Takes the comments of a node before printing it, as its children take theirs
+func (this *Formatter) begin(node common.INode, first bool, sep string) *formatItem {
+	return &formatItem{
+		Lead:  this.lead(node.Line(), first),
+		Trail: this.trail(node.Line()),
+		Sep:   sep,
+		line:  node.Line(),
+	}
+}
+
+// Comments after the last item of a block, as indented as its items
+func (this *Formatter) tail(items []*formatItem) []*formatItem {
+	if len(items) == 0 || items[0].line < 1 || items[0].line > len(this.lines) {
+		return items
+	}
+	margin := sourceIndent(this.lines[items[0].line-1])
+	res := ""
+	for len(this.comments) > this.next && margin > 0 {
+		comment := this.comments[this.next]
+		if comment.Trailing || margin > sourceIndent(this.lines[comment.Line-1]) || this.leaves(items[len(items)-1].line, comment.Line, margin) {
+			break
+		}
+		this.next++
+		if this.blankBefore(comment.Line) {
+			res += "\n"
+		}
+		res += comment.Text + "\n"
+	}
+	if len(res) == 0 {
+		return items
+	}
+	return append(items, &formatItem{Text: strings.TrimSuffix(res, "\n")})
+}
+
+// A line of code less indented than `margin` comes between `from` and `to`
+func (this *Formatter) leaves(from, to, margin int) bool {
+	for i := from; to > i; i++ {
+		line := strings.TrimSpace(this.lines[i-1])
+		if len(line) > 0 && line[0] != '#' && !strings.HasPrefix(line, "//") && !strings.HasPrefix(line, "/*") && margin > sourceIndent(this.lines[i-1]) {
+			return true
+		}
+	}
+	return false
+}
+
+// Imports are grouped in a block, the standard library first and cgo last
+func (this *Formatter) imports(imp *Import) string {
+	res := this.lead(imp.Line(), true) + "import\n"
+	std := []*formatItem{}
+	others := []*formatItem{}
+	cgo := []*formatItem{}
+	for _, spec := range imp.Items {
+		item := this.begin(spec, true, "")
+		item.Lead = strings.TrimLeft(item.Lead, "\n")
+		item.Text = importSpec(spec)
+		if importPath(item.Text) == "C" {
+			cgo = append(cgo, item)
+		} else if isStdImport(spec.Path) {
+			std = append(std, item)
+		} else {
+			others = append(others, item)
+		}
+	}
+	groups := []string{}
+	// `C` comes last, with its preamble
+	for _, group := range [][]*formatItem{
+		std,
+		others,
+		cgo,
+	} {
+		if len(group) > 0 {
+			sortImports(group)
+			groups = append(groups, indent(renderItems(group)))
+		}
+	}
+	return res + strings.Join(groups, "\n\n")
+}
+func (this *Formatter) topLevel(top *TopLevel) string {
+	if top.Declaration != nil {
+		return this.declaration(top.Declaration)
+	}
+	if top.FunctionDecl != nil {
+		return this.functionDecl(top.FunctionDecl)
+	}
+	return this.methodDecl(top.MethodDecl)
+}
+func (this *Formatter) declaration(d *Declaration) string {
+	if d.EnumDecl != nil {
+		items := []*formatItem{}
+		for i, spec := range d.EnumDecl.ConstSpecs {
+			item := this.begin(spec, i == 0, "")
+			item.Text = this.valueSpec(spec.IdentifierList, nil, spec.ExpressionList)
+			items = append(items, item)
+		}
+		return withItems("enum "+d.EnumDecl.Name, this.tail(items))
+	}
+	if d.ConstDecl != nil {
+		specs := []string{}
+		for _, spec := range d.ConstDecl.ConstSpecs {
+			specs = append(specs, this.valueSpec(spec.IdentifierList, spec.Type, spec.ExpressionList))
+		}
+		return "const " + group(specs)
+	}
+	if d.VarDecl != nil {
+		specs := []string{}
+		for _, spec := range d.VarDecl.VarSpecs {
+			text := this.valueSpec(spec.IdentifierList, spec.Type, spec.ExpressionList)
+			if spec.Statement != nil {
+				text += " = " + flat(this.statement(spec.Statement))
+			}
+			specs = append(specs, text)
+		}
+		return "var " + group(specs)
+	}
+	return this.typeDecl(d.TypeDecl)
+}
+func (this *Formatter) valueSpec(idents *IdentifierList, t *Type, exprs *ExpressionList) string {
+	res := identifiers(idents)
+	if t != nil {
+		res += " " + this.typ(t, false)
+	}
+	if exprs != nil {
+		res += " = " + this.expressions(exprs)
+	}
+	return res
+}
+func (this *Formatter) typeDecl(d *TypeDecl) string {
+	if d.StructType != nil {
+		return this.structType(d.StructType, true)
+	}
+	if d.InterfaceType != nil {
+		return this.interfaceType(d.InterfaceType, true)
+	}
+	if d.DataType != nil {
+		return this.dataType(d.DataType)
+	}
+	specs := []string{}
+	for _, spec := range d.TypeSpecs {
+		specs = append(specs, spec.Name+" "+this.typ(spec.Type, len(d.TypeSpecs) == 1))
+	}
+	return "type " + group(specs)
+}
+func (this *Formatter) functionDecl(decl *FunctionDecl) string {
+	if decl.Function != nil {
+		return decl.Name + this.function(decl.Function)
+	}
+	return decl.Name + this.signature(decl.Signature)
+}
+func (this *Formatter) methodDecl(decl *MethodDecl) string {
+	res := decl.Receiver.Package + "::"
+	if decl.Receiver.IsPointerReceiver {
+		res += "*"
+	}
+	res += decl.Receiver.Method
+	if decl.Function != nil {
+		return res + this.function(decl.Function)
+	}
+	return res + this.signature(decl.Signature)
+}
+func (this *Formatter) function(fun *Function) string {
+	return this.signature(fun.Signature) + " ->" + this.body(fun.Block)
+}
+
+// A one-liner body stays on the line of its function
+func (this *Formatter) body(block *Block) string {
+	if isOneLiner(block) {
+		text := this.statement(block.Statements[0])
+		if !strings.Contains(text, "\n") {
+			return " " + flat(text)
+		}
+		return "\n" + indent(text)
+	}
+	return "\n" + indent(this.statements(block.Statements))
+}
+
+// Body of an `if` or a `select` case, after `=>` when it is a one-liner
+func (this *Formatter) branch(block *Block, arrow string) string {
+	if isOneLiner(block) {
+		text := this.statement(block.Statements[0])
+		if !strings.Contains(text, "\n") {
+			return cellSep + "=> " + flat(text)
+		}
+		return arrow + "\n" + indent(text)
+	}
+	return arrow + "\n" + indent(this.statements(block.Statements))
+}
+func (this *Formatter) statements(stmts []*Statement) string {
+	items := []*formatItem{}
+	for _, stmt := range stmts {
+		if stmt.SimpleStmt != nil && stmt.SimpleStmt.EmptyStmt {
+			continue
+		}
+		item := this.begin(stmt, len(items) == 0, ";")
+		// A bare block is opened by the line before it
+		if stmt.Block != nil && len(items) > 0 {
+			last := items[len(items)-1]
+			if !strings.HasSuffix(last.Text, ";") {
+				last.Text += ";"
+			}
+			item.Lead = ""
+			item.Text = indent(this.statements(stmt.Block.Statements))
+		} else if stmt.Block != nil {
+			item.Text = this.statements(stmt.Block.Statements)
+		} else {
+			item.Text = this.statement(stmt)
+		}
+		items = append(items, item)
+	}
+	return renderItems(this.tail(items))
+}
+func (this *Formatter) statement(stmt *Statement) string {
+	if stmt.SimpleStmt != nil {
+		return this.simpleStmt(stmt.SimpleStmt)
+	}
+	if stmt.LabeledStmt != nil {
+		return "~" + stmt.LabeledStmt.Name + ": " + this.statement(stmt.LabeledStmt.Statement)
+	}
+	if stmt.GoStmt != nil {
+		return this.goStmt(stmt.GoStmt)
+	}
+	if stmt.ReturnStmt != nil {
+		return this.returnStmt(stmt.ReturnStmt)
+	}
+	if stmt.BreakStmt != nil {
+		return withName("break", stmt.BreakStmt.Name)
+	}
+	if stmt.ContinueStmt != nil {
+		return withName("continue", stmt.ContinueStmt.Name)
+	}
+	if stmt.GotoStmt != nil {
+		return withName("goto", stmt.GotoStmt.Name)
+	}
+	if stmt.FallthroughStmt != nil {
+		return "fallthrough"
+	}
+	if stmt.IfStmt != nil {
+		return this.ifStmt(stmt.IfStmt)
+	}
+	if stmt.SwitchStmt != nil {
+		return this.switchStmt(stmt.SwitchStmt)
+	}
+	if stmt.SelectStmt != nil {
+		return this.selectStmt(stmt.SelectStmt)
+	}
+	if stmt.ForStmt != nil {
+		return this.forStmt(stmt.ForStmt)
+	}
+	if stmt.Block != nil {
+		return this.statements(stmt.Block.Statements)
+	}
+	if stmt.DeferStmt != nil {
+		return "defer " + this.expression(stmt.DeferStmt.Expression)
+	}
+	return this.declaration(stmt.Declaration)
+}
+func (this *Formatter) simpleStmt(stmt *SimpleStmt) string {
+	if stmt.SendStmt != nil {
+		return this.expression(stmt.SendStmt.Left) + " <- " + this.expression(stmt.SendStmt.Right)
+	}
+	if stmt.Expression != nil {
+		return this.expression(stmt.Expression)
+	}
+	if stmt.IncDecStmt != nil {
+		if stmt.IncDecStmt.IsInc {
+			return this.expression(stmt.IncDecStmt.Expression) + "++"
+		}
+		return this.expression(stmt.IncDecStmt.Expression) + "--"
+	}
+	if stmt.ShortVarDecl != nil && stmt.ShortVarDecl.Statement != nil {
+		return identifiers(stmt.ShortVarDecl.IdentifierList) + " := " + flat(this.statement(stmt.ShortVarDecl.Statement))
+	}
+	if stmt.ShortVarDecl != nil {
+		return identifiers(stmt.ShortVarDecl.IdentifierList) + " := " + this.expressions(stmt.ShortVarDecl.Expressions)
+	}
+	if stmt.Assignment != nil {
+		return this.expressions(stmt.Assignment.Left) + " " + stmt.Assignment.Op + " " + this.expressions(stmt.Assignment.Right)
+	}
+	return ""
+}
+func (this *Formatter) goStmt(stmt *GoStmt) string {
+	if stmt.Function != nil {
+		return "go" + this.function(stmt.Function)
+	}
+	return "go " + this.expression(stmt.Expression)
+}
+func (this *Formatter) returnStmt(stmt *ReturnStmt) string {
+	if stmt.Expressions == nil {
+		return "return"
+	}
+	return "return " + this.expressions(stmt.Expressions)
+}
+func (this *Formatter) ifStmt(stmt *IfStmt) string {
+	res := "if "
+	if stmt.SimpleStmt != nil {
+		res += this.simpleStmt(stmt.SimpleStmt) + "; "
+	}
+	res += this.expression(stmt.Expression) + this.branch(stmt.Block, "")
+	if stmt.IfStmt != nil {
+		res += "\nelse " + this.ifStmt(stmt.IfStmt)
+	} else if stmt.BlockElse != nil {
+		res += "\nelse" + this.branch(stmt.BlockElse, "")
+	}
+	return res
+}
+func (this *Formatter) switchStmt(stmt *SwitchStmt) string {
+	if stmt.TypeSwitchStmt != nil {
+		return this.typeSwitchStmt(stmt.TypeSwitchStmt)
+	}
+	if stmt.MatchStmt != nil {
+		return this.matchStmt(stmt.MatchStmt)
+	}
+	sw := stmt.ExprSwitchStmt
+	res := "switch"
+	if sw.SimpleStmt != nil {
+		res += " " + this.simpleStmt(sw.SimpleStmt) + ";"
+	}
+	if sw.Expression != nil {
+		res += " " + this.expression(sw.Expression)
+	}
+	items := []*formatItem{}
+	for i, clause := range sw.ExprCaseClauses {
+		item := this.begin(clause, i == 0, ";")
+		item.Text = "_"
+		if !clause.ExprSwitchCase.IsDefault {
+			item.Text = this.expressions(clause.ExprSwitchCase.Expressions)
+		}
+		item.Text += this.caseBody(clause.Statements)
+		items = append(items, item)
+	}
+	return withItems(res, this.tail(items))
+}
+func (this *Formatter) typeSwitchStmt(sw *TypeSwitchStmt) string {
+	res := "switch "
+	if sw.SimpleStmt != nil {
+		res += this.simpleStmt(sw.SimpleStmt) + "; "
+	}
+	if len(sw.TypeSwitchGuard.Name) > 0 {
+		res += sw.TypeSwitchGuard.Name + " := "
+	}
+	res += this.primaryExpr(sw.TypeSwitchGuard.PrimaryExpr) + ".(type)"
+	items := []*formatItem{}
+	for i, clause := range sw.TypeCaseClauses {
+		item := this.begin(clause, i == 0, ";")
+		item.Text = "_"
+		if len(clause.TypeSwitchCase.Types) > 0 {
+			item.Text = this.types(clause.TypeSwitchCase.Types)
+		}
+		item.Text += this.caseBody(clause.Statements)
+		items = append(items, item)
+	}
+	return withItems(res, this.tail(items))
+}
+func (this *Formatter) matchStmt(stmt *MatchStmt) string {
+	items := []*formatItem{}
+	// An arm starts on the line of its first pattern
+	for i, arm := range stmt.Arms {
+		item := this.begin(arm, i == 0, "")
+		patterns := []string{}
+		for _, pattern := range arm.Patterns {
+			patterns = append(patterns, this.pattern(pattern))
+		}
+		item.Text = strings.Join(patterns, ", ")
+		if arm.Guard != nil {
+			item.Text += " if " + this.expression(arm.Guard)
+		}
+		item.Text += this.caseBody(arm.Statements)
+		items = append(items, item)
+	}
+	return withItems("match "+this.expression(stmt.Expression), this.tail(items))
+}
+func (this *Formatter) pattern(pattern *Pattern) string {
+	res := "_"
+	if pattern.Value != nil {
+		res = this.expression(pattern.Value)
+	} else if pattern.Fields != nil && strings.HasPrefix(pattern.Type, "*") {
+		res = "&" + pattern.Type[1:] + this.literalValue(pattern.Fields)
+	} else if pattern.Fields != nil {
+		res = pattern.Type + this.literalValue(pattern.Fields)
+	} else if pattern.Type != "" {
+		res = pattern.Type
+	}
+	if pattern.Binding != "" {
+		res += " as " + pattern.Binding
+	}
+	return res
+}
+
+// The statements of a one-liner case are separated by `;`,
+// a block case is a single block statement
+func (this *Formatter) caseBody(stmts []*Statement) string {
+	if len(stmts) == 1 && stmts[0].Block != nil {
+		return " =>\n" + indent(this.statements(stmts[0].Block.Statements))
+	}
+	texts := []string{}
+	for _, stmt := range stmts {
+		if stmt.SimpleStmt != nil && stmt.SimpleStmt.EmptyStmt {
+			continue
+		}
+		texts = append(texts, this.statement(stmt))
+	}
+	if len(texts) == 0 {
+		return " =>"
+	}
+	text := strings.Join(texts, "; ")
+	if !strings.Contains(text, "\n") {
+		return cellSep + "=> " + flat(text)
+	}
+	return " =>\n" + indent(strings.Join(texts, "\n"))
+}
+func (this *Formatter) selectStmt(stmt *SelectStmt) string {
+	items := []*formatItem{}
+	for i, clause := range stmt.CommClauses {
+		item := this.begin(clause, i == 0, ";")
+		item.Text = this.commCase(clause.CommCase) + this.branch(clause.Block, " =>")
+		items = append(items, item)
+	}
+	return withItems("select", this.tail(items))
+}
+func (this *Formatter) commCase(c *CommCase) string {
+	if c.SendStmt != nil {
+		return this.expression(c.SendStmt.Left) + " <- " + this.expression(c.SendStmt.Right)
+	}
+	if c.RecvStmt == nil {
+		return "_"
+	}
+	res := ""
+	if c.RecvStmt.Expressions != nil {
+		res = this.expressions(c.RecvStmt.Expressions) + " = "
+	}
+	if c.RecvStmt.IdentifierList != nil {
+		res = identifiers(c.RecvStmt.IdentifierList) + " := "
+	}
+	return res + this.expression(c.RecvStmt.Expression)
+}
+func (this *Formatter) forStmt(stmt *ForStmt) string {
+	res := "for"
+	if stmt.Expression != nil {
+		res += " " + this.expression(stmt.Expression)
+	} else if stmt.ForClause != nil {
+		res += " " + this.forClause(stmt.ForClause)
+	} else if stmt.RangeClause != nil {
+		res += " " + identifiers(stmt.RangeClause.IdentifierList) + " in " + this.expression(stmt.RangeClause.Expression)
+	}
+	return res + "\n" + indent(this.statements(stmt.Block.Statements))
+}
+func (this *Formatter) forClause(clause *ForClause) string {
+	res := ""
+	if clause.LeftSimpleStmt != nil {
+		res += this.simpleStmt(clause.LeftSimpleStmt)
+	}
+	res += "; "
+	if clause.Expression != nil {
+		res += this.expression(clause.Expression)
+	}
+	res += "; "
+	if clause.RightSimpleStmt != nil {
+		res += this.simpleStmt(clause.RightSimpleStmt)
+	}
+	return strings.TrimSpace(res)
+}
+
+// Block form for the declarations, inline form inside expressions
+func (this *Formatter) typ(t *Type, block bool) string {
+	if t.Type != nil {
+		return "(" + this.typ(t.Type, false) + ")"
+	}
+	if t.TypeLit == nil {
+		return t.TypeName
+	}
+	lit := t.TypeLit
+	if lit.ArrayType != nil {
+		return this.arrayType(lit.ArrayType)
+	}
+	if lit.StructType != nil {
+		return this.structType(lit.StructType, block)
+	}
+	if lit.PointerType != nil {
+		return "*" + this.typ(lit.PointerType.Type, false)
+	}
+	if lit.FunctionType != nil {
+		return "fn" + this.signature(lit.FunctionType.Signature)
+	}
+	if lit.InterfaceType != nil {
+		return this.interfaceType(lit.InterfaceType, block)
+	}
+	if lit.SliceType != nil {
+		return "[]" + this.typ(lit.SliceType.Type, false)
+	}
+	if lit.MapType != nil {
+		return this.mapType(lit.MapType)
+	}
+	if lit.ChannelType != nil {
+		return lit.ChannelType.ChannelDecl + " " + this.typ(lit.ChannelType.Type, false)
+	}
+	return ""
+}
+func (this *Formatter) types(types []*Type) string {
+	res := []string{}
+	for _, t := range types {
+		res = append(res, this.typ(t, false))
+	}
+	return strings.Join(res, ", ")
+}
+func (this *Formatter) arrayType(t *ArrayType) string {
+	return "[" + this.expression(t.Length) + "]" + this.typ(t.ElementType, false)
+}
+func (this *Formatter) mapType(t *MapType) string {
+	return "map[" + this.typ(t.InnerType, false) + "]" + this.typ(t.OuterType, false)
+}
+func (this *Formatter) structType(st *StructType, block bool) string {
+	res := "struct"
+	if strings.HasPrefix(st.Text(), "class") {
+		res = "class"
+	}
+	if len(st.Name) > 0 {
+		res += " " + st.Name
+	}
+	if st.TemplateSpec != nil {
+		res += this.templateSpec(st.TemplateSpec)
+	}
+	if block {
+		return withItems(res, this.fields(st))
+	}
+	fields := []string{}
+	for _, field := range st.Fields {
+		fields = append(fields, flat(this.field(field)))
+	}
+	return res + "{" + strings.Join(fields, "; ") + "}"
+}
+func (this *Formatter) fields(st *StructType) []*formatItem {
+	items := []*formatItem{}
+	for i, field := range st.Fields {
+		sep := ""
+		// The body of a one-liner method could be continued by the next field
+		if field.InlineStructMethod != nil {
+			sep = ";"
+		}
+		item := this.begin(field, i == 0, sep)
+		item.Text = this.field(field)
+		items = append(items, item)
+	}
+	return this.tail(items)
+}
+
+// The variants that have fields are a name followed by the block of their fields
+func (this *Formatter) dataType(dt *DataType) string {
+	items := []*formatItem{}
+	for i, variant := range dt.Variants {
+		item := this.begin(variant, i == 0, "")
+		item.Text = withItems(variant.Name, this.fields(&StructType{Fields: variant.Fields}))
+		items = append(items, item)
+	}
+	return withItems("data "+dt.Name, this.tail(items))
+}
+func (this *Formatter) field(field *FieldDecl) string {
+	if field.InlineStructMethod != nil {
+		res := this.functionDecl(field.InlineStructMethod.FunctionDecl)
+		if strings.HasPrefix(field.Text(), "*") {
+			res = "*" + res
+		}
+		return res
+	}
+	res := ""
+	if field.Anonymous != nil {
+		res = field.Anonymous.Type
+		if field.Anonymous.IsPointerReceiver {
+			res = "*" + res
+		}
+	} else {
+		res = identifiers(field.IdentifierList) + cellSep + this.typ(field.Type, len(field.Tag) == 0)
+	}
+	if len(field.Tag) > 0 {
+		res += cellSep + field.Tag
+	}
+	return res
+}
+func (this *Formatter) interfaceType(it *InterfaceType, block bool) string {
+	res := "interface"
+	if len(it.Name) > 0 {
+		res += " " + it.Name
+	}
+	if block {
+		items := []*formatItem{}
+		for i, method := range it.MethodSpecs {
+			item := this.begin(method, i == 0, "")
+			item.Text = this.methodSpec(method)
+			items = append(items, item)
+		}
+		return withItems(res, this.tail(items))
+	}
+	methods := []string{}
+	for _, method := range it.MethodSpecs {
+		methods = append(methods, this.methodSpec(method))
+	}
+	// Only a literal type can be followed by a brace
+	if len(methods) == 0 && this.literals == 0 {
+		return res
+	}
+	return res + "{" + strings.Join(methods, "; ") + "}"
+}
+
+// Without result, the parameters tell a method from an embedded interface
+func (this *Formatter) methodSpec(method *MethodSpec) string {
+	if len(method.Type) > 0 {
+		return method.Type
+	}
+	res := method.Name
+	params := this.parameters(method.Parameters)
+	if len(params) > 0 || method.Result == nil {
+		res += "(" + params + ")"
+	}
+	if method.Result != nil {
+		res += ": " + this.types(method.Result.Types)
+	}
+	return res
+}
+func (this *Formatter) signature(sig *Signature) string {
+	if sig == nil {
+		return ""
+	}
+	res := ""
+	params := this.parameters(sig.Parameters)
+	if sig.TemplateSpec != nil {
+		res += this.templateSpec(sig.TemplateSpec)
+	}
+	if len(params) > 0 {
+		res += "(" + params + ")"
+	}
+	if sig.Result != nil && len(sig.Result.Types) > 0 {
+		res += ": " + this.types(sig.Result.Types)
+	}
+	return res
+}
+func (this *Formatter) parameters(params *Parameters) string {
+	if params == nil {
+		return ""
+	}
+	res := []string{}
+	for _, param := range params.List {
+		text := ""
+		if param.IdentifierList != nil {
+			text = identifiers(param.IdentifierList) + " "
+		}
+		if param.IsVariadic {
+			text += "..."
+		}
+		res = append(res, text+this.typ(param.Type, false))
+	}
+	return strings.Join(res, ", ")
+}
+func (this *Formatter) templateSpec(spec *TemplateSpec) string {
+	res := []string{}
+	for i, t := range spec.Result.Types {
+		item := this.typ(t, false)
+		if constraint := spec.Constraint(i); constraint != "" {
+			item += ": " + constraint
+		}
+		res = append(res, item)
+	}
+	return "<" + strings.Join(res, ", ") + ">"
+}
+func (this *Formatter) expressions(list *ExpressionList) string {
+	if list == nil {
+		return ""
+	}
+	res := []string{}
+	for _, expr := range list.Expressions {
+		res = append(res, this.expression(expr))
+	}
+	return strings.Join(res, ", ")
+}
+func (this *Formatter) expression(expr *Expression) string {
+	if expr.UnaryExpr != nil {
+		return this.unaryExpr(expr.UnaryExpr)
+	}
+	return this.expression(expr.LeftExpression) + " " + expr.Op + " " + this.expression(expr.RightExpression)
+}
+func (this *Formatter) unaryExpr(expr *UnaryExpr) string {
+	if expr.PrimaryExpr != nil {
+		return this.primaryExpr(expr.PrimaryExpr)
+	}
+	operand := this.unaryExpr(expr.UnaryExpr)
+	// `- -a` is not `--a`
+	if len(operand) > 0 && isOperator(expr.Op[len(expr.Op)-1:len(expr.Op)]+operand[:1]) {
+		return expr.Op + " " + operand
+	}
+	return expr.Op + operand
+}
+func (this *Formatter) primaryExpr(expr *PrimaryExpr) string {
+	if expr.Operand != nil {
+		return this.operand(expr.Operand)
+	}
+	if expr.Conversion != nil {
+		return this.typ(expr.Conversion.Type, false) + "(" + this.expression(expr.Conversion.Expression) + ")"
+	}
+	return this.primaryExpr(expr.PrimaryExpr) + this.secondaryExpr(expr.SecondaryExpr)
+}
+func (this *Formatter) secondaryExpr(expr *SecondaryExpr) string {
+	if len(expr.Selector) > 0 {
+		return expr.Selector
+	}
+	if expr.Index != nil {
+		return "[" + this.expression(expr.Index.Expression) + "]"
+	}
+	if expr.Slice != nil {
+		return this.slice(expr.Slice)
+	}
+	if expr.TypeAssertion != nil {
+		return ".(" + this.typ(expr.TypeAssertion.Type, false) + ")"
+	}
+	if expr.Arguments != nil {
+		return this.arguments(expr.Arguments)
+	}
+	if expr.Bubble {
+		return "?"
+	}
+	return ""
+}
+func (this *Formatter) slice(s *Slice) string {
+	res := "["
+	if s.LeftExpr != nil {
+		res += this.expression(s.LeftExpr)
+	}
+	res += ":"
+	if s.MiddleExpr != nil {
+		res += this.expression(s.MiddleExpr)
+	}
+	if s.RightExpr != nil {
+		res += ":" + this.expression(s.RightExpr)
+	}
+	return res + "]"
+}
+func (this *Formatter) arguments(args *Arguments) string {
+	res := ""
+	list := []string{}
+	if args.TemplateSpec != nil {
+		res += this.templateSpec(args.TemplateSpec)
+	}
+	if args.Type != nil {
+		list = append(list, this.typ(args.Type, false))
+	}
+	if args.Expressions != nil {
+		list = append(list, this.expressions(args.Expressions))
+	}
+	res += "(" + strings.Join(list, ", ")
+	if args.IsVariadic {
+		res += "..."
+	}
+	return res + ")"
+}
+func (this *Formatter) operand(op *Operand) string {
+	if op.Literal != nil {
+		return this.literal(op.Literal)
+	}
+	if op.OperandName != nil {
+		return operandName(op.OperandName.Name)
+	}
+	if op.MethodExpr != nil {
+		return receiverType(op.MethodExpr.ReceiverType) + "." + op.MethodExpr.Name
+	}
+	return "(" + this.expression(op.Expression) + ")"
+}
+func (this *Formatter) literal(lit *Literal) string {
+	if lit.Composite != nil {
+		return this.compositeLit(lit.Composite)
+	}
+	if lit.FunctionLit != nil {
+		return "fn" + this.function(lit.FunctionLit.Function)
+	}
+	return this.basicLit(lit)
+}
+func (this *Formatter) basicLit(lit *Literal) string {
+	return strings.Replace(lit.Basic, "\n", rawNewline, -1)
+}
+
+// The block form is kept when the elements are not on the line of the type
+func (this *Formatter) compositeLit(lit *CompositeLit) string {
+	res := this.literalType(lit.LiteralType)
+	if lit.TemplateSpec != nil {
+		res += this.templateSpec(lit.TemplateSpec)
+	}
+	elements := lit.LiteralValue.Elements
+	if len(elements) > 0 && elements[0].Line() > lit.Line() {
+		items := []*formatItem{}
+		for i, element := range elements {
+			item := this.begin(element, i == 0, ",")
+			item.Text = this.keyedElement(element, ":"+cellSep)
+			items = append(items, item)
+		}
+		return withItems(res, this.tail(items))
+	}
+	return res + this.literalValue(lit.LiteralValue)
+}
+func (this *Formatter) literalType(t *LiteralType) string {
+	this.literals++
+	res := t.Type
+	if t.Struct != nil {
+		res = this.structType(t.Struct, false)
+	} else if t.Array != nil {
+		res = this.arrayType(t.Array)
+	} else if t.Element != nil {
+		res = "[...]" + this.typ(t.Element, false)
+	} else if t.Slice != nil {
+		res = "[]" + this.typ(t.Slice.Type, false)
+	} else if t.Map != nil {
+		res = this.mapType(t.Map)
+	}
+	this.literals--
+	return res
+}
+func (this *Formatter) literalValue(value *LiteralValue) string {
+	res := []string{}
+	for _, element := range value.Elements {
+		res = append(res, flat(this.keyedElement(element, ": ")))
+	}
+	return "{" + strings.Join(res, ", ") + "}"
+}
+func (this *Formatter) keyedElement(element *KeyedElement, sep string) string {
+	res := ""
+	if element.Key != nil {
+		key := element.Key
+		if key.Expression != nil {
+			res = this.expression(key.Expression)
+		} else if key.LiteralValue != nil {
+			res = this.literalValue(key.LiteralValue)
+		} else {
+			res = key.Name
+		}
+		res += sep
+	}
+	if element.Element.Expression != nil {
+		return res + this.expression(element.Element.Expression)
+	}
+	return res + this.literalValue(element.Element.LiteralValue)
+}
+func NewFormatter(source []byte) *Formatter {
+	return &Formatter{
+		lines:    strings.Split(string(source), "\n"),
+		comments: ScanComments(string(source)),
+	}
+}
+
+// Line and block comments outside of the literals
+func ScanComments(source string) []*Comment {
+	res := []*Comment{}
+	line := 1
+	code := false
+	i := 0
+	for len(source) > i {
+		c := source[i]
+		rest := source[i:len(source)]
+		if c == '\n' {
+			line++
+			code = false
+			i++
+		} else if c == '"' || c == '\'' || c == '`' {
+			end := literalEnd(source, i)
+			line += strings.Count(source[i:end], "\n")
+			code = true
+			i = end
+		} else if c == '#' || strings.HasPrefix(rest, "//") {
+			end := strings.Index(rest, "\n")
+			if end < 0 {
+				end = len(rest)
+			}
+			res = append(res, &Comment{
+				Line:     line,
+				Text:     strings.TrimRight(rest[:end], " \t\r"),
+				Trailing: code,
+			})
+			i += end
+		} else if strings.HasPrefix(rest, "/*") {
+			end := strings.Index(rest, "*/") + 2
+			if end < 2 {
+				end = len(rest)
+			}
+			res = append(res, &Comment{
+				Line:     line,
+				Text:     rest[:end],
+				Trailing: code,
+			})
+			line += strings.Count(rest[:end], "\n")
+			i += end
+		} else {
+			if c != ' ' && c != '\t' && c != '\r' {
+				code = true
+			}
+			i++
+		}
+	}
+	return res
+}
+
+// Index after the literal starting at `start`
+func literalEnd(source string, start int) int {
+	quote := source[start]
+	i := start + 1
+	for len(source) > i {
+		c := source[i]
+		if c == '\\' && quote != '`' {
+			i += 2
+		} else if c == quote {
+			return i + 1
+		} else if c == '\n' && quote != '`' {
+			return i
+		} else {
+			i++
+		}
+	}
+	return len(source)
+}
+func isOneLiner(block *Block) bool {
+	return !strings.HasPrefix(block.Text(), "{") && len(block.Statements) == 1
+}
+
+// Prints the items of a list, one per line
+func renderItems(items []*formatItem) string {
+	res := []string{}
+	for i, item := range items {
+		text := item.Text
+		if len(items) > i+1 && len(item.Sep) > 0 && continues(items[i+1].Text) && !endsIndented(text) {
+			text += item.Sep
+		}
+		res = append(res, item.Lead+withTrail(text, item.Trail))
+	}
+	return strings.Join(res, "\n")
+}
+
+// A header followed by its indented items
+func withItems(header string, items []*formatItem) string {
+	if len(items) == 0 {
+		return header
+	}
+	return header + "\n" + indent(renderItems(items))
+}
+
+// A trailing comment on a line that opens a block would swallow its brace
+func withTrail(text, trail string) string {
+	if len(trail) == 0 {
+		return text
+	}
+	lines := strings.Split(text, "\n")
+	if len(lines) > 1 && strings.HasPrefix(lines[1], " ") {
+		return trail + "\n" + text
+	}
+	lines[0] += commentSep + trail
+	return strings.Join(lines, "\n")
+}
+
+// Whether a line starting with `text` continues the expression of the line before
+func continues(text string) bool {
+	if strings.HasPrefix(text, "//") || strings.HasPrefix(text, "/*") {
+		return false
+	}
+	return len(text) > 0 && strings.ContainsAny(text[:1], "*&-+<^([|%/.")
+}
+func endsIndented(text string) bool {
+	return strings.HasPrefix(text[strings.LastIndex(text, "\n")+1:len(text)], " ")
+}
+func isOperator(str string) bool {
+	for _, op := range []string{
+		"--",
+		"++",
+		"&&",
+		"&^",
+		"||",
+		"<-",
+		"<<",
+		">>",
+		"==",
+		"!=",
+		"<=",
+		">=",
+		"->",
+		"=>",
+		":=",
+	} {
+		if str == op {
+			return true
+		}
+	}
+	return false
+}
+func indent(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if len(line) > 0 {
+			lines[i] = "  " + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// The one-liners nested in another line are not aligned
+func flat(text string) string {
+	return strings.Replace(text, cellSep, " ", -1)
+}
+
+// Several specs of a declaration are written on a single line
+func group(specs []string) string {
+	if len(specs) == 1 {
+		return specs[0]
+	}
+	return "(" + strings.Join(specs, "; ") + ";)"
+}
+func identifiers(list *IdentifierList) string {
+	if list == nil {
+		return ""
+	}
+	return strings.Join(list.List, ", ")
+}
+func withName(keyword, name string) string {
+	if len(name) > 0 {
+		return keyword + " " + name
+	}
+	return keyword
+}
+func operandName(name string) string {
+	if name == "this" {
+		return "@"
+	}
+	if strings.HasPrefix(name, "this.") {
+		return "@" + strings.TrimPrefix(name, "this.")
+	}
+	return name
+}
+func receiverType(t *ReceiverType) string {
+	if t.ReceiverType != nil {
+		return "(" + receiverType(t.ReceiverType) + ")"
+	}
+	if t.IsPointer {
+		return "(*" + t.Type + ")"
+	}
+	return t.Type
+}
+func importSpec(spec *ImportSpec) string {
+	res := strings.TrimSpace(spec.Path)
+	if simpleImport.MatchString(res) {
+		res = strings.Trim(res, "\"")
+	}
+	if len(spec.Alias) > 0 {
+		res += ": " + spec.Alias
+	}
+	return res
+}
+
+// The first element of a path from the standard library has no dot
+func isStdImport(path string) bool {
+	path = strings.Trim(strings.TrimSpace(path), "\"")
+	return !strings.Contains(strings.Split(path, "/")[0], ".")
+}
+func sortImports(items []*formatItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		return importPath(items[j].Text) > importPath(items[i].Text)
+	})
+}
+func importPath(text string) string {
+	return strings.Trim(strings.Split(text, ":")[0], "\"")
+}
+
+// Pads the cells of consecutive lines with the same indentation to the same width
+func align(text, sep string) string {
+	rows := [][]string{}
+	for _, line := range strings.Split(text, "\n") {
+		rows = append(rows, strings.Split(line, sep))
+	}
+	col := 0
+	for alignColumn(rows, col) {
+		col++
+	}
+	lines := []string{}
+	for _, row := range rows {
+		lines = append(lines, strings.Join(row, ""))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// False once no row has a cell after `col`
+func alignColumn(rows [][]string, col int) bool {
+	found := false
+	start := 0
+	for len(rows) > start {
+		if col+1 >= len(rows[start]) {
+			start++
+			continue
+		}
+		found = true
+		end := start + 1
+		margin := indentOf(rows[start][0])
+		for len(rows) > end && len(rows[end]) > col+1 && indentOf(rows[end][0]) == margin {
+			end++
+		}
+		width := 0
+		for _, row := range rows[start:end] {
+			if utf8.RuneCountInString(row[col]) > width {
+				width = utf8.RuneCountInString(row[col])
+			}
+		}
+		for _, row := range rows[start:end] {
+			row[col] += strings.Repeat(" ", width-utf8.RuneCountInString(row[col])+1)
+		}
+		start = end
+	}
+	return found
+}
+func indentOf(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
+
+// Indentation of a source line, a tab counts as two spaces like in the lexer
+func sourceIndent(line string) int {
+	res := 0
+	for _, c := range line {
+		if c == ' ' {
+			res++
+		} else if c == '\t' {
+			res += 2
+		} else {
+			break
+		}
+	}
+	return res
+}
@@ -0,0 +1,85 @@
+package ast
+
+import (
+	"strings"
+)
+
+// The types that `ordered` stands for, the ones that `<` compares
+var (
+	OrderedTypes = []string{
+		"int",
+		"int8",
+		"int16",
+		"int32",
+		"int64",
+		"uint",
+		"uint8",
+		"uint16",
+		"uint32",
+		"uint64",
+		"uintptr",
+		"float32",
+		"float64",
+		"string",
+	}
+)
+
+// The constraint of the type `i`, "" when it has none
+func (this TemplateSpec) Constraint(i int) string {
+	if i >= len(this.Constraints) {
+		return ""
+	}
+	return this.Constraints[i]
+}
+
+// The members of a constraint, `comparable | ordered` has two
+func ConstraintMembers(constraint string) []string {
+	res := []string{}
+	for _, member := range strings.Split(constraint, "|") {
+		if member = strings.TrimSpace(member); member != "" {
+			res = append(res, member)
+		}
+	}
+	return res
+}
+
+// `[T any, U fmt.Stringer]`, the type parameters of a template declaration
+func (this TemplateSpec) TypeParams() string {
+	params := []string{}
+	for i, t := range this.Result.Types {
+		params = append(params, t.Eval()+" "+goConstraint(this.Constraint(i)))
+	}
+	return "[" + strings.Join(params, ", ") + "]"
+}
+
+// `[int, string]`, the type arguments of a template usage
+func (this TemplateSpec) TypeArgs() string {
+	args := []string{}
+	for _, t := range this.Result.Types {
+		args = append(args, t.Eval())
+	}
+	return "[" + strings.Join(args, ", ") + "]"
+}
+
+// `ordered` is the set of its types. Go cannot put `comparable` in a union,
+// it is the whole constraint as the others are comparable too
+func goConstraint(constraint string) string {
+	members := ConstraintMembers(constraint)
+	if len(members) == 0 {
+		return "any"
+	}
+	res := []string{}
+	for _, member := range members {
+		if member == "comparable" {
+			return member
+		}
+		if member != "ordered" {
+			res = append(res, member)
+			continue
+		}
+		for _, t := range OrderedTypes {
+			res = append(res, "~"+t)
+		}
+	}
+	return strings.Join(res, " | ")
+}
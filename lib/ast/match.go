@@ -0,0 +1,373 @@
+package ast
+
+import (
+	"github.com/champii/og/lib/common"
+	"strings"
+)
+
+// Name of the matched value in the generated switch
+const (
+	matchValue = "__og_match"
+)
+
+// The switch a `match` is lowered to
+const (
+	matchValues = 0 // switch value { case 1, 2: }
+)
+const (
+	matchTypes = 1 // switch value.(type) { case *Foo: }
+)
+const (
+	matchConditions = 2 // switch __og_match := value; { case cond: }
+)
+
+// `_`, a value, `T as name`, `T{Field: value}` or `&T{Field: value}`.
+// The `expr` of a `_` is nil
+func NewPattern(node *common.Node, expr *Expression, binding string) *Pattern {
+	res := &Pattern{
+		Node:    node,
+		Binding: binding,
+	}
+	if expr == nil {
+		return res
+	}
+	if lit, pointer := compositeOf(expr); lit != nil && isKeyed(lit.LiteralValue) {
+		res.Type = lit.LiteralType.Eval()
+		res.Fields = lit.LiteralValue
+		if pointer {
+			res.Type = "*" + res.Type
+		}
+	} else if binding != "" {
+		res.Type = expr.Eval()
+	} else {
+		res.Value = expr
+	}
+	return res
+}
+
+// The struct literal of a pattern, and whether it is behind a pointer
+func compositeOf(expr *Expression) (*CompositeLit, bool) {
+	unary := expr.UnaryExpr
+	if unary == nil {
+		return nil, false
+	}
+	pointer := unary.Op == "&" || unary.Op == "*"
+	if pointer {
+		unary = unary.UnaryExpr
+	}
+	if unary.PrimaryExpr == nil || unary.PrimaryExpr.Operand == nil || unary.PrimaryExpr.Operand.Literal == nil {
+		return nil, false
+	}
+	return unary.PrimaryExpr.Operand.Literal.Composite, pointer
+}
+
+// The positional literals are compared as a whole
+func isKeyed(value *LiteralValue) bool {
+	for _, element := range value.Elements {
+		if element.Key == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// The values are compared by an expression switch and the types tested by a
+// type switch. A guard, a field, a mix of both or a `_` that is not last
+// need each arm to be a condition
+func (this MatchStmt) mode() int {
+	values := false
+	types := false
+	for i, arm := range this.Arms {
+		if arm.Guard != nil {
+			return matchConditions
+		}
+		for _, pattern := range arm.Patterns {
+			if pattern.Value != nil {
+				values = true
+			} else if pattern.Fields != nil && len(pattern.Fields.Elements) > 0 {
+				return matchConditions
+			} else if pattern.Type != "" {
+				types = true
+			} else if i < len(this.Arms)-1 {
+				return matchConditions
+			}
+		}
+	}
+	if values && types {
+		return matchConditions
+	}
+	if types {
+		return matchTypes
+	}
+	return matchValues
+}
+
+// Whether an arm names the matched value
+func (this MatchStmt) binds() bool {
+	for _, arm := range this.Arms {
+		if len(arm.bindings()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Whether a condition uses the matched value
+func (this MatchStmt) tests() bool {
+	for _, arm := range this.Arms {
+		for _, pattern := range arm.Patterns {
+			if !pattern.isWildcard() {
+				return true
+			}
+		}
+	}
+	return this.binds()
+}
+func (this MatchStmt) lower() string {
+	mode := this.mode()
+	value := this.Expression.Eval()
+	res := "switch "
+	if mode == matchConditions && this.tests() {
+		res += matchValue + " := " + value + ";"
+	} else if mode == matchConditions {
+		res += "_ = " + value + ";"
+	} else if mode == matchTypes && this.binds() {
+		res += matchValue + " := " + value + ".(type)"
+	} else if mode == matchTypes {
+		res += value + ".(type)"
+	} else if this.binds() {
+		res += matchValue + " := " + value + ";" + matchValue
+	} else {
+		res += value
+	}
+	res += "{\n"
+	for i, arm := range this.Arms {
+		res += arm.lower(mode, i == len(this.Arms)-1) + "\n"
+	}
+	if this.Exhaustive {
+		res += unreachable + "\n"
+	}
+	return res + "}"
+}
+
+// The arm matches anything
+func (this MatchArm) isDefault() bool {
+	if this.Guard != nil {
+		return false
+	}
+	for _, pattern := range this.Patterns {
+		if pattern.isWildcard() {
+			return true
+		}
+	}
+	return false
+}
+
+// The names given to the matched value, once each
+func (this MatchArm) bindings() []string {
+	res := []string{}
+	seen := make(map[string]bool)
+	for _, pattern := range this.Patterns {
+		if pattern.Binding != "" && pattern.Binding != "_" && !seen[pattern.Binding] {
+			seen[pattern.Binding] = true
+			res = append(res, pattern.Binding)
+		}
+	}
+	return res
+}
+
+// A binding has the type of the pattern when it is alone in its arm, the
+// matched value is given as is otherwise. The names of the fields are only
+// given by a pattern alone in its arm
+func (this MatchArm) declarations(mode int) string {
+	res := ""
+	for _, name := range this.bindings() {
+		value := matchValue
+		if mode == matchConditions && len(this.Patterns) == 1 && this.Patterns[0].Type != "" {
+			value += ".(" + this.Patterns[0].Type + ")"
+		}
+		res += name + " := " + value + "\n_ = " + name + "\n"
+	}
+	if len(this.Patterns) == 1 {
+		value := matchValue + ".(" + this.Patterns[0].Type + ")"
+		if bindings := this.bindings(); len(bindings) > 0 {
+			value = bindings[0]
+		}
+		res += this.Patterns[0].fieldDeclarations(value)
+	}
+	return res
+}
+func (this MatchArm) lower(mode int, last bool) string {
+	res := "case " + this.condition(mode) + ":"
+	if this.isDefault() && (last || mode != matchConditions) {
+		res = "default:"
+	}
+	if declarations := this.declarations(mode); declarations != "" {
+		res += "\n" + strings.TrimSuffix(declarations, "\n")
+	}
+	stmts := this.Statements
+	if len(stmts) == 1 && stmts[0].Block != nil {
+		stmts = stmts[0].Block.Statements
+	}
+	for _, stmt := range stmts {
+		res += "\n" + stmt.Marker() + stmt.Eval()
+	}
+	return res
+}
+func (this MatchArm) condition(mode int) string {
+	conds := []string{}
+	for _, pattern := range this.Patterns {
+		if pattern.isWildcard() {
+			conds = []string{}
+			break
+		}
+		if mode == matchValues {
+			conds = append(conds, pattern.Value.Eval())
+		} else if mode == matchTypes {
+			conds = append(conds, pattern.Type)
+		} else {
+			conds = append(conds, pattern.condition("", ""))
+		}
+	}
+	if mode != matchConditions {
+		return strings.Join(conds, ", ")
+	}
+	if this.Guard == nil {
+		if len(conds) == 0 {
+			return "true"
+		}
+		return strings.Join(conds, " || ")
+	}
+	guard := operand(this.Guard, "||")
+	bindings := this.bindings()
+	// The guard sees the value asserted by the pattern, and its fields
+	if len(this.Patterns) == 1 && this.Patterns[0].Type != "" && (len(bindings) > 0 || len(this.Patterns[0].FieldNames()) > 0) {
+		name := ""
+		if len(bindings) > 0 {
+			name = bindings[0]
+		}
+		return this.Patterns[0].condition(name, guard)
+	}
+	res := guard
+	if len(conds) == 1 {
+		res = conds[0] + " && " + guard
+	} else if len(conds) > 1 {
+		res = "(" + strings.Join(conds, " || ") + ") && " + guard
+	}
+	if len(bindings) > 0 {
+		res = "func() bool {\n" + this.declarations(mode) + "return " + res + "\n}()"
+	}
+	return res
+}
+func (this Pattern) isWildcard() bool {
+	return this.Value == nil && this.Type == ""
+}
+
+// The name a field of a struct pattern is given: a bare name, other than
+// `true`, `false` and `nil`. "" when the field is compared
+func fieldName(element *KeyedElement) string {
+	expr := element.Element.Expression
+	if expr == nil || expr.UnaryExpr == nil || expr.UnaryExpr.PrimaryExpr == nil {
+		return ""
+	}
+	operand := expr.UnaryExpr.PrimaryExpr.Operand
+	if operand == nil || operand.OperandName == nil {
+		return ""
+	}
+	name := operand.OperandName.Name
+	if strings.Contains(name, ".") || name == "true" || name == "false" || name == "nil" {
+		return ""
+	}
+	return name
+}
+
+// The names given to the fields of a struct pattern
+func (this Pattern) FieldNames() []string {
+	res := []string{}
+	if this.Fields == nil {
+		return res
+	}
+	for _, element := range this.Fields.Elements {
+		if name := fieldName(element); name != "" {
+			res = append(res, name)
+		}
+	}
+	return res
+}
+
+// The fields of a struct pattern that are compared to a value
+func (this Pattern) Compared() []*KeyedElement {
+	res := []*KeyedElement{}
+	if this.Fields == nil {
+		return res
+	}
+	for _, element := range this.Fields.Elements {
+		if fieldName(element) == "" {
+			res = append(res, element)
+		}
+	}
+	return res
+}
+
+// `name := value.Field` for each name given to a field
+func (this Pattern) fieldDeclarations(value string) string {
+	res := ""
+	if this.Fields == nil {
+		return res
+	}
+	for _, element := range this.Fields.Elements {
+		if name := fieldName(element); name != "" {
+			res += name + " := " + value + "." + element.Key.Eval() + "\n_ = " + name + "\n"
+		}
+	}
+	return res
+}
+
+// A type is tested by an assertion, along with the fields of a struct and
+// the guard of the arm, that can use the asserted value by its `name` and the
+// names given to its fields
+func (this Pattern) condition(name, guard string) string {
+	if this.Value != nil {
+		return matchValue + " == " + operand(this.Value, "== != < <= > >= && ||")
+	}
+	fields := this.Fields != nil && len(this.Fields.Elements) > 0
+	pointer := fields && strings.HasPrefix(this.Type, "*")
+	compared := this.Compared()
+	named := guard != "" && len(this.FieldNames()) > 0
+	value := name
+	if value == "" && (pointer || len(compared) > 0 || named) {
+		value = "__og_value"
+	} else if value == "" {
+		value = "_"
+	}
+	res := "func() bool {\n" + value + ", __og_ok := " + matchValue + ".(" + this.Type + ")\n"
+	if name != "" {
+		res += "_ = " + name + "\n"
+	}
+	checks := []string{"__og_ok"}
+	if pointer {
+		checks = append(checks, value+" != nil")
+	}
+	for _, element := range compared {
+		checks = append(checks, value+"."+element.Key.Eval()+" == "+element.Element.Eval())
+	}
+	// The fields are read once the value is known to have them
+	if named {
+		res += "if !(" + strings.Join(checks, " && ") + ") {\nreturn false\n}\n" + this.fieldDeclarations(value)
+		checks = []string{}
+	}
+	if guard != "" {
+		checks = append(checks, guard)
+	}
+	return res + "return " + strings.Join(checks, " && ") + "\n}()"
+}
+
+// The expression in parenthesis when its operator is one of `ops`
+func operand(expr *Expression, ops string) string {
+	for _, op := range strings.Fields(ops) {
+		if expr.Op == op {
+			return "(" + expr.Eval() + ")"
+		}
+	}
+	return expr.Eval()
+}
@@ -0,0 +1,468 @@
+package walker
+
+import (
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+	"strconv"
+)
+
+// Collects the `?` of a statement that are not in one of its blocks,
+// the innermost ones first as they are evaluated first
+type bubbleCollector struct {
+	AstWalker
+	depth     int
+	found     []*ast.PrimaryExpr
+	misplaced []*ast.PrimaryExpr // The ones that would be moved before a call on their left
+	effects   []common.INode     // The calls and the receives evaluated so far, that are not moved
+}
+
+func (this *bubbleCollector) BeforeBlock(n common.INode) {
+	this.depth++
+}
+func (this *bubbleCollector) AfterBlock(n common.INode) {
+	this.depth--
+}
+func (this *bubbleCollector) AfterUnaryExpr(n common.INode) {
+	if this.depth == 0 && n.(*ast.UnaryExpr).Op == "<-" {
+		this.effects = append(this.effects, n)
+	}
+}
+func (this *bubbleCollector) AfterPrimaryExpr(n common.INode) {
+	primary := n.(*ast.PrimaryExpr)
+	if this.depth > 0 || primary.SecondaryExpr == nil {
+		return
+	}
+	if primary.SecondaryExpr.Arguments != nil {
+		this.effects = append(this.effects, n)
+		return
+	}
+	if !primary.SecondaryExpr.Bubble || lazy(primary) {
+		return
+	}
+	// The call and its arguments are moved along
+	left := []common.INode{}
+	for _, effect := range this.effects {
+		if !isInside(effect, primary) {
+			left = append(left, effect)
+		}
+	}
+	this.effects = left
+	if len(left) > 0 {
+		this.misplaced = append(this.misplaced, primary)
+	} else {
+		this.found = append(this.found, primary)
+	}
+}
+
+// Desugars `res := mayFail()?` into
+//
+//	res1, err1 := mayFail()
+//	if err1 != nil
+//	  return zeroValues..., err1
+//	res := res1
+//
+// The call is moved before its statement, so the `?` is only allowed where
+// it is evaluated once before anything else of the statement runs
+type Bubble struct {
+	AstWalker
+	File    *common.File
+	Errors  common.Errors
+	count   int
+	results map[string]int // Number of results of the functions of the file
+}
+
+func (this *Bubble) Block(n common.INode) {
+	block := n.(*ast.Block)
+	block.Statements = this.statements(block.Statements, n)
+}
+func (this *Bubble) ExprCaseClause(n common.INode) {
+	clause := n.(*ast.ExprCaseClause)
+	clause.Statements = this.statements(clause.Statements, n)
+}
+func (this *Bubble) TypeCaseClause(n common.INode) {
+	clause := n.(*ast.TypeCaseClause)
+	clause.Statements = this.statements(clause.Statements, n)
+}
+func (this *Bubble) MatchArm(n common.INode) {
+	arm := n.(*ast.MatchArm)
+	arm.Statements = this.statements(arm.Statements, n)
+}
+
+// The ones that are still there could not be moved
+func (this *Bubble) PrimaryExpr(n common.INode) {
+	primary := n.(*ast.PrimaryExpr)
+	if primary.SecondaryExpr != nil && primary.SecondaryExpr.Bubble {
+		this.error(primary, "The ? operator cannot be used here")
+	}
+}
+func (this *Bubble) error(primary *ast.PrimaryExpr, msg string) {
+	primary.SecondaryExpr.Bubble = false
+	this.Errors = append(this.Errors, this.File.Error(primary.SecondaryExpr.Line(), primary.SecondaryExpr.Col(), msg, "?"))
+}
+func (this *Bubble) statements(stmts []*ast.Statement, owner common.INode) []*ast.Statement {
+	res := []*ast.Statement{}
+	for _, stmt := range stmts {
+		found, misplaced := collectBubbles(stmt)
+		for _, primary := range misplaced {
+			this.error(primary, "The ? operator must come before the other calls of its statement")
+		}
+		if len(found) == 0 {
+			res = append(res, stmt)
+			continue
+		}
+		results := resultsOf(owner)
+		if len(results) == 0 || results[len(results)-1].Eval() != "error" {
+			for _, primary := range found {
+				this.error(primary, "The ? operator needs a function that returns an error")
+			}
+			res = append(res, stmt)
+			continue
+		}
+		keep := true
+		for i, primary := range found {
+			generated, used := this.desugar(primary, results[:len(results)-1])
+			// The comments of the statement go before the code that is moved
+			if i == 0 && len(generated) > 0 {
+				generated[0].Comments_ = stmt.Comments_
+				stmt.Comments_ = nil
+				if !used {
+					generated[0].Trailing_ = stmt.Trailing_
+				}
+			}
+			res = append(res, generated...)
+			keep = keep && used
+		}
+		if keep {
+			res = append(res, stmt)
+		}
+	}
+	return res
+}
+
+// The statements that call and check, and whether the statement of the `?` is still needed
+func (this *Bubble) desugar(primary *ast.PrimaryExpr, zeros []*ast.Type) ([]*ast.Statement, bool) {
+	call := primary.PrimaryExpr
+	if call.SecondaryExpr == nil || call.SecondaryExpr.Arguments == nil {
+		this.error(primary, "The ? operator only applies to a call")
+		return nil, true
+	}
+	this.count++
+	suffix := strconv.Itoa(this.count)
+	errName := "__og_err" + suffix
+	count, list := bubbleValues(primary)
+	names := []string{}
+	values := []*ast.Expression{}
+	for i := 0; i < count; i++ {
+		name := "__og_res" + suffix
+		if count > 1 {
+			name += "_" + strconv.Itoa(i+1)
+		}
+		names = append(names, name)
+		values = append(values, nameExpr(name))
+	}
+	// The values of a dropped call are ignored, they are only known for
+	// the functions of the file
+	if count == 0 {
+		results, ok := this.results[plainName(call.PrimaryExpr)]
+		if !ok {
+			this.error(primary, "The ? operator cannot know the values of this call, they must be assigned")
+			return nil, true
+		}
+		for i := 1; i < results; i++ {
+			names = append(names, "_")
+		}
+	}
+	returned := []*ast.Expression{}
+	for _, t := range zeros {
+		returned = append(returned, zeroValue(t))
+	}
+	returned = append(returned, nameExpr(errName))
+	callExpr := &ast.Expression{
+		Node:      common.NewNodeNoCtx(&ast.Expression{}),
+		UnaryExpr: unary(call),
+	}
+	stmts := []*ast.Statement{
+		shortVarDeclStmt(append(names, errName), callExpr),
+		errCheckStmt(errName, returned),
+	}
+	stmts[0].Node.Line_ = call.Line()
+	stmts[0].Node.Col_ = call.Col()
+	switch count {
+	case 0:
+		return stmts, false
+	case 1:
+		{
+			primary.PrimaryExpr = nil
+			primary.SecondaryExpr = nil
+			primary.Operand = values[0].UnaryExpr.PrimaryExpr.Operand
+		}
+	default:
+		list.Expressions = values
+	}
+	return stmts, true
+}
+
+// Whether `n` is on the right of a `&&` or a `||`, that may not evaluate it
+func lazy(n common.INode) bool {
+	for parent := n.GetParent(); parent != nil; parent = parent.GetParent() {
+		if expr, ok := parent.(*ast.Expression); ok && (expr.Op == "&&" || expr.Op == "||") && expr.RightExpression == n {
+			return true
+		}
+		n = parent
+	}
+	return false
+}
+
+// Whether `n` is `parent` or one of its children
+func isInside(n, parent common.INode) bool {
+	for n != nil {
+		if n == parent {
+			return true
+		}
+		n = n.GetParent()
+	}
+	return false
+}
+
+// The parts of a statement that are evaluated once, before the rest of it,
+// and the ones that are evaluated after other calls
+func collectBubbles(stmt *ast.Statement) ([]*ast.PrimaryExpr, []*ast.PrimaryExpr) {
+	collector := bubbleCollector{}
+	collector.type_ = &collector
+	roots := []common.INode{}
+	if stmt.SimpleStmt != nil {
+		roots = append(roots, stmt.SimpleStmt)
+	} else if stmt.ReturnStmt != nil {
+		roots = append(roots, stmt.ReturnStmt)
+	} else if stmt.Declaration != nil && stmt.Declaration.VarDecl != nil {
+		roots = append(roots, stmt.Declaration.VarDecl)
+	} else if stmt.IfStmt != nil {
+		roots = append(roots, stmt.IfStmt.SimpleStmt, stmt.IfStmt.Expression)
+	} else if stmt.SwitchStmt != nil && stmt.SwitchStmt.ExprSwitchStmt != nil {
+		roots = append(roots, stmt.SwitchStmt.ExprSwitchStmt.SimpleStmt, stmt.SwitchStmt.ExprSwitchStmt.Expression)
+	} else if stmt.SwitchStmt != nil && stmt.SwitchStmt.TypeSwitchStmt != nil {
+		roots = append(roots, stmt.SwitchStmt.TypeSwitchStmt.SimpleStmt, stmt.SwitchStmt.TypeSwitchStmt.TypeSwitchGuard)
+	} else if stmt.SwitchStmt != nil && stmt.SwitchStmt.MatchStmt != nil {
+		roots = append(roots, stmt.SwitchStmt.MatchStmt.Expression)
+	}
+	for _, root := range roots {
+		if root != nil && !isNilNode(root) {
+			collector.Walk(root)
+		}
+	}
+	return collector.found, collector.misplaced
+}
+
+// The optional nodes are typed nil pointers once put in an INode
+func isNilNode(n common.INode) bool {
+	switch node := n.(type) {
+	case *ast.SimpleStmt:
+		return node == nil
+	case *ast.Expression:
+		return node == nil
+	case *ast.TypeSwitchGuard:
+		return node == nil
+	}
+	return false
+}
+
+// The number of values taken from the call, that can be all the values assigned
+// by its statement, and the list of values that it is alone in
+func bubbleValues(primary *ast.PrimaryExpr) (int, *ast.ExpressionList) {
+	unary, ok := primary.GetParent().(*ast.UnaryExpr)
+	if !ok || unary.Op != "" {
+		return 1, nil
+	}
+	expr, ok := unary.GetParent().(*ast.Expression)
+	if !ok {
+		return 1, nil
+	}
+	switch parent := expr.GetParent().(type) {
+	case *ast.SimpleStmt:
+		{
+			// The typed variables take a statement
+			if stmt, ok := parent.GetParent().(*ast.Statement); ok {
+				if spec, ok := stmt.GetParent().(*ast.VarSpec); ok {
+					return len(spec.IdentifierList.List), nil
+				}
+			}
+			return 0, nil
+		}
+	case *ast.ExpressionList:
+		{
+			if len(parent.Expressions) != 1 {
+				return 1, nil
+			}
+			switch stmt := parent.GetParent().(type) {
+			case *ast.ShortVarDecl:
+				return len(stmt.IdentifierList.List), parent
+			case *ast.VarSpec:
+				return len(stmt.IdentifierList.List), parent
+			case *ast.Assignment:
+				{
+					if stmt.Right == parent {
+						return len(stmt.Left.Expressions), parent
+					}
+				}
+			}
+		}
+	}
+	return 1, nil
+}
+
+// The result types of the function around `n`
+func resultsOf(n common.INode) []*ast.Type {
+	for n != nil {
+		if function, ok := n.(*ast.Function); ok {
+			if function.Signature == nil || function.Signature.Result == nil {
+				return nil
+			}
+			return function.Signature.Result.Types
+		}
+		n = n.GetParent()
+	}
+	return nil
+}
+
+// `0`, `""`, `false` or `nil` when the type is obvious, `*new(T)` otherwise
+func zeroValue(t *ast.Type) *ast.Expression {
+	if t.TypeLit != nil {
+		lit := t.TypeLit
+		if lit.PointerType != nil || lit.SliceType != nil || lit.MapType != nil || lit.ChannelType != nil || lit.FunctionType != nil || lit.InterfaceType != nil {
+			return nameExpr("nil")
+		}
+	} else if isNumeric(t.TypeName) {
+		return nameExpr("0")
+	} else if t.TypeName == "string" {
+		return nameExpr("\"\"")
+	} else if t.TypeName == "bool" {
+		return nameExpr("false")
+	} else if t.TypeName == "error" || t.TypeName == "any" {
+		return nameExpr("nil")
+	}
+	newCall := &ast.PrimaryExpr{
+		Node:        common.NewNodeNoCtx(&ast.PrimaryExpr{}),
+		PrimaryExpr: namePrimary("new"),
+		SecondaryExpr: &ast.SecondaryExpr{
+			Node: common.NewNodeNoCtx(&ast.SecondaryExpr{}),
+			Arguments: &ast.Arguments{
+				Node: common.NewNodeNoCtx(&ast.Arguments{}),
+				Type: t,
+			},
+		},
+	}
+	deref := &ast.UnaryExpr{
+		Node:      common.NewNodeNoCtx(&ast.UnaryExpr{}),
+		Op:        "*",
+		UnaryExpr: unary(newCall),
+	}
+	res := &ast.Expression{
+		Node:      common.NewNodeNoCtx(&ast.Expression{}),
+		UnaryExpr: deref,
+	}
+	return res
+}
+func unary(primary *ast.PrimaryExpr) *ast.UnaryExpr {
+	return &ast.UnaryExpr{
+		Node:        common.NewNodeNoCtx(&ast.UnaryExpr{}),
+		PrimaryExpr: primary,
+	}
+}
+func namePrimary(name string) *ast.PrimaryExpr {
+	operand := &ast.Operand{
+		Node: common.NewNodeNoCtx(&ast.Operand{}),
+		OperandName: &ast.OperandName{
+			Node: common.NewNodeNoCtx(&ast.OperandName{}),
+			Name: name,
+		},
+	}
+	res := &ast.PrimaryExpr{
+		Node:    common.NewNodeNoCtx(&ast.PrimaryExpr{}),
+		Operand: operand,
+	}
+	return res
+}
+
+// A name or a basic literal
+func nameExpr(name string) *ast.Expression {
+	return &ast.Expression{
+		Node:      common.NewNodeNoCtx(&ast.Expression{}),
+		UnaryExpr: unary(namePrimary(name)),
+	}
+}
+func expressionList(exprs []*ast.Expression) *ast.ExpressionList {
+	return &ast.ExpressionList{
+		Node:        common.NewNodeNoCtx(&ast.ExpressionList{}),
+		Expressions: exprs,
+	}
+}
+func shortVarDeclStmt(names []string, value *ast.Expression) *ast.Statement {
+	decl := &ast.ShortVarDecl{
+		Node: common.NewNodeNoCtx(&ast.ShortVarDecl{}),
+		IdentifierList: &ast.IdentifierList{
+			Node: common.NewNodeNoCtx(&ast.IdentifierList{}),
+			List: names,
+		},
+		Expressions: expressionList([]*ast.Expression{value}),
+	}
+	simple := &ast.SimpleStmt{
+		Node:         common.NewNodeNoCtx(&ast.SimpleStmt{}),
+		ShortVarDecl: decl,
+	}
+	res := &ast.Statement{
+		Node:       common.NewNodeNoCtx(&ast.Statement{}),
+		SimpleStmt: simple,
+	}
+	return res
+}
+
+// `if err != nil` returning `returned`
+func errCheckStmt(errName string, returned []*ast.Expression) *ast.Statement {
+	cond := &ast.Expression{
+		Node:            common.NewNodeNoCtx(&ast.Expression{}),
+		LeftExpression:  nameExpr(errName),
+		Op:              "!=",
+		RightExpression: nameExpr("nil"),
+	}
+	ret := &ast.Statement{
+		Node: common.NewNodeNoCtx(&ast.Statement{}),
+		ReturnStmt: &ast.ReturnStmt{
+			Node:        common.NewNodeNoCtx(&ast.ReturnStmt{}),
+			Expressions: expressionList(returned),
+		},
+	}
+	ifStmt := &ast.IfStmt{
+		Node:       common.NewNodeNoCtx(&ast.IfStmt{}),
+		Expression: cond,
+		Block: &ast.Block{
+			Node:       common.NewNodeNoCtx(&ast.Block{}),
+			Statements: []*ast.Statement{ret},
+		},
+	}
+	res := &ast.Statement{
+		Node:   common.NewNodeNoCtx(&ast.Statement{}),
+		IfStmt: ifStmt,
+	}
+	return res
+}
+func RunBubble(file *common.File) error {
+	bubble := Bubble{
+		File:    file,
+		results: make(map[string]int),
+	}
+	for _, top := range file.Ast.(*ast.SourceFile).TopLevels {
+		if top.FunctionDecl == nil {
+			continue
+		}
+		signature := top.FunctionDecl.Signature
+		if top.FunctionDecl.Function != nil {
+			signature = top.FunctionDecl.Function.Signature
+		}
+		if signature.Result != nil {
+			bubble.results[top.FunctionDecl.Name] = len(signature.Result.Types)
+		}
+	}
+	bubble.type_ = &bubble
+	bubble.Walk(file.Ast)
+	return bubble.Errors.Err()
+}
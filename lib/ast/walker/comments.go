@@ -0,0 +1,97 @@
+package walker
+
+import (
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+	"sort"
+	"strings"
+)
+
+// Collects the nodes that print the comments of the source: the package,
+// the imports, the top level declarations, the constants and variables,
+// the statements, the struct fields and the interface methods
+type Comments struct {
+	AstWalker
+	anchors []common.INode
+	lines   []string
+}
+
+func (this *Comments) Before(n common.INode) {
+	switch n.(type) {
+	case *ast.Package, *ast.Import, *ast.ImportSpec, *ast.TopLevel, *ast.ConstSpec, *ast.VarSpec, *ast.Statement, *ast.FieldDecl, *ast.MethodSpec:
+		{
+			this.anchors = append(this.anchors, n)
+		}
+	}
+}
+
+// A comment goes before the first node after it. A comment at the end of
+// a line goes after the innermost node starting on that line, if any
+func (this *Comments) attach(tree common.INode, comment *ast.Comment) {
+	text := comment.Text
+	if strings.HasPrefix(text, "#") {
+		text = "//" + text[1:len(text)]
+	}
+	if comment.Trailing {
+		for i := len(this.anchors) - 1; i >= 0; i-- {
+			if this.anchors[i].Line() == comment.Line {
+				this.anchors[i].AddComment(text, true)
+				return
+			}
+		}
+	}
+	for _, anchor := range this.anchors {
+		// `//go:embed` has to be right above the variable, inside its group
+		if _, ok := anchor.(*ast.VarSpec); !ok && strings.HasPrefix(text, "//go:embed ") {
+			continue
+		}
+		if anchor.Line() > comment.Line {
+			anchor.AddComment(text, false)
+			// Keeps a license header apart from the package documentation,
+			// a directive stays on its declaration
+			if isBuildConstraint(text) || (this.blankAfter(comment) && !isDirective(text)) {
+				anchor.AddComment("", false)
+			}
+			return
+		}
+	}
+	tree.AddComment(text, false)
+}
+func (this *Comments) blankAfter(comment *ast.Comment) bool {
+	next := comment.Line + strings.Count(comment.Text, "\n")
+	return len(this.lines) > next && len(strings.TrimSpace(this.lines[next])) == 0
+}
+
+// `//go:build` and `// +build` lines, that must be followed by a blank line
+func isBuildConstraint(text string) bool {
+	return strings.HasPrefix(text, "//go:build ") || strings.HasPrefix(text, "// +build ")
+}
+
+// Compiler directives, like `//go:noinline` or `//export`
+func isDirective(text string) bool {
+	for _, prefix := range []string{
+		"//go:",
+		"//export ",
+		"//extern ",
+		"//line ",
+	} {
+		if strings.HasPrefix(text, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Attaches the comments of `source` to the nodes of its tree, so they are
+// evaluated with them
+func AttachComments(tree *ast.SourceFile, source string) {
+	comments := Comments{lines: strings.Split(source, "\n")}
+	comments.type_ = &comments
+	comments.Walk(tree)
+	sort.SliceStable(comments.anchors, func(i, j int) bool {
+		return comments.anchors[i].Line() < comments.anchors[j].Line()
+	})
+	for _, comment := range ast.ScanComments(source) {
+		comments.attach(tree, comment)
+	}
+}
@@ -1,26 +1,56 @@
 package walker
 
 import (
+	"github.com/champii/og/lib/ast"
 	"github.com/champii/og/lib/common"
 )
 
 type Desugar struct {
 	Templates *Templates
+	Generics  bool // Go type parameters instead of the templates
+	NoStore   bool // The templates are not written in their package, for --check
 }
 
-func (this *Desugar) Run(files []*common.File) {
+func (this *Desugar) Run(files []*common.File) error {
 	RunGobRegister()
+	errs := common.Errors{}
+	packages := NewPackageScopes(files)
 	for _, file := range files {
-		file.Ast = RunReturnable(file.Ast)
-		RunTemplateLoader(file.Ast, this.Templates)
+		var (
+			pack *PackageScope
+		)
+		if source, ok := file.Ast.(*ast.SourceFile); ok {
+			pack = packages[packageKey(file, source)]
+			RunExhaustive(file, pack)
+		}
+		errs.Add(RunBubble(file))
+		RunEnum(file.Ast)
+		errs.Add(RunReturnable(file, pack))
+		if this.Generics {
+			RunGenerics(file.Ast)
+			continue
+		}
+		errs.Add(RunTemplateLoader(file, this.Templates))
 		RunTemplateParse(file, this.Templates)
 	}
-	this.Templates.Store()
+	if this.Generics {
+		return errs.Err()
+	}
+	if !this.NoStore {
+		errs.Add(this.Templates.Store())
+	}
 	for _, file := range files {
-		RunTemplateUsage(file, this.Templates)
+		var (
+			pack *PackageScope
+		)
+		if source, ok := file.Ast.(*ast.SourceFile); ok {
+			pack = packages[packageKey(file, source)]
+		}
+		errs.Add(RunTemplateUsage(file, this.Templates, pack))
 		RunTemplateGenerator(file.Ast, this.Templates)
 		this.Templates.ResetUsedFor()
 	}
+	return errs.Err()
 }
 func NewDesugar() *Desugar {
 	return &Desugar{Templates: NewTemplates()}
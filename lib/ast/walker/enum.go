@@ -0,0 +1,49 @@
+package walker
+
+import (
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+	"strings"
+)
+
+// The enums print and parse their values with fmt, that is imported when the
+// file does not already give it a name
+func RunEnum(tree common.INode) {
+	source, ok := tree.(*ast.SourceFile)
+	if !ok {
+		return
+	}
+	enums := []*ast.EnumDecl{}
+	for _, top := range source.TopLevels {
+		if top.Declaration != nil && top.Declaration.EnumDecl != nil {
+			enums = append(enums, top.Declaration.EnumDecl)
+		}
+	}
+	if len(enums) == 0 {
+		return
+	}
+	name := ""
+	if source.Import != nil {
+		for _, spec := range source.Import.Items {
+			if strings.TrimSpace(spec.Path) == "\"fmt\"" && spec.Alias != "_" && spec.Alias != "." {
+				name = spec.Alias
+				if name == "" {
+					name = "fmt"
+				}
+			}
+		}
+	}
+	if name == "" {
+		name = "fmt"
+		if source.Import == nil {
+			source.Import = &ast.Import{Node: common.NewNodeNoCtx(&ast.Import{})}
+		}
+		source.Import.Items = append(source.Import.Items, &ast.ImportSpec{
+			Node: common.NewNodeNoCtx(&ast.ImportSpec{}),
+			Path: "\"fmt\"\n",
+		})
+	}
+	for _, decl := range enums {
+		decl.Fmt = name
+	}
+}
@@ -0,0 +1,99 @@
+package walker
+
+import (
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+	"strings"
+)
+
+// Marks the type switches and the matches that handle each variant of a
+// data, so that they can end a function without a return after them
+type Exhaustive struct {
+	ScopeWalker
+	datas map[string][]string
+}
+
+func (this *Exhaustive) AfterTypeSwitchStmt(n common.INode) {
+	stmt := n.(*ast.TypeSwitchStmt)
+	if cases, ok := typeSwitchCases(stmt); ok {
+		missing, isData := missingVariants(this.datas, this.primaryType(stmt.TypeSwitchGuard.PrimaryExpr), cases)
+		stmt.Exhaustive = isData && len(missing) == 0
+	}
+}
+func (this *Exhaustive) AfterMatchStmt(n common.INode) {
+	stmt := n.(*ast.MatchStmt)
+	if cases, ok := matchCases(stmt); ok {
+		missing, isData := missingVariants(this.datas, this.typeOf(stmt.Expression), cases)
+		stmt.Exhaustive = isData && len(missing) == 0
+	}
+}
+
+// The types of the cases of a type switch, false when it has a default one
+func typeSwitchCases(stmt *ast.TypeSwitchStmt) ([]string, bool) {
+	res := []string{}
+	for _, clause := range stmt.TypeCaseClauses {
+		if len(clause.TypeSwitchCase.Types) == 0 {
+			return nil, false
+		}
+		for _, t := range clause.TypeSwitchCase.Types {
+			res = append(res, t.Eval())
+		}
+	}
+	return res, true
+}
+
+// The variants matched as a whole by the arms of a match, false when an arm
+// matches anything. Only the arms without a guard nor compared fields match a
+// whole variant
+func matchCases(stmt *ast.MatchStmt) ([]string, bool) {
+	res := []string{}
+	for _, arm := range stmt.Arms {
+		if arm.Guard != nil {
+			continue
+		}
+		for _, pattern := range arm.Patterns {
+			if pattern.Value == nil && pattern.Type == "" {
+				return nil, false
+			}
+			if len(pattern.Compared()) == 0 {
+				res = append(res, pattern.Type)
+			}
+		}
+	}
+	return res, true
+}
+
+// The variants of the data `t` that no case handles, false when `t` is not a data
+func missingVariants(datas map[string][]string, t string, cases []string) ([]string, bool) {
+	variants, ok := datas[t]
+	if !ok {
+		return nil, false
+	}
+	handled := make(map[string]bool)
+	for _, c := range cases {
+		handled[strings.TrimPrefix(c, "*")] = true
+	}
+	missing := []string{}
+	for _, variant := range variants {
+		if !handled[variant] {
+			missing = append(missing, variant)
+		}
+	}
+	return missing, true
+}
+func RunExhaustive(file *common.File, pack *PackageScope) {
+	exhaustive := Exhaustive{
+		ScopeWalker: ScopeWalker{
+			File: file,
+			stack: &Stack{scopes: []*Scope{
+				NewScope(),
+				pack.scope,
+				universeScope(),
+			},
+			},
+		},
+		datas: pack.datas,
+	}
+	exhaustive.type_ = &exhaustive
+	exhaustive.Walk(file.Ast)
+}
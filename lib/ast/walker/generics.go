@@ -0,0 +1,21 @@
+package walker
+
+import (
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+)
+
+// Lowers the templates to Go type parameters, that need Go 1.18
+type Generics struct {
+	AstWalker
+}
+
+func (this *Generics) TemplateSpec(n common.INode) common.INode {
+	n.(*ast.TemplateSpec).Native = true
+	return n
+}
+func RunGenerics(tree common.INode) {
+	generics := Generics{}
+	generics.type_ = &generics
+	generics.Walk(tree)
+}
@@ -15,6 +15,7 @@ func RunGobRegister() {
 	gob.Register(&ast.TopLevel{})
 	gob.Register(&ast.Declaration{})
 	gob.Register(&ast.ConstDecl{})
+	gob.Register(&ast.EnumDecl{})
 	gob.Register(&ast.ConstSpec{})
 	gob.Register(&ast.ExpressionList{})
 	gob.Register(&ast.Parameters{})
@@ -49,6 +50,9 @@ func RunGobRegister() {
 	gob.Register(&ast.TypeSwitchGuard{})
 	gob.Register(&ast.TypeCaseClause{})
 	gob.Register(&ast.TypeSwitchCase{})
+	gob.Register(&ast.MatchStmt{})
+	gob.Register(&ast.MatchArm{})
+	gob.Register(&ast.Pattern{})
 	gob.Register(&ast.SelectStmt{})
 	gob.Register(&ast.CommClause{})
 	gob.Register(&ast.CommCase{})
@@ -81,6 +85,8 @@ func RunGobRegister() {
 	gob.Register(&ast.Key{})
 	gob.Register(&ast.Element{})
 	gob.Register(&ast.StructType{})
+	gob.Register(&ast.DataType{})
+	gob.Register(&ast.Variant{})
 	gob.Register(&ast.FieldDecl{})
 	gob.Register(&ast.IdentifierList{})
 	gob.Register(&ast.InlineStructMethod{})
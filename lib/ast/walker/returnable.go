@@ -6,36 +6,60 @@ import (
 )
 
 type Returnable struct {
-	AstWalker
-	Root common.INode
+	ScopeWalker
+	Root   common.INode
+	values int // The values given by the body of the last `for`, and the ones that are not
+	voids  int
 }
 
 func (this *Returnable) VarDecl(n common.INode) common.INode {
 	varDecl := n.(*ast.VarDecl)
 	for _, varSpec := range varDecl.VarSpecs {
-		statement := varSpec.Statement
-		if statement == nil {
-			continue
+		if closure := valueClosure(varSpec.Statement, varSpec.Type); closure != nil {
+			varSpec.Statement = closure
 		}
-		ifStmt := statement.IfStmt
-		if ifStmt == nil {
-			if statement.Block != nil && len(statement.Block.Statements) == 1 && statement.Block.Statements[0].IfStmt != nil {
-				ifStmt = statement.Block.Statements[0].IfStmt
-			} else {
-				continue
-			}
-		}
-		varSpec.Statement = ifStmt.MakeReturnClosureStatement(varSpec.Type)
 	}
 	return varDecl
 }
+
+// `x := switch ...` gives the type of the values of the statement to its
+// closure. The stack still has the scope of the declaration
+func (this *Returnable) AfterShortVarDecl(n common.INode) {
+	decl := n.(*ast.ShortVarDecl)
+	if decl.Statement == nil {
+		this.ScopeWalker.AfterShortVarDecl(n)
+		return
+	}
+	types, msg := this.shortVarTypes(decl)
+	if msg != "" {
+		this.error(n, msg, "")
+		return
+	}
+	if types[0] == "" {
+		this.error(n, inferError(decl), "")
+		return
+	}
+	t := &ast.Type{
+		Node:     common.NewNodeNoCtx(&ast.Type{}),
+		TypeName: types[0],
+	}
+	closure := valueClosure(decl.Statement, t)
+	decl.Expressions = &ast.ExpressionList{
+		Node:        common.NewNodeNoCtx(&ast.ExpressionList{}),
+		Expressions: []*ast.Expression{closure.SimpleStmt.Expression},
+	}
+	decl.Statement = nil
+	this.declare(decl.IdentifierList.List[0], types[0], n)
+}
 func (this *Returnable) Function(n common.INode) common.INode {
 	function := n.(*ast.Function)
 	sig := function.Signature
+	/* No signature means no return type */
 	if sig == nil {
 		return n
 	}
 	retType := sig.Result
+	/* We don't support multiple return type (yet) */
 	if retType == nil || len(retType.Types) != 1 {
 		return n
 	}
@@ -49,12 +73,114 @@ func (this *Returnable) Function(n common.INode) common.INode {
 			if last.IfStmt != nil {
 				last.IfStmt.AddReturn()
 			}
+			if last.SwitchStmt != nil {
+				last.SwitchStmt.AddReturn()
+			}
+			if last.SelectStmt != nil {
+				last.SelectStmt.AddReturn()
+			}
+			/* A last `for` collects its values when a slice is returned */
+			if last.ForStmt != nil && isSlice(retType.Types[0]) && this.collects(last.ForStmt) {
+				stmts := last.ForStmt.Collect(retType.Types[0])
+				stmts[1] = last
+				block.Statements = append(block.Statements[:len(block.Statements)-1], stmts...)
+			}
 		}
 	}
 	return n
 }
-func RunReturnable(tree common.INode) common.INode {
-	returnable := Returnable{Root: tree}
+
+// A `for` collects when it ends and when its body gives a value in each of
+// its branches, an infinite loop or a `println(x)` are left alone
+func (this *Returnable) collects(stmt *ast.ForStmt) bool {
+	if !stmt.Ends() {
+		return false
+	}
+	this.values = 0
+	this.voids = 0
+	stmt.Block.EachValue(this.countValue)
+	return this.values > 0 && this.voids == 0
+}
+func (this *Returnable) countValue(expr *ast.Expression) {
+	if this.hasValue(expr) {
+		this.values++
+	} else {
+		this.voids++
+	}
+}
+
+// A call has a value unless its function is known to give none, or several
+func (this *Returnable) hasValue(expr *ast.Expression) bool {
+	if !isCall(expr) {
+		return true
+	}
+	name := calleeName(primaryOf(expr))
+	if sig := this.stack.GetFunc(name); sig != nil {
+		return len(sig.returns) == 1
+	}
+	t, _ := this.stack.GetVar(name)
+	return t != "builtin" || !voidBuiltins[name]
+}
+
+// The closure that gives the value of an `if`, a `switch`, a `select` or a
+// collecting `for`, nil when `statement` is not one of them
+func valueClosure(statement *ast.Statement, t *ast.Type) *ast.Statement {
+	if statement == nil {
+		return nil
+	}
+	if statement.SwitchStmt != nil {
+		return statement.SwitchStmt.MakeReturnClosureStatement(t)
+	}
+	if statement.SelectStmt != nil {
+		return statement.SelectStmt.MakeReturnClosureStatement(t)
+	}
+	if statement.ForStmt != nil {
+		return statement.ForStmt.MakeReturnClosureStatement(t)
+	}
+	ifStmt := statement.IfStmt
+	/* Hack: Get the inner ifStmt if existant */
+	if ifStmt == nil && statement.Block != nil && len(statement.Block.Statements) == 1 {
+		ifStmt = statement.Block.Statements[0].IfStmt
+	}
+	if ifStmt == nil {
+		return nil
+	}
+	return ifStmt.MakeReturnClosureStatement(t)
+}
+
+// The builtins that give no value
+var (
+	voidBuiltins = map[string]bool{
+		"clear":   true,
+		"close":   true,
+		"delete":  true,
+		"panic":   true,
+		"print":   true,
+		"println": true,
+	}
+)
+
+func isSlice(t *ast.Type) bool {
+	return t.TypeLit != nil && t.TypeLit.SliceType != nil
+}
+
+// The package scope is nil when the functions of the package are not known
+func RunReturnable(file *common.File, pack *PackageScope) error {
+	stack := &Stack{scopes: []*Scope{universeScope()}}
+	if pack != nil {
+		stack.scopes = []*Scope{
+			pack.scope,
+			universeScope(),
+		}
+	}
+	returnable := Returnable{
+		ScopeWalker: ScopeWalker{
+			File:  file,
+			stack: stack,
+		},
+		Root: file.Ast,
+	}
 	returnable.type_ = &returnable
-	return returnable.Walk(tree)
+	file.Ast = returnable.Walk(file.Ast)
+	return returnable.Errors.Err()
 }
@@ -0,0 +1,548 @@
+package walker
+
+import (
+	"fmt"
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+	"strings"
+)
+
+// The type of each name, "" when it is not known. The types, the functions,
+// the builtins and the packages are named "type", "func", "builtin" and "package"
+type Scope struct {
+	vars  map[string]string
+	funcs map[string]*FuncSig
+	nodes map[string]common.INode // The declaration of each name
+}
+
+func NewScope() *Scope {
+	return &Scope{
+		vars:  make(map[string]string),
+		funcs: make(map[string]*FuncSig),
+		nodes: make(map[string]common.INode),
+	}
+}
+
+type Stack struct {
+	scopes []*Scope
+}
+
+func (this *Stack) PushScope() {
+	this.scopes = append([]*Scope{NewScope()}, this.scopes...)
+}
+func (this *Stack) PopScope() {
+	this.scopes = this.scopes[1:]
+}
+
+// Declares `name` in the innermost scope, false if it is already there
+func (this *Stack) AddVar(name, t string) bool {
+	if _, ok := this.scopes[0].vars[name]; ok {
+		return false
+	}
+	this.scopes[0].vars[name] = t
+	return true
+}
+func (this *Stack) GetVar(name string) (string, bool) {
+	for _, scope := range this.scopes {
+		if t, ok := scope.vars[name]; ok {
+			return t, true
+		}
+	}
+	return "", false
+}
+func (this *Stack) AddFunc(name string, f *FuncSig) bool {
+	if !this.AddVar(name, "func") {
+		return false
+	}
+	this.scopes[0].funcs[name] = f
+	return true
+}
+
+// nil when `name` is not a function, or when a variable hides it
+func (this *Stack) GetFunc(name string) *FuncSig {
+	for _, scope := range this.scopes {
+		if _, ok := scope.vars[name]; ok {
+			return scope.funcs[name]
+		}
+	}
+	return nil
+}
+
+// The node that declares `name` in the closest scope, nil when it is not known
+func (this *Stack) GetNode(name string) common.INode {
+	for _, scope := range this.scopes {
+		if _, ok := scope.vars[name]; ok {
+			return scope.nodes[name]
+		}
+	}
+	return nil
+}
+
+// Declares the names scope by scope while walking a file, and gives the
+// type of the expressions when it is obvious
+type ScopeWalker struct {
+	AstWalker
+	File     *common.File
+	Errors   common.Errors
+	stack    *Stack
+	results  map[*ast.Arguments]string  // The type of the template calls
+	strict   bool                       // A name declared twice in a scope is reported
+	branches map[*ast.Expression]string // The values of a statement given to a `:=`, typed in their own scope
+}
+
+func (this *ScopeWalker) BeforeFunction(n common.INode) {
+	this.stack.PushScope()
+	sig := n.(*ast.Function).Signature
+	if sig.TemplateSpec != nil {
+		for _, t := range sig.TemplateSpec.Result.Types {
+			this.stack.AddVar(t.Eval(), "type")
+		}
+	}
+	if sig.Parameters == nil {
+		return
+	}
+	for _, param := range sig.Parameters.List {
+		if param.IdentifierList == nil {
+			continue
+		}
+		t := param.Type.Eval()
+		if param.IsVariadic {
+			t = "[]" + t
+		}
+		for _, name := range param.IdentifierList.List {
+			this.declare(name, t, param)
+		}
+	}
+}
+func (this *ScopeWalker) AfterFunction(n common.INode) {
+	this.stack.PopScope()
+}
+
+// The body of a function shares the scope of its parameters
+func (this *ScopeWalker) BeforeBlock(n common.INode) {
+	if _, ok := n.GetParent().(*ast.Function); !ok {
+		this.stack.PushScope()
+	}
+}
+func (this *ScopeWalker) AfterBlock(n common.INode) {
+	if _, ok := n.GetParent().(*ast.Function); !ok {
+		this.stack.PopScope()
+	}
+}
+func (this *ScopeWalker) BeforeIfStmt(n common.INode) {
+	this.stack.PushScope()
+}
+func (this *ScopeWalker) AfterIfStmt(n common.INode) {
+	this.stack.PopScope()
+}
+func (this *ScopeWalker) BeforeForStmt(n common.INode) {
+	this.stack.PushScope()
+}
+func (this *ScopeWalker) AfterForStmt(n common.INode) {
+	this.stack.PopScope()
+}
+func (this *ScopeWalker) BeforeSwitchStmt(n common.INode) {
+	this.stack.PushScope()
+}
+func (this *ScopeWalker) AfterSwitchStmt(n common.INode) {
+	this.stack.PopScope()
+}
+func (this *ScopeWalker) BeforeExprCaseClause(n common.INode) {
+	this.stack.PushScope()
+}
+func (this *ScopeWalker) AfterExprCaseClause(n common.INode) {
+	this.stack.PopScope()
+}
+func (this *ScopeWalker) BeforeCommClause(n common.INode) {
+	this.stack.PushScope()
+}
+func (this *ScopeWalker) AfterCommClause(n common.INode) {
+	this.stack.PopScope()
+}
+func (this *ScopeWalker) AfterTypeCaseClause(n common.INode) {
+	this.stack.PopScope()
+}
+func (this *ScopeWalker) AfterStructType(n common.INode) {
+	this.stack.PopScope()
+}
+func (this *ScopeWalker) AfterMatchArm(n common.INode) {
+	this.stack.PopScope()
+}
+
+// The variable of the guard has the type of the case, when there is only one
+func (this *ScopeWalker) BeforeTypeCaseClause(n common.INode) {
+	this.stack.PushScope()
+	clause := n.(*ast.TypeCaseClause)
+	stmt, ok := clause.GetParent().(*ast.TypeSwitchStmt)
+	if !ok || stmt.TypeSwitchGuard.Name == "" {
+		return
+	}
+	t := ""
+	if len(clause.TypeSwitchCase.Types) == 1 {
+		t = clause.TypeSwitchCase.Types[0].Eval()
+	}
+	this.stack.AddVar(stmt.TypeSwitchGuard.Name, t)
+	this.stack.scopes[0].nodes[stmt.TypeSwitchGuard.Name] = stmt.TypeSwitchGuard
+}
+
+// The bindings and the names of the fields are seen by the guard. A binding
+// has the type of the pattern when it is alone
+func (this *ScopeWalker) BeforeMatchArm(n common.INode) {
+	this.stack.PushScope()
+	arm := n.(*ast.MatchArm)
+	for _, pattern := range arm.Patterns {
+		if pattern.Binding == "" || pattern.Binding == "_" {
+			continue
+		}
+		t := ""
+		if len(arm.Patterns) == 1 {
+			t = pattern.Type
+		}
+		this.stack.AddVar(pattern.Binding, t)
+		this.stack.scopes[0].nodes[pattern.Binding] = pattern
+	}
+	// Several patterns giving names to their fields are reported by the
+	// type checker
+	for _, pattern := range arm.Patterns {
+		for _, name := range pattern.FieldNames() {
+			if len(arm.Patterns) == 1 {
+				this.declare(name, "", pattern)
+				continue
+			}
+			this.stack.AddVar(name, "")
+			this.stack.scopes[0].nodes[name] = pattern
+		}
+	}
+}
+func (this *ScopeWalker) BeforeStructType(n common.INode) {
+	this.stack.PushScope()
+	structType := n.(*ast.StructType)
+	if structType.TemplateSpec != nil {
+		for _, t := range structType.TemplateSpec.Result.Types {
+			this.stack.AddVar(t.Eval(), "type")
+		}
+	}
+}
+
+// The values of `x := switch ...` are typed while their branch is walked
+func (this *ScopeWalker) BeforeShortVarDecl(n common.INode) {
+	decl := n.(*ast.ShortVarDecl)
+	if decl.Statement == nil {
+		return
+	}
+	if this.branches == nil {
+		this.branches = make(map[*ast.Expression]string)
+	}
+	eachValue(decl.Statement, func(expr *ast.Expression) {
+		this.branches[expr] = ""
+	})
+}
+func (this *ScopeWalker) AfterExpression(n common.INode) {
+	expr := n.(*ast.Expression)
+	if _, ok := this.branches[expr]; ok {
+		this.branches[expr] = this.typeOf(expr)
+	}
+}
+
+// The names are declared after their values are resolved
+func (this *ScopeWalker) AfterShortVarDecl(n common.INode) {
+	decl := n.(*ast.ShortVarDecl)
+	names := decl.IdentifierList.List
+	types, _ := this.shortVarTypes(decl)
+	for i, name := range names {
+		if _, ok := this.stack.scopes[0].vars[name]; !ok {
+			this.declare(name, defaultType(types[i]), n)
+		}
+	}
+}
+func (this *ScopeWalker) AfterVarSpec(n common.INode) {
+	spec := n.(*ast.VarSpec)
+	this.declareValues(n, spec.IdentifierList.List, spec.Type, varValues(spec), false)
+}
+func (this *ScopeWalker) AfterConstSpec(n common.INode) {
+	spec := n.(*ast.ConstSpec)
+	this.declareValues(n, spec.IdentifierList.List, spec.Type, spec.ExpressionList, true)
+}
+func (this *ScopeWalker) AfterRangeClause(n common.INode) {
+	clause := n.(*ast.RangeClause)
+	if clause.IdentifierList != nil {
+		for _, name := range clause.IdentifierList.List {
+			this.declare(name, "", n)
+		}
+	}
+}
+func (this *ScopeWalker) AfterRecvStmt(n common.INode) {
+	stmt := n.(*ast.RecvStmt)
+	if stmt.IdentifierList != nil {
+		for _, name := range stmt.IdentifierList.List {
+			this.declare(name, "", n)
+		}
+	}
+}
+func (this *ScopeWalker) error(n common.INode, msg, msg2 string) {
+	this.Errors = append(this.Errors, this.File.Error(n.Line(), n.Col(), msg, msg2))
+}
+func (this *ScopeWalker) declare(name, t string, n common.INode) {
+	if name == "_" {
+		return
+	}
+	if !this.stack.AddVar(name, t) {
+		if this.strict {
+			this.error(n, "Already declared", name)
+		}
+		return
+	}
+	this.stack.scopes[0].nodes[name] = n
+}
+
+// Each name takes its type, or the one of its value. A constant keeps its untyped value
+func (this *ScopeWalker) declareValues(n common.INode, names []string, t *ast.Type, list *ast.ExpressionList, constant bool) {
+	types, _ := this.valueTypes(len(names), list)
+	for i, name := range names {
+		if t != nil {
+			types[i] = t.Eval()
+		} else if !constant {
+			types[i] = defaultType(types[i])
+		}
+		this.declare(name, types[i], n)
+	}
+}
+
+// The types of the values of a `:=`, and the mismatch between the names and
+// the values. A statement gives a single value
+func (this *ScopeWalker) shortVarTypes(decl *ast.ShortVarDecl) ([]string, string) {
+	count := len(decl.IdentifierList.List)
+	if decl.Statement == nil {
+		return this.valueTypes(count, decl.Expressions)
+	}
+	res := make([]string, count)
+	res[0] = this.statementType(decl.Statement)
+	if count != 1 {
+		return res, fmt.Sprintf("Assignment mismatch: %s but 1 value", plural(count, "variable"))
+	}
+	return res, ""
+}
+
+// The type of the values of an `if`, a `switch`, a `select` or a `for` given
+// as a value, "" when one of them is not known or when they differ. The values
+// of a `for` are collected in a slice
+func (this *ScopeWalker) statementType(stmt *ast.Statement) string {
+	exprs := []*ast.Expression{}
+	eachValue(stmt, func(expr *ast.Expression) {
+		exprs = append(exprs, expr)
+	})
+	types := []string{}
+	for _, expr := range exprs {
+		if !this.isVoid(expr) {
+			types = append(types, this.branches[expr])
+		}
+	}
+	t := unify(types)
+	if stmt.ForStmt != nil && t != "" {
+		return "[]" + t
+	}
+	return t
+}
+
+// A call to a builtin that gives no value, like the `panic` of a branch
+func (this *ScopeWalker) isVoid(expr *ast.Expression) bool {
+	if !isCall(expr) {
+		return false
+	}
+	name := calleeName(primaryOf(expr))
+	t, _ := this.stack.GetVar(name)
+	return t == "builtin" && voidBuiltins[name]
+}
+
+// The types of the values assigned to `count` names, "" when unknown,
+// and the mismatch between the names and the values
+func (this *ScopeWalker) valueTypes(count int, list *ast.ExpressionList) ([]string, string) {
+	res := make([]string, count)
+	if list == nil {
+		return res, ""
+	}
+	exprs := list.Expressions
+	if len(exprs) == count {
+		for i, expr := range exprs {
+			res[i] = this.typeOf(expr)
+		}
+		return res, ""
+	}
+	if len(exprs) == 1 {
+		// `f()?` gives the values of `f` but its error
+		if primary := primaryOf(exprs[0]); isBubble(primary) {
+			if sig := this.stack.GetFunc(calleeName(primary.PrimaryExpr)); sig != nil && len(sig.returns) > 0 {
+				if len(sig.returns)-1 != count {
+					return res, fmt.Sprintf("Assignment mismatch: %s but %s? returns %s", plural(count, "variable"), sig.name, plural(len(sig.returns)-1, "value"))
+				}
+				copy(res, sig.returns)
+			}
+			return res, ""
+		}
+		if sig := this.stack.GetFunc(calleeName(primaryOf(exprs[0]))); sig != nil {
+			if len(sig.returns) != count {
+				return res, fmt.Sprintf("Assignment mismatch: %s but %s returns %s", plural(count, "variable"), sig.name, plural(len(sig.returns), "value"))
+			}
+			copy(res, sig.returns)
+			return res, ""
+		}
+		if isCall(exprs[0]) || (count == 2 && commaOk(exprs[0])) {
+			return res, ""
+		}
+	}
+	return res, fmt.Sprintf("Assignment mismatch: %s but %s", plural(count, "variable"), plural(len(exprs), "value"))
+}
+
+// The type of an expression when it is obvious, "" otherwise
+func (this *ScopeWalker) typeOf(expr *ast.Expression) string {
+	if expr.UnaryExpr != nil {
+		return this.unaryType(expr.UnaryExpr)
+	}
+	op := expr.Op
+	switch op {
+	case "==", "!=", "<", "<=", ">", ">=", "&&", "||":
+		{
+			return "untyped bool"
+		}
+	case "<<", ">>":
+		{
+			return this.typeOf(expr.LeftExpression)
+		}
+	}
+	left := this.typeOf(expr.LeftExpression)
+	right := this.typeOf(expr.RightExpression)
+	if left == right || right == "" {
+		return left
+	}
+	if left == "" {
+		return right
+	}
+	// An untyped constant takes the type of the other operand
+	if !isUntyped(left) {
+		return left
+	}
+	if !isUntyped(right) {
+		return right
+	}
+	if left == "untyped float" || right == "untyped float" {
+		return "untyped float"
+	}
+	if left == "untyped rune" || right == "untyped rune" {
+		return "untyped rune"
+	}
+	return left
+}
+func (this *ScopeWalker) unaryType(unary *ast.UnaryExpr) string {
+	if unary.PrimaryExpr != nil {
+		return this.primaryType(unary.PrimaryExpr)
+	}
+	inner := this.unaryType(unary.UnaryExpr)
+	if unary.Op == "&" && inner != "" && !isUntyped(inner) {
+		return "*" + inner
+	}
+	if unary.Op == "*" && strings.HasPrefix(inner, "*") {
+		return inner[1:]
+	}
+	if unary.Op == "!" || unary.Op == "-" || unary.Op == "+" || unary.Op == "^" {
+		return inner
+	}
+	return ""
+}
+func (this *ScopeWalker) primaryType(primary *ast.PrimaryExpr) string {
+	if primary.Operand != nil {
+		return this.operandType(primary.Operand)
+	}
+	if primary.Conversion != nil {
+		return primary.Conversion.Type.Eval()
+	}
+	if primary.SecondaryExpr != nil && primary.SecondaryExpr.Arguments != nil {
+		if t, ok := this.results[primary.SecondaryExpr.Arguments]; ok {
+			return t
+		}
+	}
+	name := calleeName(primary)
+	if sig := this.stack.GetFunc(name); sig != nil {
+		if len(sig.returns) == 1 {
+			return sig.returns[0]
+		}
+		return ""
+	}
+	t, _ := this.stack.GetVar(name)
+	if t == "type" {
+		return name
+	}
+	if t == "builtin" && (name == "len" || name == "cap" || name == "copy") {
+		return "int"
+	}
+	return ""
+}
+func (this *ScopeWalker) operandType(operand *ast.Operand) string {
+	if operand.Expression != nil {
+		return this.typeOf(operand.Expression)
+	}
+	if operand.Literal != nil {
+		return literalType(operand.Literal)
+	}
+	if operand.OperandName == nil || strings.Contains(operand.OperandName.Name, ".") {
+		return ""
+	}
+	t, _ := this.stack.GetVar(operand.OperandName.Name)
+	if t == "type" || t == "func" || t == "builtin" || t == "package" {
+		return ""
+	}
+	return t
+}
+
+// A typed variable takes a statement, that is a simple expression most of the time
+func varValues(spec *ast.VarSpec) *ast.ExpressionList {
+	if stmt := spec.Statement; stmt != nil && stmt.SimpleStmt != nil && stmt.SimpleStmt.Expression != nil {
+		return &ast.ExpressionList{Expressions: []*ast.Expression{stmt.SimpleStmt.Expression}}
+	}
+	return spec.ExpressionList
+}
+
+// Calls `f` on each value of a statement given to a `:=`
+func eachValue(stmt *ast.Statement, f func(*ast.Expression)) {
+	if stmt.ForStmt != nil {
+		stmt.ForStmt.Block.EachValue(f)
+	} else {
+		(&ast.Block{Statements: []*ast.Statement{stmt}}).EachValue(f)
+	}
+}
+
+// The type that all the `types` can be given, "" when there is none. The
+// untyped constants take the type of the typed values, or their default type
+func unify(types []string) string {
+	typed := ""
+	untyped := ""
+	for _, t := range types {
+		if t == "" {
+			return ""
+		}
+		if !isUntyped(t) {
+			if typed != "" && canonicalType(typed) != canonicalType(t) {
+				return ""
+			}
+			typed = t
+		} else if untyped == "" || untyped == "untyped int" || (untyped == "untyped rune" && t == "untyped float") {
+			untyped = t
+		}
+	}
+	if typed == "" {
+		for _, t := range types {
+			if t != untyped && !(untypedNumber(t) && untypedNumber(untyped)) {
+				return ""
+			}
+		}
+		return defaultType(untyped)
+	}
+	for _, t := range types {
+		if isUntyped(t) && !assignable(t, typed) {
+			return ""
+		}
+	}
+	return typed
+}
+func untypedNumber(t string) bool {
+	return t == "untyped int" || t == "untyped float" || t == "untyped rune"
+}
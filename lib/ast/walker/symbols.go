@@ -0,0 +1,172 @@
+package walker
+
+import (
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	SymbolFunction = "function"
+)
+const (
+	SymbolMethod = "method"
+)
+const (
+	SymbolStruct = "struct"
+)
+const (
+	SymbolInterface = "interface"
+)
+
+type Symbol struct {
+	Name      string
+	Kind      string
+	Container string
+	Line      int
+	Col       int
+}
+type Symbols struct {
+	AstWalker
+	Root    common.INode
+	Symbols []*Symbol
+}
+
+func (this *Symbols) add(name, kind, container string, n common.INode) {
+	symbol := &Symbol{
+		Name:      name,
+		Kind:      kind,
+		Container: container,
+		Line:      n.Line(),
+		Col:       n.Col(),
+	}
+	this.Symbols = append(this.Symbols, symbol)
+}
+func (this *Symbols) FunctionDecl(n common.INode) common.INode {
+	fDecl := n.(*ast.FunctionDecl)
+	// Inline struct methods are declared as FunctionDecl inside a FieldDecl
+	if method, ok := fDecl.GetParent().(*ast.InlineStructMethod); ok {
+		if field, ok := method.GetParent().(*ast.FieldDecl); ok {
+			if structType, ok := field.GetParent().(*ast.StructType); ok {
+				this.add(fDecl.Name, SymbolMethod, structType.Name, n)
+				return n
+			}
+		}
+	}
+	this.add(fDecl.Name, SymbolFunction, "", n)
+	return n
+}
+func (this *Symbols) MethodDecl(n common.INode) common.INode {
+	mDecl := n.(*ast.MethodDecl)
+	this.add(mDecl.Receiver.Method, SymbolMethod, mDecl.Receiver.Package, n)
+	return n
+}
+func (this *Symbols) StructType(n common.INode) common.INode {
+	structType := n.(*ast.StructType)
+	if structType.Name != "" {
+		this.add(structType.Name, SymbolStruct, "", n)
+	}
+	return n
+}
+func (this *Symbols) InterfaceType(n common.INode) common.INode {
+	interfaceType := n.(*ast.InterfaceType)
+	if interfaceType.Name != "" {
+		this.add(interfaceType.Name, SymbolInterface, "", n)
+	}
+	return n
+}
+func RunSymbols(tree common.INode) []*Symbol {
+	symbols := Symbols{Root: tree}
+	symbols.type_ = &symbols
+	symbols.Walk(tree)
+	return symbols.Symbols
+}
+
+// Resolves the names scope by scope, until the one at `line` and `col`
+type Definition struct {
+	ScopeWalker
+	line     int
+	col      int
+	receiver string // The type of `this` in the current method
+	Found    common.INode
+	Selector bool   // The name follows a dot: a method or a field
+	Type     string // The type the selector is taken from, "" when it is not known
+	Imported bool   // The selector is taken from a package
+}
+
+func (this *Definition) BeforeMethodDecl(n common.INode) {
+	this.receiver = n.(*ast.MethodDecl).Receiver.Package
+}
+func (this *Definition) BeforeStructType(n common.INode) {
+	this.ScopeWalker.BeforeStructType(n)
+	if name := n.(*ast.StructType).Name; name != "" {
+		this.receiver = name
+	}
+}
+
+// The name is the first part of the operand or follows one of its dots,
+// like in `this.name` or `fmt.Println`
+func (this *Definition) OperandName(n common.INode) {
+	name := n.(*ast.OperandName).Name
+	if this.Found != nil || this.Selector || n.Line() != this.line || this.col < n.Col() || this.col >= n.Col()+utf8.RuneCountInString(name) {
+		return
+	}
+	parts := strings.Split(name, ".")
+	if this.col == n.Col() {
+		this.Found = this.stack.GetNode(parts[0])
+		return
+	}
+	this.Selector = true
+	// Only the member of a single name is known
+	if len(parts) == 2 && this.col == n.Col()+utf8.RuneCountInString(parts[0])+1 {
+		this.selectFrom(parts[0])
+	}
+}
+
+// The name follows the dot of a selector
+func (this *Definition) PrimaryExpr(n common.INode) {
+	primary := n.(*ast.PrimaryExpr)
+	second := primary.SecondaryExpr
+	if this.Selector || second == nil || second.Selector == "" || second.Line() != this.line || second.Col()+1 != this.col {
+		return
+	}
+	this.Selector = true
+	if operand := primary.PrimaryExpr.Operand; operand != nil && operand.OperandName != nil && !strings.Contains(operand.OperandName.Name, ".") {
+		this.selectFrom(operand.OperandName.Name)
+	} else {
+		this.Type = strings.TrimPrefix(this.primaryType(primary.PrimaryExpr), "*")
+	}
+}
+
+// The type of a selector taken from the name `name`
+func (this *Definition) selectFrom(name string) {
+	if name == "this" {
+		this.Type = this.receiver
+		return
+	}
+	t, _ := this.stack.GetVar(name)
+	switch t {
+	case "type":
+		this.Type = name
+	case "package":
+		this.Imported = true
+	default:
+		this.Type = strings.TrimPrefix(t, "*")
+	}
+}
+
+// What the name at `line` and `col` refers to: the declaration of a local
+// name, or the type of a selector. Found is nil for the functions and the
+// types of the top level
+func RunDefinition(tree common.INode, line, col int) *Definition {
+	definition := Definition{
+		ScopeWalker: ScopeWalker{stack: &Stack{scopes: []*Scope{NewScope()}},
+		},
+		line: line,
+		col:  col,
+	}
+	definition.type_ = &definition
+	definition.Walk(tree)
+	return &definition
+}
@@ -3,13 +3,11 @@ package walker
 import (
 	"bytes"
 	"encoding/gob"
-	"fmt"
 	"github.com/champii/og/lib/common"
 	"io/ioutil"
 	"os"
 	"path"
 	"reflect"
-	"strings"
 )
 
 type Template struct {
@@ -17,7 +15,7 @@ type Template struct {
 	Pack         string
 	Types        []string
 	UsedFor      [][]string
-	GeneratedFor map[string][]string
+	GeneratedFor map[string][]string // Mangle(UsedFor[i]...) -> []PackageName
 	Node         common.INode
 }
 
@@ -30,17 +28,26 @@ func (this Template) contains(arr []string, str string) bool {
 	return false
 }
 func (this *Template) IsGeneratedFor(types []string, packageName string) bool {
-	serie := strings.Join(types, ",")
+	serie := Mangle(types...)
 	packages, _ := this.GeneratedFor[serie]
 	return this.contains(packages, packageName)
 }
 func (this *Template) AddGeneratedFor(types []string, packageName string) {
-	serie := strings.Join(types, ",")
+	serie := Mangle(types...)
 	packages, _ := this.GeneratedFor[serie]
 	if !this.contains(packages, packageName) {
 		this.GeneratedFor[serie] = append(this.GeneratedFor[serie], packageName)
 	}
 }
+
+// The Go name of the instance of the template for `types`. It keeps the whole
+// import path of the package, as two packages can have the same name
+func (this Template) InstanceName(types []string) string {
+	return Mangle(append([]string{
+		this.Pack,
+		this.Name,
+	}, types...)...)
+}
 func (this *Template) AddUsedFor(types []string) {
 	for _, usedFor := range this.UsedFor {
 		if reflect.DeepEqual(types, usedFor) {
@@ -62,11 +69,19 @@ func NewTemplate(name, pack string, types []string, node common.INode) *Template
 
 type Templates struct {
 	Names     []string
-	Packages  []string
+	Packages  []string // The import paths
 	Templates []*Template
+	dirs      map[string]string // The folder of the packages being compiled
 }
 
+// A template of a package replaces the one with the same name
 func (this *Templates) Add(name, pack string, template *Template) {
+	for i, n := range this.Names {
+		if n == name && this.Packages[i] == pack {
+			this.Templates[i] = template
+			return
+		}
+	}
 	this.Names = append(this.Names, name)
 	this.Packages = append(this.Packages, pack)
 	this.Templates = append(this.Templates, template)
@@ -79,23 +94,31 @@ func (this *Templates) Get(name, pack string) *Template {
 	}
 	return nil
 }
+func (this *Templates) AddParsed(pack, dir string) {
+	this.dirs[pack] = dir
+}
+func (this Templates) IsParsed(pack string) bool {
+	_, ok := this.dirs[pack]
+	return ok
+}
 func (this Templates) ResetUsedFor() {
 	for _, template := range this.Templates {
 		template.UsedFor = [][]string{}
 	}
 }
-func (this *Templates) Decode(content []byte) {
+func (this *Templates) Decode(content []byte) error {
 	arr := []*TemplateSerie{}
 	buf := bytes.NewBuffer(content)
 	dec := gob.NewDecoder(buf)
 	if err := dec.Decode(&arr); err != nil {
-		panic("ERROR DECODE" + err.Error())
+		return err
 	}
 	for _, tmpl := range arr {
 		this.Add(tmpl.Name, tmpl.Template.Pack, tmpl.Template)
 	}
+	return nil
 }
-func (this *Templates) Encode(arr []*Template) []byte {
+func (this *Templates) Encode(arr []*Template) ([]byte, error) {
 	res := []*TemplateSerie{}
 	for _, template := range arr {
 		res = append(res, &TemplateSerie{
@@ -108,9 +131,9 @@ func (this *Templates) Encode(arr []*Template) []byte {
 	)
 	enc := gob.NewEncoder(&buf)
 	if err := enc.Encode(&res); err != nil {
-		panic("ERROR ENCODE" + err.Error())
+		return nil, err
 	}
-	return buf.Bytes()
+	return buf.Bytes(), nil
 }
 func (this *Templates) byPackage() map[string][]*Template {
 	res := make(map[string][]*Template)
@@ -119,23 +142,35 @@ func (this *Templates) byPackage() map[string][]*Template {
 	}
 	return res
 }
-func (this Templates) Store() {
+
+// The templates of the packages being compiled are written in their folder,
+// the loaded ones are left where they are
+func (this Templates) Store() error {
 	for pack, arr := range this.byPackage() {
-		templateDir := path.Join(pack, ".og")
+		dir, ok := this.dirs[pack]
+		if !ok {
+			continue
+		}
+		templateDir := path.Join(dir, ".og")
 		_, err := os.Stat(templateDir)
 		if err != nil {
 			err = os.Mkdir(templateDir, 0755)
 			if err != nil {
-				fmt.Println("Cannot create template directory: " + templateDir)
-				return
+				return common.NewError(templateDir, nil, 0, 0, "Cannot create template directory", "")
 			}
 		}
-		blob := this.Encode(arr)
-		ioutil.WriteFile(path.Join(templateDir, "template"), blob, 0644)
+		blob, err := this.Encode(arr)
+		if err != nil {
+			return common.NewError(templateDir, nil, 0, 0, "Cannot encode templates: "+err.Error(), "")
+		}
+		if err := ioutil.WriteFile(path.Join(templateDir, "template"), blob, 0644); err != nil {
+			return common.NewError(templateDir, nil, 0, 0, "Cannot write templates: "+err.Error(), "")
+		}
 	}
+	return nil
 }
 func NewTemplates() *Templates {
-	return &Templates{}
+	return &Templates{dirs: make(map[string]string)}
 }
 
 type TemplateSerie struct {
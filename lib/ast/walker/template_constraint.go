@@ -0,0 +1,248 @@
+package walker
+
+import (
+	"github.com/champii/og/lib/ast"
+	goast "go/ast"
+	"go/types"
+	"strings"
+)
+
+// The methods of the interfaces of the standard library a constraint often is
+var (
+	knownInterfaces = map[string][]string{
+		"error":        []string{"Error"},
+		"fmt.Stringer": []string{"String"},
+	}
+)
+
+// A method of a type, the ones of a pointer receiver are not in the method set
+// of the type itself
+type methodFact struct {
+	name    string
+	pointer bool
+}
+
+// What a package tells of its types, to check the constraints of the templates.
+// A type that the package does not declare satisfies any constraint, unless some
+// of its files are not known
+type TypeFacts struct {
+	kinds    map[string]string       // "struct", "interface", or the type it is defined with
+	methods  map[string][]methodFact // The methods of the types and of the interfaces
+	complete bool                    // Every file of the package is known
+}
+
+func (this *TypeFacts) addMethod(t, name string, pointer bool) {
+	this.methods[t] = append(this.methods[t], methodFact{
+		name,
+		pointer,
+	})
+}
+
+// The names of the methods of `t`, a pointer has the ones of both receivers
+func (this *TypeFacts) methodSet(t string) ([]string, bool) {
+	pointer := strings.HasPrefix(t, "*")
+	methods, ok := this.methods[strings.TrimPrefix(t, "*")]
+	res := []string{}
+	for _, method := range methods {
+		if pointer || !method.pointer {
+			res = append(res, method.name)
+		}
+	}
+	return res, ok
+}
+
+// The type under a type of the file, "" when it is not known
+func (this *TypeFacts) underlying(t string) string {
+	for i := 0; i < 10; i++ {
+		if isBasic(t) || strings.HasPrefix(t, "[") || strings.HasPrefix(t, "map[") || strings.HasPrefix(t, "*") || strings.HasPrefix(t, "chan ") || strings.HasPrefix(t, "func") {
+			return t
+		}
+		kind, ok := this.kinds[t]
+		if !ok {
+			return ""
+		}
+		if kind == "struct" || kind == "interface" {
+			return kind
+		}
+		t = kind
+	}
+	return ""
+}
+
+// The methods a constraint asks for, nil when it is not a known interface
+func (this TypeFacts) required(member string, local bool) []string {
+	if methods, ok := knownInterfaces[member]; ok {
+		return methods
+	}
+	if local && this.kinds[member] == "interface" {
+		methods, _ := this.methodSet(member)
+		return methods
+	}
+	return nil
+}
+
+// Whether `t` satisfies `member`, and whether the member is known to tell it
+func (this TypeFacts) satisfies(t, member string, local bool) (bool, bool) {
+	under := this.underlying(t)
+	switch member {
+	case "any":
+		return true, true
+	case "comparable":
+		return !strings.HasPrefix(under, "[]") && !strings.HasPrefix(under, "map[") && !strings.HasPrefix(under, "func"), true
+	case "ordered":
+		return under == "" || contains(ast.OrderedTypes, canonicalType(under)), true
+	}
+	if strings.HasPrefix(member, "~") {
+		return under == "" || canonicalType(under) == canonicalType(member[1:]), true
+	}
+	required := this.required(member, local)
+	// A type of a union, or an interface that is not known
+	if required == nil {
+		if isBasic(member) || local && this.kinds[member] != "" {
+			return canonicalType(t) == canonicalType(member), true
+		}
+		return false, false
+	}
+	methods, ok := this.methodSet(t)
+	// A type of the package that is not known yet cannot be verified
+	if !ok && under == "" {
+		if !this.complete && !strings.Contains(t, ".") {
+			return false, false
+		}
+		return true, true
+	}
+	for _, name := range required {
+		if !contains(methods, name) {
+			return false, true
+		}
+	}
+	return true, true
+}
+
+// Whether `t` satisfies one of the members of the constraint, and whether
+// it is known. The interfaces of the package count only for a template of the package
+func (this TypeFacts) Satisfies(t, constraint string, local bool) (bool, bool) {
+	known := true
+	for _, member := range ast.ConstraintMembers(constraint) {
+		ok, memberKnown := this.satisfies(t, member, local)
+		if ok {
+			return true, true
+		}
+		known = known && memberKnown
+	}
+	return false, known
+}
+
+// The types and the methods of an Og file
+func (this *TypeFacts) declare(source *ast.SourceFile) {
+	for _, top := range source.TopLevels {
+		if top.MethodDecl != nil {
+			receiver := top.MethodDecl.Receiver
+			this.addMethod(receiver.Package, receiver.Method, receiver.IsPointerReceiver)
+		}
+		if top.Declaration == nil {
+			continue
+		}
+		if decl := top.Declaration.EnumDecl; decl != nil {
+			this.kinds[decl.Name] = "int"
+			this.addMethod(decl.Name, "String", false)
+		}
+		decl := top.Declaration.TypeDecl
+		if decl == nil {
+			continue
+		}
+		for _, spec := range decl.TypeSpecs {
+			this.kinds[spec.Name] = spec.Type.Eval()
+		}
+		if st := decl.StructType; st != nil {
+			this.kinds[st.Name] = "struct"
+			for _, field := range st.Fields {
+				if field.InlineStructMethod != nil {
+					this.addMethod(st.Name, field.InlineStructMethod.FunctionDecl.Name, field.InlineStructMethod.IsPointerReceiver)
+				}
+			}
+		}
+		if it := decl.InterfaceType; it != nil {
+			this.kinds[it.Name] = "interface"
+			for _, spec := range it.MethodSpecs {
+				this.addMethod(it.Name, spec.Name, false)
+			}
+		}
+		if decl.DataType != nil {
+			this.kinds[decl.DataType.Name] = "interface"
+			for _, variant := range decl.DataType.Variants {
+				this.kinds[variant.Name] = "struct"
+			}
+		}
+	}
+}
+
+// The types and the methods of a Go file of the package
+func (this *TypeFacts) declareGo(decl goast.Decl) {
+	switch d := decl.(type) {
+	case *goast.FuncDecl:
+		{
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				return
+			}
+			t := d.Recv.List[0].Type
+			star, pointer := t.(*goast.StarExpr)
+			if pointer {
+				t = star.X
+			}
+			if ident, ok := t.(*goast.Ident); ok {
+				this.addMethod(ident.Name, d.Name.Name, pointer)
+			}
+		}
+	case *goast.GenDecl:
+		{
+			for _, spec := range d.Specs {
+				s, ok := spec.(*goast.TypeSpec)
+				if !ok {
+					continue
+				}
+				switch t := s.Type.(type) {
+				case *goast.StructType:
+					this.kinds[s.Name.Name] = "struct"
+				case *goast.InterfaceType:
+					{
+						this.kinds[s.Name.Name] = "interface"
+						for _, method := range t.Methods.List {
+							for _, name := range method.Names {
+								this.addMethod(s.Name.Name, name.Name, false)
+							}
+						}
+					}
+				default:
+					this.kinds[s.Name.Name] = types.ExprString(s.Type)
+				}
+			}
+		}
+	}
+}
+func NewTypeFacts() *TypeFacts {
+	return &TypeFacts{
+		kinds:    make(map[string]string),
+		methods:  make(map[string][]methodFact),
+		complete: true,
+	}
+}
+func contains(arr []string, str string) bool {
+	for _, item := range arr {
+		if str == item {
+			return true
+		}
+	}
+	return false
+}
+
+// The type parameters of a template
+func templateSpecOf(node interface{}) *ast.TemplateSpec {
+	switch n := node.(type) {
+	case *ast.FunctionDecl:
+		return signatureOf(n).TemplateSpec
+	case *ast.StructType:
+		return n.TemplateSpec
+	}
+	return nil
+}
@@ -6,8 +6,6 @@ import (
 	"fmt"
 	"github.com/champii/og/lib/ast"
 	"github.com/champii/og/lib/common"
-	"path"
-	"strings"
 )
 
 type TemplateGenerator struct {
@@ -33,10 +31,8 @@ func (this *TemplateGenerator) GenerateStruct(template *Template) {
 			fmt.Println("ERROR DECODE", err)
 		}
 		newStruct := RunTemplateReplace(other, template.Types, usedFor).(*ast.StructType)
-		prefix := path.Base(template.Pack) + "_"
-		newStruct.Name = prefix + newStruct.Name + "_" + strings.Join(usedFor, "_")
-		topLevel := &ast.TopLevel{Declaration: &ast.Declaration{TypeDecl: &ast.TypeDecl{StructType: newStruct},
-		},
+		newStruct.Name = template.InstanceName(usedFor)
+		topLevel := &ast.TopLevel{Declaration: &ast.Declaration{TypeDecl: &ast.TypeDecl{StructType: newStruct}},
 		}
 		source.TopLevels = append(source.TopLevels, topLevel)
 		template.AddGeneratedFor(usedFor, source.Package.Eval())
@@ -61,8 +57,7 @@ func (this *TemplateGenerator) GenerateTopFns(template *Template) {
 			fmt.Println("ERROR DECODE", err)
 		}
 		newFn := RunTemplateReplace(other, template.Types, usedFor).(*ast.FunctionDecl)
-		prefix := path.Base(template.Pack) + "_"
-		newFn.Name = prefix + newFn.Name + "_" + strings.Join(usedFor, "_")
+		newFn.Name = template.InstanceName(usedFor)
 		topLevel := &ast.TopLevel{FunctionDecl: newFn}
 		source.TopLevels = append(source.TopLevels, topLevel)
 		template.AddGeneratedFor(usedFor, source.Package.Eval())
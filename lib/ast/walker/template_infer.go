@@ -0,0 +1,166 @@
+package walker
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	typeNameRegexp = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+)
+
+// The types given to the template types by the arguments of a call.
+// The untyped constants only count when no typed value gives a type
+type Bindings struct {
+	names   []string
+	typed   map[string][]string
+	untyped map[string][]string
+}
+
+func (this Bindings) isName(t string) bool {
+	for _, name := range this.names {
+		if name == t {
+			return true
+		}
+	}
+	return false
+}
+func (this *Bindings) bind(to map[string][]string, name, t string) {
+	for _, bound := range to[name] {
+		if bound == t {
+			return
+		}
+	}
+	to[name] = append(to[name], t)
+}
+
+// Matches the type of a parameter against the type of its argument
+func (this *Bindings) Unify(pattern, t string) {
+	if t == "" {
+		return
+	}
+	if this.isName(pattern) {
+		if isUntyped(t) {
+			this.bind(this.untyped, pattern, defaultType(t))
+		} else {
+			this.bind(this.typed, pattern, t)
+		}
+		return
+	}
+	if isUntyped(t) {
+		return
+	}
+	for _, prefix := range []string{
+		"*",
+		"[]",
+		"<-chan ",
+		"chan<- ",
+		"chan ",
+	} {
+		if strings.HasPrefix(pattern, prefix) {
+			if strings.HasPrefix(t, prefix) {
+				this.Unify(pattern[len(prefix):], t[len(prefix):])
+			}
+			return
+		}
+	}
+	if strings.HasPrefix(pattern, "map[") && strings.HasPrefix(t, "map[") {
+		patternEnd := closing(pattern, 3)
+		tEnd := closing(t, 3)
+		if patternEnd > 0 && tEnd > 0 {
+			this.Unify(pattern[4:patternEnd], t[4:tEnd])
+			this.Unify(pattern[patternEnd+1:], t[tEnd+1:])
+		}
+		return
+	}
+	// `[4]T`
+	if strings.HasPrefix(pattern, "[") && strings.HasPrefix(t, "[") {
+		patternEnd := closing(pattern, 0)
+		tEnd := closing(t, 0)
+		if patternEnd > 0 && pattern[:patternEnd] == t[:tEnd] {
+			this.Unify(pattern[patternEnd+1:], t[tEnd+1:])
+		}
+	}
+}
+
+// The type of each template type, or why one cannot be inferred
+func (this Bindings) Types() ([]string, string) {
+	res := []string{}
+	for _, name := range this.names {
+		typed := this.typed[name]
+		if len(typed) > 1 {
+			return nil, "Ambiguous template type " + name + ", it is " + strings.Join(typed, " or ")
+		}
+		if len(typed) == 1 {
+			res = append(res, typed[0])
+			continue
+		}
+		t, ok := untypedDefault(this.untyped[name])
+		if !ok {
+			return nil, "Ambiguous template type " + name + ", it is " + strings.Join(this.untyped[name], " or ")
+		}
+		if t == "" {
+			return nil, "Cannot infer the template type " + name
+		}
+		res = append(res, t)
+	}
+	return res, ""
+}
+func NewBindings(names []string) *Bindings {
+	return &Bindings{
+		names:   names,
+		typed:   make(map[string][]string),
+		untyped: make(map[string][]string),
+	}
+}
+
+// Mixed untyped numeric constants take the largest kind, like in Go
+func untypedDefault(types []string) (string, bool) {
+	if len(types) < 2 {
+		return strings.Join(types, ""), true
+	}
+	res := "int"
+	for _, t := range types {
+		if !isNumeric(t) {
+			return "", false
+		}
+		if t == "float64" || (t == "rune" && res == "int") {
+			res = t
+		}
+	}
+	return res, true
+}
+
+// The index of the `]` that closes the `[` at `open`, -1 when there is none
+func closing(t string, open int) int {
+	depth := 0
+	for i := open; i < len(t); i++ {
+		if t[i] == '[' {
+			depth++
+		} else if t[i] == ']' {
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// Replaces the template types by their instance in `t`
+func substitute(t string, names, types []string) string {
+	res := ""
+	last := 0
+	for _, loc := range typeNameRegexp.FindAllStringIndex(t, -1) {
+		name := t[loc[0]:loc[1]]
+		for i, templateName := range names {
+			if name == templateName {
+				name = types[i]
+				break
+			}
+		}
+		res += t[last:loc[0]] + name
+		last = loc[1]
+	}
+	return res + t[last:]
+}
@@ -8,24 +8,33 @@ import (
 	"path"
 )
 
-func load(dir string, templates *Templates) {
-	templateDir := path.Join(os.Getenv("GOPATH"), "src", dir, ".og")
+// Loads the templates of the package `importPath`, from the folder go finds it in
+func load(fromDir, importPath string, templates *Templates) error {
+	templateDir := path.Join(common.PackageDir(fromDir, importPath), ".og")
 	_, err := os.Stat(templateDir)
 	if err != nil {
-		return
+		return nil
 	}
-	content, err := ioutil.ReadFile(path.Join(templateDir, "template"))
+	templatePath := path.Join(templateDir, "template")
+	content, err := ioutil.ReadFile(templatePath)
 	if err != nil {
-		return
+		return nil
 	}
-	templates.Decode(content)
-}
-func RunTemplateLoader(tree common.INode, templates *Templates) {
-	imports := tree.(*ast.SourceFile).Import
-	if imports == nil {
-		return
+	if err := templates.Decode(content); err != nil {
+		return common.NewError(templatePath, nil, 0, 0, "Cannot decode templates: "+err.Error(), "")
 	}
-	for _, imp := range imports.Items {
-		load(imp.Path[1:len(imp.Path)-2], templates)
+	return nil
+}
+func RunTemplateLoader(file *common.File, templates *Templates) error {
+	errs := common.Errors{}
+	for _, importPath := range file.Ast.(*ast.SourceFile).GetImports() {
+		// The templates of a package compiled along are parsed again
+		if templates.IsParsed(importPath) {
+			continue
+		}
+		if err := load(path.Dir(file.FullPath), importPath, templates); err != nil {
+			errs.Add(err)
+		}
 	}
+	return errs.Err()
 }
@@ -0,0 +1,81 @@
+package walker
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// The characters of the types that have a code of their own, `_` followed by
+// their index. Any other one is `_9` followed by the hexadecimal of its bytes
+const (
+	mangleCodes = "_* [].<>,"
+)
+
+// Mangle gives a Go name to a list of names and types, like the package, the
+// name and the types of a template instance. Each part is escaped and the
+// parts are joined by `_`: `foo<[]int, *bar.Baz>` of the package `pkg` is
+// `pkg_foo__3_4int__1bar_5Baz`. A `_` followed by a digit is always a code,
+// and a part never starts with a digit, so that the names can be split back.
+// The names and the types that are plain identifiers are left as they are
+func Mangle(parts ...string) string {
+	res := []string{}
+	for _, part := range parts {
+		res = append(res, mangle(part))
+	}
+	return strings.Join(res, "_")
+}
+func mangle(part string) string {
+	res := ""
+	for i, c := range part {
+		if unicode.IsLetter(c) || (unicode.IsDigit(c) && i > 0) {
+			res += string(c)
+			continue
+		}
+		if code := strings.IndexRune(mangleCodes, c); code >= 0 {
+			res += "_" + strconv.Itoa(code)
+			continue
+		}
+		buf := make([]byte, utf8.RuneLen(c))
+		utf8.EncodeRune(buf, c)
+		for _, b := range buf {
+			res += fmt.Sprintf("_9%02x", b)
+		}
+	}
+	return res
+}
+
+// Demangle splits a name given by Mangle back into its parts
+func Demangle(name string) ([]string, error) {
+	res := []string{}
+	part := []byte{}
+	for i := 0; i < len(name); i++ {
+		if name[i] != '_' {
+			part = append(part, name[i])
+			continue
+		}
+		if i+1 == len(name) || name[i+1] < '0' || name[i+1] > '9' {
+			res = append(res, string(part))
+			part = []byte{}
+			continue
+		}
+		i++
+		if name[i] != '9' {
+			part = append(part, mangleCodes[name[i]-'0'])
+			continue
+		}
+		if i+2 >= len(name) {
+			return nil, errors.New("Truncated escape in " + name)
+		}
+		b, err := strconv.ParseUint(name[i+1:i+3], 16, 8)
+		if err != nil {
+			return nil, errors.New("Bad escape in " + name)
+		}
+		part = append(part, byte(b))
+		i += 2
+	}
+	return append(res, string(part)), nil
+}
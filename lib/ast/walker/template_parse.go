@@ -44,8 +44,9 @@ func RunTemplateParse(file *common.File, templates *Templates) {
 		Root:       file.Ast,
 		Templates:  templates,
 		Package:    file.Ast.(*ast.SourceFile).Package.Name,
-		ImportName: path.Dir(file.FullPath),
+		ImportName: common.ImportPathOf(path.Dir(file.FullPath)),
 	}
+	templates.AddParsed(templateParse.ImportName, path.Dir(file.FullPath))
 	templateParse.type_ = &templateParse
 	templateParse.Walk(file.Ast)
 }
@@ -3,48 +3,153 @@ package walker
 import (
 	"github.com/champii/og/lib/ast"
 	"github.com/champii/og/lib/common"
-	"os"
 	"path"
 	"strings"
 )
 
 type TemplateUsage struct {
-	AstWalker
-	File      *common.File
-	Root      common.INode
-	Package   string
-	Templates *Templates
+	ScopeWalker
+	Root       common.INode
+	Package    string
+	Templates  *Templates
+	inTemplate int        // The template types are not inferred inside a template
+	facts      *TypeFacts // The types of the package, for the constraints
 }
 
-func (this *TemplateUsage) computeTypes(callee common.INode, templateSpec *ast.TemplateSpec) string {
+// The template named by `callee`, and its name without the package
+func (this *TemplateUsage) lookup(callee common.INode) (*Template, string) {
 	calleeName := callee.Eval()
-	types := []string{}
-	for _, t := range templateSpec.Result.Types {
-		types = append(types, t.Eval())
-	}
 	splited := strings.Split(calleeName, ".")
 	pack := this.Package
 	if len(splited) > 1 {
 		pack = this.File.Imports[splited[0]]
 		calleeName = splited[1]
 	}
-	template := this.Templates.Get(calleeName, pack)
+	return this.Templates.Get(calleeName, pack), calleeName
+}
+func (this *TemplateUsage) computeTypes(callee common.INode, templateSpec *ast.TemplateSpec) string {
+	types := specTypes(templateSpec)
+	template, calleeName := this.lookup(callee)
 	if template == nil {
-		err := this.File.Error(callee.Line(), callee.Col(), "Unknown template name", callee.Eval())
-		common.Print.Error(err)
-		os.Exit(1)
+		this.Errors = append(this.Errors, this.File.Error(callee.Line(), callee.Col(), "Unknown template name", callee.Eval()))
 		return calleeName
 	}
+	return this.instantiate(callee, template, types)
+}
+func (this *TemplateUsage) instantiate(callee common.INode, template *Template, types []string) string {
+	this.checkConstraints(callee, template, types)
 	template.AddUsedFor(types)
-	prefix := path.Base(template.Pack) + "_"
-	return prefix + calleeName + "_" + strings.Join(types, "_")
+	return template.InstanceName(types)
+}
+
+// Reports the types that do not satisfy the constraint of their template type
+func (this *TemplateUsage) checkConstraints(callee common.INode, template *Template, types []string) {
+	spec := templateSpecOf(template.Node)
+	if spec == nil {
+		return
+	}
+	for i, t := range types {
+		constraint := spec.Constraint(i)
+		if constraint == "" {
+			continue
+		}
+		ok, known := this.facts.Satisfies(t, constraint, template.Pack == this.Package)
+		if !known {
+			this.Errors = append(this.Errors, this.File.Error(callee.Line(), callee.Col(), "Cannot verify that "+t+" satisfies "+constraint, callee.Eval()))
+		} else if !ok {
+			this.Errors = append(this.Errors, this.File.Error(callee.Line(), callee.Col(), t+" does not satisfy "+constraint, callee.Eval()))
+		}
+	}
+}
+
+// The template types of a call without `<...>`, from the types of its arguments
+func (this *TemplateUsage) infer(callee *ast.Operand, args *ast.Arguments, template *Template) []string {
+	sig := signatureOf(template.Node.(*ast.FunctionDecl))
+	bindings := NewBindings(template.Types)
+	params := []string{}
+	variadic := false
+	if sig.Parameters != nil {
+		for _, param := range sig.Parameters.List {
+			count := 1
+			if param.IdentifierList != nil {
+				count = len(param.IdentifierList.List)
+			}
+			for i := 0; i < count; i++ {
+				params = append(params, param.Type.Eval())
+			}
+			variadic = param.IsVariadic
+		}
+	}
+	if args.Expressions != nil {
+		for i, expr := range args.Expressions.Expressions {
+			if len(params) == 0 {
+				break
+			}
+			pattern := ""
+			if i < len(params) {
+				pattern = params[i]
+			} else if variadic {
+				pattern = params[len(params)-1]
+			}
+			// `f(xs...)` gives the whole slice
+			if args.IsVariadic && variadic && i == len(params)-1 {
+				pattern = "[]" + pattern
+			}
+			bindings.Unify(pattern, this.typeOf(expr))
+		}
+	}
+	types, msg := bindings.Types()
+	if msg != "" {
+		this.Errors = append(this.Errors, this.File.Error(callee.Line(), callee.Col(), msg, callee.Eval()))
+	}
+	return types
+}
+
+// The type given by a template call, when it returns a single value
+func (this *TemplateUsage) result(args *ast.Arguments, template *Template, types []string) {
+	decl, ok := template.Node.(*ast.FunctionDecl)
+	if !ok {
+		return
+	}
+	sig := signatureOf(decl)
+	if sig.Result != nil && len(sig.Result.Types) == 1 {
+		this.results[args] = substitute(sig.Result.Types[0].Eval(), template.Types, types)
+	}
 }
 func (this *TemplateUsage) Arguments(n common.INode) common.INode {
 	args := n.(*ast.Arguments)
+	callee := calleeOf(args)
+	if callee == nil {
+		return n
+	}
 	if args.TemplateSpec != nil {
-		callee := args.GetParent().(*ast.SecondaryExpr).GetParent().(*ast.PrimaryExpr).PrimaryExpr.Operand
+		template, _ := this.lookup(callee)
 		callee.OperandName.Name = this.computeTypes(callee, args.TemplateSpec)
+		if template != nil {
+			this.result(args, template, specTypes(args.TemplateSpec))
+		}
+		return n
 	}
+	if this.inTemplate > 0 {
+		return n
+	}
+	// A variable or a function of the file hides the template
+	if _, ok := this.stack.GetVar(callee.OperandName.Name); ok {
+		return n
+	}
+	template, _ := this.lookup(callee)
+	if template == nil {
+		return n
+	}
+	if _, ok := template.Node.(*ast.FunctionDecl); !ok {
+		return n
+	}
+	types := this.infer(callee, args, template)
+	if types == nil {
+		return n
+	}
+	callee.OperandName.Name = this.instantiate(callee, template, types)
+	this.result(args, template, types)
 	return n
 }
 func (this *TemplateUsage) CompositeLit(n common.INode) common.INode {
@@ -55,13 +160,64 @@ func (this *TemplateUsage) CompositeLit(n common.INode) common.INode {
 	}
 	return n
 }
-func RunTemplateUsage(file *common.File, templates *Templates) {
+func (this *TemplateUsage) BeforeFunction(n common.INode) {
+	this.ScopeWalker.BeforeFunction(n)
+	if n.(*ast.Function).Signature.TemplateSpec != nil {
+		this.inTemplate++
+	}
+}
+func (this *TemplateUsage) AfterFunction(n common.INode) {
+	this.ScopeWalker.AfterFunction(n)
+	if n.(*ast.Function).Signature.TemplateSpec != nil {
+		this.inTemplate--
+	}
+}
+func specTypes(templateSpec *ast.TemplateSpec) []string {
+	res := []string{}
+	for _, t := range templateSpec.Result.Types {
+		res = append(res, t.Eval())
+	}
+	return res
+}
+
+// The operand called with `args`, nil when it is not a name
+func calleeOf(args *ast.Arguments) *ast.Operand {
+	secondary, ok := args.GetParent().(*ast.SecondaryExpr)
+	if !ok {
+		return nil
+	}
+	primary, ok := secondary.GetParent().(*ast.PrimaryExpr)
+	if !ok || primary.PrimaryExpr == nil || primary.PrimaryExpr.Operand == nil || primary.PrimaryExpr.Operand.OperandName == nil {
+		return nil
+	}
+	return primary.PrimaryExpr.Operand
+}
+
+// The package scope is nil when the other files of the package are not known
+func RunTemplateUsage(file *common.File, templates *Templates, pack *PackageScope) error {
+	facts := NewTypeFacts()
+	if pack != nil {
+		facts = pack.facts
+	} else {
+		facts.declare(file.Ast.(*ast.SourceFile))
+	}
 	templateUsage := TemplateUsage{
+		ScopeWalker: ScopeWalker{
+			File:    file,
+			stack:   &Stack{scopes: []*Scope{NewScope()}},
+			results: make(map[*ast.Arguments]string),
+		},
 		Root:      file.Ast,
-		File:      file,
 		Templates: templates,
-		Package:   path.Dir(file.FullPath),
+		Package:   common.ImportPathOf(path.Dir(file.FullPath)),
+		facts:     facts,
+	}
+	for _, top := range file.Ast.(*ast.SourceFile).TopLevels {
+		if decl := top.FunctionDecl; decl != nil && signatureOf(decl).TemplateSpec == nil {
+			templateUsage.stack.AddFunc(decl.Name, NewFuncSig(decl.Name, signatureOf(decl)))
+		}
 	}
 	templateUsage.type_ = &templateUsage
 	templateUsage.Walk(file.Ast)
+	return templateUsage.Errors.Err()
 }
@@ -1,95 +1,825 @@
 package walker
 
 import (
+	"fmt"
+	"github.com/champii/og/lib/ast"
 	"github.com/champii/og/lib/common"
+	goast "go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
 )
 
+var (
+	predeclaredTypes = []string{
+		"bool",
+		"byte",
+		"complex64",
+		"complex128",
+		"error",
+		"float32",
+		"float64",
+		"int",
+		"int8",
+		"int16",
+		"int32",
+		"int64",
+		"rune",
+		"string",
+		"uint",
+		"uint8",
+		"uint16",
+		"uint32",
+		"uint64",
+		"uintptr",
+		"any",
+		"comparable",
+	}
+)
+var (
+	predeclaredFuncs = []string{
+		"append",
+		"cap",
+		"clear",
+		"close",
+		"complex",
+		"copy",
+		"delete",
+		"imag",
+		"len",
+		"make",
+		"max",
+		"min",
+		"new",
+		"panic",
+		"print",
+		"println",
+		"real",
+		"recover",
+	}
+)
+var (
+	numericTypes = []string{
+		"complex64",
+		"complex128",
+		"float32",
+		"float64",
+		"int",
+		"int8",
+		"int16",
+		"int32",
+		"int64",
+		"uint",
+		"uint8",
+		"uint16",
+		"uint32",
+		"uint64",
+		"uintptr",
+	}
+)
+var (
+	identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+)
+var (
+	versionRegexp = regexp.MustCompile(`^v[0-9]+$`)
+)
+
+// The arity of a function
 type FuncSig struct {
 	name      string
-	arguments map[string]string
-	returns   map[string]string
+	arguments []string
+	variadic  bool
+	returns   []string
 }
 
-func NewFuncSig() *FuncSig {
-	return &FuncSig{
-		arguments: make(map[string]string),
-		returns:   make(map[string]string),
+func NewFuncSig(name string, sig *ast.Signature) *FuncSig {
+	res := &FuncSig{name: name}
+	if sig == nil {
+		return res
 	}
+	if sig.Parameters != nil {
+		for _, param := range sig.Parameters.List {
+			count := 1
+			if param.IdentifierList != nil {
+				count = len(param.IdentifierList.List)
+			}
+			for i := 0; i < count; i++ {
+				res.arguments = append(res.arguments, param.Type.Eval())
+			}
+			if param.IsVariadic {
+				res.variadic = true
+			}
+		}
+	}
+	if sig.Result != nil {
+		for _, t := range sig.Result.Types {
+			res.returns = append(res.returns, t.Eval())
+		}
+	}
+	return res
 }
 
-type Scope struct {
-	vars  map[string]string
-	funcs map[string]*FuncSig
+// The top level names of a package, from its Og files and from the Go files next to them
+type PackageScope struct {
+	scope    *Scope
+	complete bool                // Every file is known, a name that is not found is undefined
+	datas    map[string][]string // The variants of each data
+	facts    *TypeFacts          // The types and their methods, for the constraints of the templates
 }
 
-func NewScope() *Scope {
-	return &Scope{vars: make(map[string]string)}
+// Declares the top level names of an Og file
+func (this *PackageScope) declare(source *ast.SourceFile) {
+	this.facts.declare(source)
+	for _, top := range source.TopLevels {
+		if top.FunctionDecl != nil {
+			decl := top.FunctionDecl
+			this.scope.vars[decl.Name] = "func"
+			this.scope.funcs[decl.Name] = NewFuncSig(decl.Name, signatureOf(decl))
+		}
+		if top.Declaration == nil {
+			continue
+		}
+		if top.Declaration.ConstDecl != nil {
+			for _, spec := range top.Declaration.ConstDecl.ConstSpecs {
+				for _, name := range spec.IdentifierList.List {
+					this.scope.vars[name] = typeName(spec.Type)
+				}
+			}
+		}
+		if top.Declaration.VarDecl != nil {
+			for _, spec := range top.Declaration.VarDecl.VarSpecs {
+				for _, name := range spec.IdentifierList.List {
+					this.scope.vars[name] = typeName(spec.Type)
+				}
+			}
+		}
+		for _, name := range typeNames(top.Declaration.TypeDecl) {
+			this.scope.vars[name] = "type"
+		}
+		if decl := top.Declaration.TypeDecl; decl != nil && decl.DataType != nil {
+			this.declareData(decl.DataType)
+		}
+		if decl := top.Declaration.EnumDecl; decl != nil {
+			this.declareEnum(decl)
+		}
+	}
 }
 
-type Stack struct {
-	scopes []*Scope
+// The type of an enum, its values and its functions
+func (this *PackageScope) declareEnum(decl *ast.EnumDecl) {
+	this.scope.vars[decl.Name] = "type"
+	for _, value := range decl.Values() {
+		this.scope.vars[value] = decl.Name
+	}
+	parse := &FuncSig{
+		name:      decl.ParseFunc(),
+		arguments: []string{"string"},
+		returns: []string{
+			decl.Name,
+			"error",
+		},
+	}
+	values := &FuncSig{
+		name:    decl.ValuesFunc(),
+		returns: []string{"[]" + decl.Name},
+	}
+	for _, sig := range []*FuncSig{
+		parse,
+		values,
+	} {
+		this.scope.vars[sig.name] = "func"
+		this.scope.funcs[sig.name] = sig
+	}
 }
 
-func (this *Stack) PushScope() {
-	this.scopes = append([]*Scope{NewScope()}, this.scopes...)
+// The variants of a data and their constructors
+func (this *PackageScope) declareData(data *ast.DataType) {
+	for _, variant := range data.Variants {
+		this.datas[data.Name] = append(this.datas[data.Name], variant.Name)
+		sig := &FuncSig{
+			name:    variant.Constructor(),
+			returns: []string{data.Name},
+		}
+		for _, field := range variant.Fields {
+			if field.Anonymous != nil {
+				sig.arguments = append(sig.arguments, field.Anonymous.Eval())
+			} else if field.InlineStructMethod == nil {
+				for i := 0; i < len(field.IdentifierList.List); i++ {
+					sig.arguments = append(sig.arguments, field.Type.Eval())
+				}
+			}
+		}
+		this.scope.vars[sig.name] = "func"
+		this.scope.funcs[sig.name] = sig
+	}
 }
-func (this *Stack) PopScope() {
-	this.scopes = this.scopes[1:]
+
+// Declares the names of the Go files of the package that are not compiled from
+// `outputs`. An Og file that is not compiled yet leaves the package incomplete
+func (this *PackageScope) declareSiblings(file *common.File, name string, outputs map[string]bool) {
+	outDir := filepath.Dir(file.OutPath)
+	sources, _ := filepath.Glob(filepath.Join(filepath.Dir(file.Path), "*.og"))
+	for _, source := range sources {
+		output := filepath.Join(outDir, strings.TrimSuffix(filepath.Base(source), ".og")+".go")
+		if _, err := os.Stat(output); err != nil && !outputs[output] {
+			this.complete = false
+			this.facts.complete = false
+		}
+	}
+	files, _ := filepath.Glob(filepath.Join(outDir, "*.go"))
+	fset := token.NewFileSet()
+	for _, goFile := range files {
+		if outputs[goFile] || strings.HasSuffix(goFile, "_test.go") {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, goFile, nil, parser.SkipObjectResolution)
+		if err != nil || parsed.Name.Name != name {
+			continue
+		}
+		for _, decl := range parsed.Decls {
+			this.facts.declareGo(decl)
+			switch d := decl.(type) {
+			case *goast.FuncDecl:
+				{
+					if d.Recv == nil {
+						this.scope.vars[d.Name.Name] = "func"
+					}
+				}
+			case *goast.GenDecl:
+				{
+					for _, spec := range d.Specs {
+						switch s := spec.(type) {
+						case *goast.TypeSpec:
+							{
+								this.scope.vars[s.Name.Name] = "type"
+							}
+						case *goast.ValueSpec:
+							{
+								for _, ident := range s.Names {
+									this.scope.vars[ident.Name] = ""
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
 }
-func (this *Stack) AddVar(name, t string) bool {
-	if _, ok := this.GetVar(name); ok {
-		return false
+func NewPackageScope() *PackageScope {
+	return &PackageScope{
+		scope:    NewScope(),
+		complete: true,
+		datas:    make(map[string][]string),
+		facts:    NewTypeFacts(),
 	}
-	this.scopes[0].vars[name] = t
-	return true
 }
-func (this *Stack) GetVar(name string) (string, bool) {
-	for _, scope := range this.scopes {
-		if t, ok := scope.vars[name]; ok {
-			return t, true
+
+// The packages of the files, by directory and package name
+func NewPackageScopes(files []*common.File) map[string]*PackageScope {
+	res := make(map[string]*PackageScope)
+	outputs := make(map[string]bool)
+	for _, file := range files {
+		outputs[filepath.Clean(file.OutPath)] = true
+	}
+	for _, file := range files {
+		source, ok := file.Ast.(*ast.SourceFile)
+		if !ok {
+			continue
 		}
+		key := packageKey(file, source)
+		pack, ok := res[key]
+		if !ok {
+			pack = NewPackageScope()
+			pack.declareSiblings(file, source.Package.Name, outputs)
+			res[key] = pack
+		}
+		pack.declare(source)
+	}
+	return res
+}
+func packageKey(file *common.File, source *ast.SourceFile) string {
+	return filepath.Dir(file.Path) + ":" + source.Package.Name
+}
+
+// Resolves the names scope by scope. Reports the undefined ones, the
+// redeclarations, the mismatched assignments and the calls with a wrong
+// number of arguments
+type TypeChecker struct {
+	ScopeWalker
+	complete bool            // An undefined name can be reported
+	loose    bool            // Some packages are not named after their path
+	toplevel map[string]bool // The top level names of the file
+	datas    map[string][]string
+}
+
+func (this *TypeChecker) BeforeFunctionDecl(n common.INode) {
+	if _, ok := n.GetParent().(*ast.TopLevel); ok {
+		this.declareTop(n.(*ast.FunctionDecl).Name, n)
 	}
-	return "", false
 }
-func (this *Stack) AddFunc(name string, f *FuncSig) bool {
-	if r := this.GetFunc(name); r != nil {
+func (this *TypeChecker) BeforeTypeDecl(n common.INode) {
+	for _, name := range typeNames(n.(*ast.TypeDecl)) {
+		if this.topLevel() {
+			this.declareTop(name, n)
+		} else {
+			this.declare(name, "type", n)
+		}
+	}
+}
+func (this *TypeChecker) BeforeDataType(n common.INode) {
+	if !this.topLevel() {
+		this.error(n, "A data is declared at the top level", "")
+	}
+}
+
+// The names of the fields of a pattern could be of several types otherwise
+func (this *TypeChecker) MatchArm(n common.INode) {
+	arm := n.(*ast.MatchArm)
+	if len(arm.Patterns) < 2 {
+		return
+	}
+	for _, pattern := range arm.Patterns {
+		if len(pattern.FieldNames()) > 0 {
+			this.error(pattern, "A field is given a name only in a pattern alone in its arm", "")
+		}
+	}
+}
+func (this *TypeChecker) BeforeEnumDecl(n common.INode) {
+	decl := n.(*ast.EnumDecl)
+	if !this.topLevel() {
+		this.error(n, "An enum is declared at the top level", "")
+		return
+	}
+	for _, name := range []string{
+		decl.Name,
+		decl.ParseFunc(),
+		decl.ValuesFunc(),
+	} {
+		this.declareTop(name, n)
+	}
+}
+
+// A type switch over a data has a case for each variant, or a default one.
+// The Exhaustive desugaring marks them, whether they are checked or not
+func (this *TypeChecker) AfterTypeSwitchStmt(n common.INode) {
+	stmt := n.(*ast.TypeSwitchStmt)
+	if cases, ok := typeSwitchCases(stmt); ok {
+		this.exhaustive(n, "switch", this.primaryType(stmt.TypeSwitchGuard.PrimaryExpr), cases)
+	}
+}
+func (this *TypeChecker) AfterMatchStmt(n common.INode) {
+	stmt := n.(*ast.MatchStmt)
+	if cases, ok := matchCases(stmt); ok {
+		this.exhaustive(n, "match", this.typeOf(stmt.Expression), cases)
+	}
+}
+
+// The names are declared after their values are resolved
+func (this *TypeChecker) AfterShortVarDecl(n common.INode) {
+	decl := n.(*ast.ShortVarDecl)
+	names := decl.IdentifierList.List
+	types, msg := this.shortVarTypes(decl)
+	if msg != "" {
+		this.error(n, msg, "")
+	}
+	// The closure that gives the value of a statement needs its type
+	if decl.Statement != nil && msg == "" && types[0] == "" {
+		this.error(n, inferError(decl), "")
+	}
+	fresh := false
+	for i, name := range names {
+		if name == "_" {
+			continue
+		}
+		if t, ok := this.stack.scopes[0].vars[name]; ok {
+			this.checkAssign(n, name, t, types[i])
+			continue
+		}
+		this.stack.AddVar(name, defaultType(types[i]))
+		fresh = true
+	}
+	if !fresh {
+		this.error(n, "No new variables on the left of :=", "")
+	}
+}
+func (this *TypeChecker) AfterVarSpec(n common.INode) {
+	spec := n.(*ast.VarSpec)
+	this.declareSpec(n, spec.IdentifierList.List, spec.Type, varValues(spec), false)
+}
+func (this *TypeChecker) AfterConstSpec(n common.INode) {
+	spec := n.(*ast.ConstSpec)
+	this.declareSpec(n, spec.IdentifierList.List, spec.Type, spec.ExpressionList, true)
+}
+func (this *TypeChecker) AfterAssignment(n common.INode) {
+	assign := n.(*ast.Assignment)
+	if assign.Op != "=" {
+		return
+	}
+	left := assign.Left.Expressions
+	types := this.values(n, len(left), assign.Right)
+	for i, expr := range left {
+		name := plainName(primaryOf(expr))
+		if t, ok := this.stack.GetVar(name); ok && name != "" {
+			this.checkAssign(n, name, t, types[i])
+		}
+	}
+}
+func (this *TypeChecker) OperandName(n common.INode) {
+	operandName := n.(*ast.OperandName)
+	// The template usage reports the unknown templates
+	if isTemplateCallee(operandName) {
+		return
+	}
+	name := strings.Split(operandName.Name, ".")[0]
+	qualified := name != operandName.Name
+	if name == "this" || name == "_" || !this.complete || (qualified && this.loose) {
+		return
+	}
+	if _, ok := this.stack.GetVar(name); !ok {
+		this.error(n, "Undefined name", name)
+	}
+}
+func (this *TypeChecker) PrimaryExpr(n common.INode) {
+	primary := n.(*ast.PrimaryExpr)
+	name := calleeName(primary)
+	sig := this.stack.GetFunc(name)
+	if sig == nil {
+		return
+	}
+	args := primary.SecondaryExpr.Arguments
+	count := 0
+	if args.Expressions != nil {
+		count = len(args.Expressions.Expressions)
+	}
+	// `f(g())` passes all the values of `g`
+	if count == 1 && isCall(args.Expressions.Expressions[0]) {
+		inner := this.stack.GetFunc(calleeName(primaryOf(args.Expressions.Expressions[0])))
+		if inner == nil {
+			return
+		}
+		count = len(inner.returns)
+	}
+	expected := len(sig.arguments)
+	if args.IsVariadic {
+		if sig.variadic && count != expected {
+			this.error(n, "Wrong number of arguments in call", name)
+		}
+	} else if sig.variadic && count < expected-1 {
+		this.error(n, "Not enough arguments in call", name)
+	} else if !sig.variadic && count < expected {
+		this.error(n, "Not enough arguments in call", name)
+	} else if !sig.variadic && count > expected {
+		this.error(n, "Too many arguments in call", name)
+	}
+}
+
+// Reports the variants of the data `t` that no case handles
+func (this *TypeChecker) exhaustive(n common.INode, kind, t string, cases []string) {
+	if missing, _ := missingVariants(this.datas, t, cases); len(missing) > 0 {
+		this.error(n, "Non-exhaustive "+kind+" on "+t+", missing "+strings.Join(missing, ", "), "")
+	}
+}
+func (this *TypeChecker) topLevel() bool {
+	return len(this.stack.scopes) == 3
+}
+
+// The top level names are already in the package scope,
+// they are only checked to be unique in the file
+func (this *TypeChecker) declareTop(name string, n common.INode) {
+	if name == "_" || name == "init" {
+		return
+	}
+	if this.toplevel[name] {
+		this.error(n, "Already declared", name)
+	}
+	this.toplevel[name] = true
+}
+
+// A constant keeps its untyped value
+func (this *TypeChecker) declareSpec(n common.INode, names []string, t *ast.Type, list *ast.ExpressionList, constant bool) {
+	types := this.values(n, len(names), list)
+	for i, name := range names {
+		if t != nil {
+			this.checkAssign(n, name, t.Eval(), types[i])
+			types[i] = t.Eval()
+		} else if !constant {
+			types[i] = defaultType(types[i])
+		}
+		if this.topLevel() {
+			this.declareTop(name, n)
+		} else {
+			this.declare(name, types[i], n)
+		}
+	}
+}
+
+// The alias of an import, or the last element of its path
+func (this *TypeChecker) declareImports(imports *ast.Import) {
+	if imports == nil {
+		return
+	}
+	for _, spec := range imports.Items {
+		importPath := strings.Trim(strings.TrimSpace(spec.Path), "\"")
+		name := spec.Alias
+		if name == "" {
+			name = path.Base(importPath)
+			if !identifierRegexp.MatchString(name) || versionRegexp.MatchString(name) {
+				this.loose = true
+				continue
+			}
+		}
+		// The names of a dot import are unknown
+		if name == "." {
+			this.complete = false
+		}
+		this.stack.AddVar(name, "package")
+	}
+}
+
+// The types of the values assigned to `count` names, "" when unknown
+func (this *TypeChecker) values(n common.INode, count int, list *ast.ExpressionList) []string {
+	res, msg := this.valueTypes(count, list)
+	if msg != "" {
+		this.error(n, msg, "")
+	}
+	return res
+}
+func (this *TypeChecker) checkAssign(n common.INode, name, to, value string) {
+	if !assignable(value, to) {
+		this.error(n, "Cannot assign "+strings.TrimPrefix(value, "untyped ")+" to "+to, name)
+	}
+}
+
+// The predeclared names of Go
+func universeScope() *Scope {
+	res := NewScope()
+	for _, name := range predeclaredTypes {
+		res.vars[name] = "type"
+	}
+	for _, name := range predeclaredFuncs {
+		res.vars[name] = "builtin"
+	}
+	res.vars["true"] = "untyped bool"
+	res.vars["false"] = "untyped bool"
+	res.vars["iota"] = "untyped int"
+	res.vars["nil"] = ""
+	return res
+}
+func signatureOf(decl *ast.FunctionDecl) *ast.Signature {
+	if decl.Function != nil {
+		return decl.Function.Signature
+	}
+	return decl.Signature
+}
+func typeName(t *ast.Type) string {
+	if t == nil {
+		return ""
+	}
+	return t.Eval()
+}
+func typeNames(decl *ast.TypeDecl) []string {
+	res := []string{}
+	if decl == nil {
+		return res
+	}
+	for _, spec := range decl.TypeSpecs {
+		res = append(res, spec.Name)
+	}
+	if decl.StructType != nil && decl.StructType.Name != "" {
+		res = append(res, decl.StructType.Name)
+	}
+	if decl.InterfaceType != nil && decl.InterfaceType.Name != "" {
+		res = append(res, decl.InterfaceType.Name)
+	}
+	if decl.DataType != nil {
+		res = append(res, decl.DataType.Name)
+		for _, variant := range decl.DataType.Variants {
+			res = append(res, variant.Name)
+		}
+	}
+	return res
+}
+
+// The primary expression of `expr`, when it has no operator
+func primaryOf(expr *ast.Expression) *ast.PrimaryExpr {
+	if expr == nil || expr.UnaryExpr == nil {
+		return nil
+	}
+	return expr.UnaryExpr.PrimaryExpr
+}
+
+// The name of a variable or of a function of the package
+func plainName(primary *ast.PrimaryExpr) string {
+	if primary == nil || primary.Operand == nil || primary.Operand.OperandName == nil {
+		return ""
+	}
+	if strings.Contains(primary.Operand.OperandName.Name, ".") {
+		return ""
+	}
+	return primary.Operand.OperandName.Name
+}
+
+// The name of the function called by `primary`, "" if it is not a plain call
+func calleeName(primary *ast.PrimaryExpr) string {
+	if primary == nil || primary.SecondaryExpr == nil || primary.SecondaryExpr.Arguments == nil {
+		return ""
+	}
+	if primary.SecondaryExpr.Arguments.TemplateSpec != nil {
+		return ""
+	}
+	return plainName(primary.PrimaryExpr)
+}
+func isBubble(primary *ast.PrimaryExpr) bool {
+	return primary != nil && primary.SecondaryExpr != nil && primary.SecondaryExpr.Bubble
+}
+func isCall(expr *ast.Expression) bool {
+	primary := primaryOf(expr)
+	return primary != nil && primary.SecondaryExpr != nil && primary.SecondaryExpr.Arguments != nil
+}
+
+// A map index, a type assertion or a receive, that can give a second value
+func commaOk(expr *ast.Expression) bool {
+	if expr.UnaryExpr == nil {
+		return false
+	}
+	if expr.UnaryExpr.Op == "<-" {
+		return true
+	}
+	primary := expr.UnaryExpr.PrimaryExpr
+	return primary != nil && primary.SecondaryExpr != nil && (primary.SecondaryExpr.Index != nil || primary.SecondaryExpr.TypeAssertion != nil)
+}
+func isTemplateCallee(n *ast.OperandName) bool {
+	operand, ok := n.GetParent().(*ast.Operand)
+	if !ok {
+		return false
+	}
+	callee, ok := operand.GetParent().(*ast.PrimaryExpr)
+	if !ok {
 		return false
 	}
-	this.scopes[0].funcs[name] = f
-	return true
+	call, ok := callee.GetParent().(*ast.PrimaryExpr)
+	return ok && call.SecondaryExpr != nil && call.SecondaryExpr.Arguments != nil && call.SecondaryExpr.Arguments.TemplateSpec != nil
 }
-func (this *Stack) GetFunc(name string) *FuncSig {
-	for _, scope := range this.scopes {
-		if f, ok := scope.funcs[name]; ok {
-			return f
+func literalType(lit *ast.Literal) string {
+	if lit.FunctionLit != nil {
+		return ""
+	}
+	if lit.Composite != nil {
+		if lit.Composite.TemplateSpec != nil {
+			return ""
 		}
+		return lit.Composite.LiteralType.Eval()
+	}
+	basic := lit.Basic
+	if strings.HasPrefix(basic, "\"") || strings.HasPrefix(basic, "`") {
+		return "untyped string"
+	}
+	if strings.HasPrefix(basic, "'") {
+		return "untyped rune"
+	}
+	if basic == "true" || basic == "false" {
+		return "untyped bool"
+	}
+	if basic == "nil" {
+		return ""
+	}
+	if strings.HasSuffix(basic, "i") {
+		return ""
 	}
-	return nil
+	if strings.HasPrefix(basic, "0x") || strings.HasPrefix(basic, "0X") {
+		if strings.ContainsAny(basic, "pP") {
+			return "untyped float"
+		}
+		return "untyped int"
+	}
+	if strings.ContainsAny(basic, ".eE") {
+		return "untyped float"
+	}
+	return "untyped int"
+}
+func isUntyped(t string) bool {
+	return strings.HasPrefix(t, "untyped ")
 }
 
-type TypeChecker struct {
-	AstWalker
-	stack *Stack
+// The type of a variable declared with an untyped value
+func defaultType(t string) string {
+	switch t {
+	case "untyped int":
+		return "int"
+	case "untyped float":
+		return "float64"
+	case "untyped rune":
+		return "rune"
+	case "untyped string":
+		return "string"
+	case "untyped bool":
+		return "bool"
+	}
+	return t
 }
 
-func (this *TypeChecker) VarSpec(n common.INode) common.INode {
-	return n
+// The aliases are compared with the types they stand for
+func canonicalType(t string) string {
+	if t == "byte" {
+		return "uint8"
+	}
+	if t == "rune" {
+		return "int32"
+	}
+	return t
+}
+func isNumeric(t string) bool {
+	for _, numeric := range numericTypes {
+		if canonicalType(t) == numeric {
+			return true
+		}
+	}
+	return false
+}
+func isBasic(t string) bool {
+	return isNumeric(t) || t == "string" || t == "bool"
+}
+
+// Only the basic types are compared, a named type can have any of them underneath
+func assignable(value, to string) bool {
+	if !isBasic(to) || value == "" {
+		return true
+	}
+	switch value {
+	case "untyped int", "untyped float", "untyped rune":
+		return isNumeric(to)
+	case "untyped string":
+		return to == "string"
+	case "untyped bool":
+		return to == "bool"
+	}
+	return !isBasic(value) || canonicalType(value) == canonicalType(to)
 }
-func (this *TypeChecker) Assignment(n common.INode) common.INode {
-	return n
+
+// The type of the values of a statement is not known, or they differ
+func inferError(decl *ast.ShortVarDecl) string {
+	keyword := valueKeyword(decl.Statement)
+	return "Cannot infer the type of a " + keyword + ", use var " + strings.Join(decl.IdentifierList.List, ", ") + " T = " + keyword
 }
-func (this *TypeChecker) BeforeBlock(n common.INode) {
-	this.stack.PushScope()
+
+// The keyword of a statement given as a value
+func valueKeyword(stmt *ast.Statement) string {
+	if stmt.IfStmt != nil {
+		return "if"
+	}
+	if stmt.SelectStmt != nil {
+		return "select"
+	}
+	if stmt.ForStmt != nil {
+		return "for"
+	}
+	if stmt.SwitchStmt != nil && stmt.SwitchStmt.MatchStmt != nil {
+		return "match"
+	}
+	return "switch"
 }
-func (this *TypeChecker) AfterBlock(n common.INode) {
-	this.stack.PopScope()
+func plural(count int, name string) string {
+	if count == 1 {
+		return fmt.Sprintf("%d %s", count, name)
+	}
+	return fmt.Sprintf("%d %ss", count, name)
 }
-func (this *TypeChecker) Each(n common.INode) common.INode {
-	return n
+
+// Checks the names of a file against the names of its package
+func TypeCheck(file *common.File, pack *PackageScope) error {
+	source := file.Ast.(*ast.SourceFile)
+	checker := TypeChecker{
+		ScopeWalker: ScopeWalker{
+			File: file,
+			stack: &Stack{scopes: []*Scope{
+				NewScope(),
+				pack.scope,
+				universeScope(),
+			},
+			},
+			strict: true,
+		},
+		complete: pack.complete,
+		toplevel: make(map[string]bool),
+		datas:    pack.datas,
+	}
+	checker.type_ = &checker
+	checker.declareImports(source.Import)
+	checker.Walk(file.Ast)
+	return checker.Errors.Err()
 }
-func TypeCheck(ast common.INode) {
-	t := TypeChecker{stack: &Stack{}}
-	t.stack.PushScope()
-	t.type_ = &t
-	t.Walk(ast)
+func RunTypeChecker(files []*common.File) error {
+	errs := common.Errors{}
+	packages := NewPackageScopes(files)
+	for _, file := range files {
+		if source, ok := file.Ast.(*ast.SourceFile); ok {
+			errs.Add(TypeCheck(file, packages[packageKey(file, source)]))
+		}
+	}
+	return errs.Err()
 }
@@ -1,6 +1,7 @@
 package walker
 
 import (
+	// fmt
 	"github.com/champii/og/lib/common"
 	"reflect"
 )
@@ -1,19 +1,29 @@
 package common
 
+const (
+	Version = "v0.7.2"
+)
+
 type OgConfig struct {
-	Blocks      bool
-	Dirty       bool
-	Print       bool
-	Force       bool
-	Ast         bool
-	SimpleAst   bool
-	Quiet       bool
-	Interpreter bool
-	Paths       []string
-	Workers     int
-	OutPath     string
-	NoBuild     bool
-	Run         bool
+	Blocks         bool
+	Dirty          bool
+	Print          bool
+	Force          bool
+	Ast            bool
+	SimpleAst      bool
+	Quiet          bool
+	Interpreter    bool
+	Paths          []string // from command line
+	Workers        int
+	OutPath        string
+	NoBuild        bool
+	Run            bool
+	RunArgs        []string // after `--`, given to the binary
+	LineDirectives bool
+	Watch          bool
+	NoCheck        bool
+	Check          bool
+	Generics       bool // Go type parameters instead of the templates
 }
 
 func NewOgConfig() *OgConfig {
@@ -1,21 +1,80 @@
 package common
 
+import (
+	"fmt"
+	"strings"
+)
+
+type Severity int
+
+const (
+	SeverityError Severity = 1
+)
+const (
+	SeverityWarning Severity = 2
+)
+
 type Error struct {
-	Path   string
-	Source []string
-	Line   int
-	Column int
-	Msg    string
-	Msg2   string
+	Path     string
+	Source   []string
+	Line     int
+	Column   int
+	Msg      string
+	Msg2     string
+	Severity Severity
 }
 
+func (this Error) Error() string {
+	res := fmt.Sprintf("%s:%d:%d: %s", this.Path, this.Line, this.Column, this.Msg)
+	if this.Msg2 != "" {
+		res += " '" + this.Msg2 + "'"
+	}
+	return res
+}
+func (this Error) IsWarning() bool {
+	return this.Severity == SeverityWarning
+}
 func NewError(filePath string, source []string, line, column int, msg, msg2 string) *Error {
 	return &Error{
-		Path:   filePath,
-		Source: source,
-		Line:   line,
-		Column: column,
-		Msg:    msg,
-		Msg2:   msg2,
+		Path:     filePath,
+		Source:   source,
+		Line:     line,
+		Column:   column,
+		Msg:      msg,
+		Msg2:     msg2,
+		Severity: SeverityError,
+	}
+}
+
+// Errors collected from one or several files
+type Errors []*Error
+
+func (this Errors) Error() string {
+	res := []string{}
+	for _, err := range this {
+		res = append(res, err.Error())
+	}
+	return strings.Join(res, "\n")
+}
+
+// Adds `err` to the list, wrapping it if it is not already an *Error
+func (this *Errors) Add(err error) {
+	switch e := err.(type) {
+	case *Error:
+		*this = append(*this, e)
+	case Errors:
+		*this = append(*this, e...)
+	default:
+		if err != nil {
+			*this = append(*this, NewError("", nil, 0, 0, err.Error(), ""))
+		}
+	}
+}
+
+// nil when empty, so it can be returned as an `error`
+func (this Errors) Err() error {
+	if len(this) == 0 {
+		return nil
 	}
+	return this
 }
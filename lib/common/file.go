@@ -1,19 +1,20 @@
 package common
 
 import (
-	"errors"
 	"fmt"
+	"go/format"
 	"io/ioutil"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
 type File struct {
 	Path        string
-	Imports     map[string]string
+	Imports     map[string]string // alias -> import path
 	FullPath    string
 	OutPath     string
 	Name        string
@@ -21,29 +22,77 @@ type File struct {
 	Output      string
 	LineMapping []int
 	Source      []byte
+	SourceMap   *SourceMap
 }
 
 func (this File) Write() {
 	os.MkdirAll(filepath.Dir(this.OutPath), os.ModePerm)
 	ioutil.WriteFile(this.OutPath, []byte(this.Output), os.ModePerm)
+	if this.SourceMap != nil {
+		this.SourceMap.Write(this.OutPath)
+	}
+}
+
+// Has to be called on the evaluated output, before it is printed or written
+func (this *File) ExtractSourceMap() {
+	this.SourceMap, this.Output = ExtractSourceMap(this.Path, this.Output)
 }
 func (this *File) Format() error {
 	cmd := exec.Command("gofmt")
-	stdin, _ := cmd.StdinPipe()
-	stdin.Write([]byte(this.Output))
-	stdin.Close()
+	// A pipe written before the start would block past its buffer
+	cmd.Stdin = strings.NewReader(this.Output)
 	final, err := cmd.CombinedOutput()
 	if err != nil {
-		fmt.Println("!!! THIS IS A BUG !!!\n")
+		fmt.Print("!!! THIS IS A BUG !!!\n\n")
 		fmt.Println("If you see this text, Oglang have generated an invalid Go code")
 		fmt.Println("and cannot go through the go formater.")
 		fmt.Println("You should report this as an issue along with the file that produced that error")
 		fmt.Println("https://github.com/Champii/og/issues")
-		return errors.New(this.Path + ": " + string(final))
+		return NewError(this.Path, nil, 0, 0, "Cannot go through go fmt", string(final))
 	}
 	this.Output = string(final)
+	this.ExtractSourceMap()
+	// The markers were taken into account for the alignment
+	if aligned, err := format.Source([]byte(this.Output)); err == nil {
+		this.Output = string(aligned)
+	}
 	return nil
 }
+
+// Adds a `//line` directive before each line that starts a new Og statement,
+// so the go tools report positions in the Og source
+func (this *File) AddLineDirectives() {
+	if this.SourceMap == nil {
+		return
+	}
+	source := this.Path
+	if rel, err := filepath.Rel(filepath.Dir(this.OutPath), this.Path); err == nil {
+		source = rel
+	}
+	lines := strings.Split(this.Output, "\n")
+	res := []string{}
+	mappings := []*Mapping{}
+	var (
+		last *Mapping
+	)
+	for i, line := range lines {
+		mapping := this.SourceMap.Lines[i]
+		if mapping != nil && mapping != last {
+			directive := "//line " + source + ":" + strconv.Itoa(mapping.Line)
+			// The column is the one of the first character of the next line, its indentation
+			if col := mapping.Col + 2 - mapping.GoCol; col > 0 {
+				directive += ":" + strconv.Itoa(col)
+			}
+			res = append(res, directive)
+			mappings = append(mappings, nil)
+		}
+		last = mapping
+		res = append(res, line)
+		mappings = append(mappings, mapping)
+	}
+	this.Output = strings.Join(res, "\n")
+	this.SourceMap.Lines = mappings
+}
 func (this *File) Error(line, column int, msg, msg2 string) *Error {
 	source := strings.Split(string(this.Source), "\n")
 	return NewError(this.Path, source, line, column, msg, msg2)
@@ -51,8 +100,10 @@ func (this *File) Error(line, column int, msg, msg2 string) *Error {
 func NewFile(filePath, outPath string) *File {
 	name := path.Base(filePath)
 	fullPath := filePath
-	dir, _ := os.Getwd()
-	fullPath = path.Join(dir, filePath)
+	if !filepath.IsAbs(filePath) {
+		dir, _ := os.Getwd()
+		fullPath = path.Join(dir, filePath)
+	}
 	source, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		return nil
@@ -0,0 +1,189 @@
+package common
+
+import (
+	"go/build"
+	"golang.org/x/mod/modfile"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// What a go.mod tells of where the packages are
+type Module struct {
+	Path     string            // The path of the module
+	Dir      string            // The folder of its go.mod
+	requires map[string]string // module -> version
+	replaces []*modfile.Replace
+}
+
+// The folder of the module `mod` at `version`. A replacement with a version
+// on its left only applies to this version, and wins over the one without.
+// `replace a => ../a` is a folder, `replace a => b v1.2.0` is in the module cache
+func (this *Module) moduleDir(mod, version string) string {
+	var (
+		found *modfile.Replace
+	)
+	for _, replace := range this.replaces {
+		if replace.Old.Path != mod {
+			continue
+		}
+		if replace.Old.Version == version {
+			found = replace
+			break
+		}
+		if replace.Old.Version == "" {
+			found = replace
+		}
+	}
+	if found == nil {
+		return cacheDir(mod, version)
+	}
+	if found.New.Version != "" {
+		return cacheDir(found.New.Path, found.New.Version)
+	}
+	if filepath.IsAbs(found.New.Path) {
+		return found.New.Path
+	}
+	return path.Join(this.Dir, found.New.Path)
+}
+
+// The folder of the package if it is in the module or in one of its
+// dependencies, "" otherwise. The longest module path wins, like for go
+func (this Module) PackageDir(importPath string) string {
+	if dir, ok := within(importPath, this.Path, this.Dir); ok {
+		return dir
+	}
+	res := ""
+	longest := 0
+	for mod, version := range this.requires {
+		if sub, ok := within(importPath, mod, this.moduleDir(mod, version)); ok && len(mod) > longest {
+			res = sub
+			longest = len(mod)
+		}
+	}
+	// A replacement for any version holds even without a require
+	for _, replace := range this.replaces {
+		mod := replace.Old.Path
+		if _, ok := this.requires[mod]; ok || replace.Old.Version != "" {
+			continue
+		}
+		if sub, ok := within(importPath, mod, this.moduleDir(mod, "")); ok && len(mod) > longest {
+			res = sub
+			longest = len(mod)
+		}
+	}
+	return res
+}
+
+// Reads the go.mod of the folder `dir`
+func ParseModule(dir string, content []byte) (*Module, error) {
+	file, err := modfile.Parse(path.Join(dir, "go.mod"), content, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &Module{
+		Dir:      dir,
+		requires: make(map[string]string),
+		replaces: file.Replace,
+	}
+	if file.Module != nil {
+		res.Path = file.Module.Mod.Path
+	}
+	for _, require := range file.Require {
+		res.requires[require.Mod.Path] = require.Mod.Version
+	}
+	return res, nil
+}
+
+// The module of `dir`, from the go.mod of the folder or of its closest parent.
+// Nil outside of a module, or when its go.mod is invalid
+func FindModule(dir string) *Module {
+	if content, err := ioutil.ReadFile(path.Join(dir, "go.mod")); err == nil {
+		mod, err := ParseModule(dir, content)
+		if err != nil {
+			return nil
+		}
+		return mod
+	}
+	if parent := path.Dir(dir); parent != dir {
+		return FindModule(parent)
+	}
+	return nil
+}
+
+// The import path of the package in `dir`, from the go.mod of its module or
+// from $GOPATH/src. The name of the folder when it is in neither, so that the
+// outputs do not depend on where the sources are
+func ImportPathOf(dir string) string {
+	if mod := FindModule(dir); mod != nil && mod.Path != "" {
+		if rel, err := filepath.Rel(mod.Dir, dir); err == nil {
+			return path.Join(mod.Path, filepath.ToSlash(rel))
+		}
+	}
+	for _, gopath := range filepath.SplitList(build.Default.GOPATH) {
+		if src := path.Join(gopath, "src") + "/"; strings.HasPrefix(dir, src) {
+			return dir[len(src):]
+		}
+	}
+	return path.Base(dir)
+}
+
+// The folder of the package `importPath` imported from `fromDir`: in the
+// module, in a replacement or in the module cache when a go.mod requires it,
+// in $GOPATH/src otherwise
+func PackageDir(fromDir, importPath string) string {
+	if path.IsAbs(importPath) {
+		return importPath
+	}
+	if mod := FindModule(fromDir); mod != nil {
+		if dir := mod.PackageDir(importPath); dir != "" {
+			return dir
+		}
+	}
+	return path.Join(gopath(), "src", importPath)
+}
+
+// $GOMODCACHE, or the `pkg/mod` of the first $GOPATH
+func ModuleCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	return path.Join(gopath(), "pkg", "mod")
+}
+
+// The first folder of $GOPATH
+func gopath() string {
+	if list := filepath.SplitList(build.Default.GOPATH); len(list) > 0 {
+		return list[0]
+	}
+	return ""
+}
+
+// The folder of a module version in the module cache
+func cacheDir(mod, version string) string {
+	return path.Join(ModuleCache(), escapeModule(mod)+"@"+escapeModule(version))
+}
+
+// The module cache writes an upper case letter as `!` and its lower case
+func escapeModule(s string) string {
+	res := ""
+	for _, c := range s {
+		if unicode.IsUpper(c) {
+			res += "!" + string(unicode.ToLower(c))
+		} else {
+			res += string(c)
+		}
+	}
+	return res
+}
+
+// The folder of the package `importPath` when it is in the module `mod` found in `dir`
+func within(importPath, mod, dir string) (string, bool) {
+	if mod == "" || (importPath != mod && !strings.HasPrefix(importPath, mod+"/")) {
+		return "", false
+	}
+	return path.Join(dir, importPath[len(mod):]), true
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"github.com/champii/antlr4/runtime/Go/antlr"
+	"strings"
 )
 
 type INode interface {
@@ -13,6 +14,7 @@ type INode interface {
 	GetParent() INode
 	ChildrenCount() int
 	T() interface{}
+	AddComment(text string, trailing bool)
 }
 type Node struct {
 	Line_          int
@@ -21,6 +23,8 @@ type Node struct {
 	Children       []INode
 	parent         INode
 	ChildrenCount_ int
+	Comments_      []string // Comment lines before the node
+	Trailing_      string   // Comment at the end of its line
 	t              interface{}
 }
 
@@ -44,15 +48,52 @@ func (this *Node) SetParent(n INode) {
 		this.parent = n
 	}
 }
+
+// Source map position of the node, empty for generated nodes
+func (this *Node) Marker() string {
+	if this == nil {
+		return ""
+	}
+	return SourceMarker(this.Line_, this.Col_)
+}
 func (this *Node) GetParent() INode {
 	return this.parent
 }
+func (this *Node) AddComment(text string, trailing bool) {
+	if trailing {
+		this.Trailing_ = text
+	} else {
+		this.Comments_ = append(this.Comments_, text)
+	}
+}
+
+// The code of the node preceded by its comments. The trailing comment
+// is put before the code too when it spans several lines
+func (this *Node) WithComments(code string) string {
+	if this == nil {
+		return code
+	}
+	res := ""
+	for _, comment := range this.Comments_ {
+		res += comment + "\n"
+	}
+	if this.Trailing_ == "" {
+		return res + code
+	}
+	if strings.Contains(code, "\n") {
+		return res + this.Trailing_ + "\n" + code
+	}
+	return res + code + " " + this.Trailing_
+}
 func (this Node) T() interface{} {
 	return this.t
 }
 func NewNode(ctx antlr.ParserRuleContext, file *File, t interface{}) *Node {
 	tok := ctx.GetStart()
-	line := file.LineMapping[tok.GetLine()]
+	line := 0
+	if len(file.LineMapping) > tok.GetLine() {
+		line = file.LineMapping[tok.GetLine()]
+	}
 	col := tok.GetColumn()
 	return &Node{
 		Text_:          ctx.GetText(),
@@ -59,6 +59,14 @@ func (this Printer) Running() {
 	tm.Print(tm.Color("~> ", tm.RED), tm.Color("Oglang: ", tm.MAGENTA), tm.Color("Running... \n", tm.GREEN))
 	tm.Flush()
 }
+func (this Printer) Watching() {
+	if this.Config.Quiet {
+		return
+	}
+	tm.Print("                                          \r")
+	tm.Print(tm.Color("~> ", tm.RED), tm.Color("Oglang: ", tm.MAGENTA), tm.Color("Watching for changes... \n", tm.GREEN))
+	tm.Flush()
+}
 func (this Printer) NothingToDo() {
 	if this.Config.Quiet {
 		return
@@ -94,17 +102,60 @@ func (this *Printer) CompileList(files []string, workerIds []int, nbWorkers, fin
 	this.spinner %= 4
 }
 func (this *Printer) Error(err *Error) {
+	this.clearProgress()
+	this.printError(err)
+}
+
+// Prints every error from a compilation, whatever its type
+func (this *Printer) Errors(err error) {
+	this.clearProgress()
+	switch e := err.(type) {
+	case Errors:
+		{
+			for _, item := range e {
+				this.printError(item)
+			}
+		}
+	case *Error:
+		this.printError(e)
+	default:
+		fmt.Println(err)
+	}
+}
+func (this Printer) clearProgress() {
+	if this.Config.Quiet {
+		return
+	}
 	for i := 0; i < 8; i++ {
 		tm.Println("                                                                          ")
 	}
 	tm.MoveCursorUp(9)
 	tm.Flush()
+}
+func (this *Printer) printError(err *Error) {
 	fileInfo := fmt.Sprintf("%s (%s:%s)", green(err.Path), yellow(err.Line), yellow(err.Column))
-	fmt.Printf("\n%s: %s '%s'\n", fileInfo, red(err.Msg), magenta(err.Msg2))
+	msg := red(err.Msg)
+	if err.IsWarning() {
+		msg = yellow(err.Msg)
+	}
+	if len(err.Msg2) > 0 {
+		fmt.Printf("\n%s: %s '%s'\n", fileInfo, msg, magenta(err.Msg2))
+	} else {
+		fmt.Printf("\n%s: %s\n", fileInfo, msg)
+	}
+	// Errors that are not tied to a source line
+	if err.Line <= 0 || len(err.Source) < err.Line {
+		return
+	}
 	badLine := err.Source[err.Line-1]
-	badLine = cyan(badLine[:err.Column]) + magenta(err.Msg2) + cyan(badLine[err.Column+len(err.Msg2):])
+	end := err.Column + len(err.Msg2)
+	if err.Column <= len(badLine) && end <= len(badLine) && badLine[err.Column:end] == err.Msg2 {
+		badLine = cyan(badLine[:err.Column]) + magenta(err.Msg2) + cyan(badLine[end:])
+	} else {
+		badLine = cyan(badLine)
+	}
 	fmt.Println(badLine)
-	fmt.Print(blue("%"+strconv.Itoa(err.Column+1)+"s\n", "^"))
+	fmt.Print(blue("%s^\n", caretIndent(err.Source[err.Line-1], err.Column)))
 }
 func (this Printer) CursorHide() {
 	if !this.Config.Quiet {
@@ -123,3 +174,16 @@ func NewPrinter(config *OgConfig) *Printer {
 var (
 	Print *Printer
 )
+
+// Spaces up to `column`, keeping the tabs of the line so the caret is aligned
+func caretIndent(line string, column int) string {
+	res := ""
+	for i := 0; column > i; i++ {
+		if len(line) > i && line[i] == '\t' {
+			res += "\t"
+		} else {
+			res += " "
+		}
+	}
+	return res
+}
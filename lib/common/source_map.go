@@ -0,0 +1,105 @@
+package common
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Put in the generated code by Eval, then removed by ExtractSourceMap
+var (
+	markerRegexp = regexp.MustCompile(`/\*og:(\d+):(\d+)\*/ ?`)
+)
+
+// Position in the Og source of the code starting at GoCol in a generated line,
+// which is the first non blank character
+type Mapping struct {
+	Line  int `json:"line"`
+	Col   int `json:"col"`
+	GoCol int `json:"goCol"`
+}
+
+// Og position of each line of a generated file, stored next to it as `file.go.map`
+type SourceMap struct {
+	Source string     `json:"source"`
+	Lines  []*Mapping `json:"lines"`
+}
+
+// `line` and `column` are 1-based, as reported by the go tools.
+// The column is only kept relative to the start of the mapped code.
+func (this *SourceMap) Lookup(line, column int) *Mapping {
+	if line <= 0 || line > len(this.Lines) || this.Lines[line-1] == nil {
+		return nil
+	}
+	mapping := this.Lines[line-1]
+	col := mapping.Col
+	if column > mapping.GoCol {
+		col += column - mapping.GoCol
+	}
+	return &Mapping{
+		Line:  mapping.Line,
+		Col:   col,
+		GoCol: column,
+	}
+}
+func (this SourceMap) Write(goPath string) error {
+	content, err := json.Marshal(this)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(goPath+".map", content, os.ModePerm)
+}
+
+// Marker for the start of a generated line, empty for unknown positions
+func SourceMarker(line, col int) string {
+	if line <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("/*og:%d:%d*/ ", line, col)
+}
+
+// Removes the markers from `output` and maps every line to the last marker seen
+func ExtractSourceMap(source, output string) (*SourceMap, string) {
+	res := &SourceMap{Source: source}
+	lines := strings.Split(output, "\n")
+	var (
+		current *Mapping
+	)
+	for i, line := range lines {
+		loc := markerRegexp.FindStringSubmatchIndex(line)
+		if loc != nil {
+			ogLine, _ := strconv.Atoi(line[loc[2]:loc[3]])
+			ogCol, _ := strconv.Atoi(line[loc[4]:loc[5]])
+			lines[i] = markerRegexp.ReplaceAllString(line, "")
+			current = &Mapping{
+				Line:  ogLine,
+				Col:   ogCol,
+				GoCol: len(lines[i]) - len(strings.TrimLeft(lines[i], " \t")) + 1,
+			}
+		}
+		res.Lines = append(res.Lines, current)
+	}
+	return res, strings.Join(lines, "\n")
+}
+
+// Reads the source map of a generated file, nil if there is none
+func LoadSourceMap(goPath string) *SourceMap {
+	content, err := ioutil.ReadFile(goPath + ".map")
+	if err != nil {
+		return nil
+	}
+	res := &SourceMap{}
+	if err := json.Unmarshal(content, res); err != nil {
+		return nil
+	}
+	return res
+}
+
+// Generated code without its markers, when no source map is needed
+func StripMarkers(output string) string {
+	return markerRegexp.ReplaceAllString(output, "")
+}
@@ -0,0 +1,186 @@
+package lsp
+
+import (
+	"github.com/champii/og/lib/ast/walker"
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+	"path"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+type Document struct {
+	URI         string
+	Path        string
+	Text        string
+	Lines       []string
+	File        *common.File
+	Symbols     []*walker.Symbol
+	Diagnostics []Diagnostic
+}
+
+// Keeps the last valid AST and symbols when the new text does not parse
+func (this *Document) Update(parser *og.OgParser, text string) {
+	this.Text = text
+	this.Lines = strings.Split(text, "\n")
+	file := &common.File{
+		Path:     this.Path,
+		FullPath: this.Path,
+		Name:     path.Base(this.Path),
+		Source:   []byte(text),
+	}
+	this.Diagnostics = []Diagnostic{}
+	errs := common.Errors{}
+	errs.Add(parser.Parse(file))
+	for _, err := range errs {
+		this.addDiagnostic(err)
+	}
+	if len(this.Diagnostics) == 0 && file.Ast != nil {
+		this.File = file
+		this.Symbols = walker.RunSymbols(file.Ast)
+	}
+}
+
+// Error lines are already mapped back to the source through File.LineMapping
+func (this *Document) addDiagnostic(err *common.Error) {
+	msg := err.Msg
+	if err.Msg2 != "" {
+		msg += " '" + err.Msg2 + "'"
+	}
+	start := toPosition(this.Lines, err.Line, err.Column)
+	end := start
+	end.Character += utf16Length(err.Msg2)
+	if err.Msg2 == "" {
+		end.Character++
+	}
+	diagnostic := Diagnostic{
+		Range: Range{
+			Start: start,
+			End:   end,
+		},
+		Severity: SeverityError,
+		Source:   "og",
+		Message:  msg,
+	}
+	this.Diagnostics = append(this.Diagnostics, diagnostic)
+}
+
+// Symbol lines are already mapped back to the source through File.LineMapping
+func (this *Document) Locate(symbol *walker.Symbol) *Location {
+	return this.location(symbol.Name, symbol.Line, symbol.Col)
+}
+
+// The name under the cursor and what it refers to, nil when there is no name.
+// A name that follows a dot is a selector, even when the walker does not see it
+func (this *Document) Reference(pos Position) (*walker.Definition, string) {
+	start, end := this.wordBounds(pos)
+	if start == end {
+		return nil, ""
+	}
+	line := this.Lines[pos.Line]
+	name := line[start:end]
+	res := &walker.Definition{}
+	if this.File != nil {
+		res = walker.RunDefinition(this.File.Ast, pos.Line+1, utf8.RuneCountInString(line[:start]))
+	}
+	if start > 0 && line[start-1] == '.' {
+		res.Selector = true
+	}
+	return res, name
+}
+
+// The name in the source line of a node, that can start before it.
+// The columns count the runes, the LSP characters count the UTF-16 units
+func (this *Document) location(name string, line, col int) *Location {
+	start := toPosition(this.Lines, line, col)
+	end := start
+	end.Character += utf16Length(name)
+	if len(this.Lines) > start.Line {
+		text := []rune(this.Lines[start.Line])
+		if col > len(text) {
+			col = len(text)
+		}
+		if idx := strings.Index(string(text[col:len(text)]), name); idx >= 0 {
+			col += utf8.RuneCountInString(string(text[col:len(text)])[:idx])
+			start.Character = utf16Length(string(text[:col]))
+			end.Character = start.Character + utf16Length(name)
+		}
+	}
+	return &Location{
+		URI: this.URI,
+		Range: Range{
+			Start: start,
+			End:   end,
+		},
+	}
+}
+
+// The byte bounds of the identifier under the cursor in its line, equal when
+// there is none
+func (this Document) wordBounds(pos Position) (int, int) {
+	if pos.Line >= len(this.Lines) {
+		return 0, 0
+	}
+	line := this.Lines[pos.Line]
+	if pos.Character > utf16Length(line) {
+		return 0, 0
+	}
+	start := byteOffset(line, pos.Character)
+	end := start
+	for start > 0 && isIdentChar(line[start-1]) {
+		start--
+	}
+	for len(line) > end && isIdentChar(line[end]) {
+		end++
+	}
+	return start, end
+}
+func NewDocument(uri, filePath string) *Document {
+	return &Document{
+		URI:  uri,
+		Path: filePath,
+	}
+}
+func isIdentChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+// Converts a 1-based line and a column, where a tab counts for one character,
+// into a 0-based LSP position in the original text, counted in UTF-16 units
+func toPosition(lines []string, line, column int) Position {
+	if line > 0 {
+		line--
+	}
+	if line >= len(lines) {
+		return Position{
+			Line:      line,
+			Character: column,
+		}
+	}
+	runes := []rune(lines[line])
+	if column > len(runes) {
+		column = len(runes)
+	}
+	return Position{
+		Line:      line,
+		Character: utf16Length(string(runes[:column])),
+	}
+}
+
+// The length of a text for the LSP, that counts the UTF-16 units
+func utf16Length(text string) int {
+	return len(utf16.Encode([]rune(text)))
+}
+
+// The byte offset in a line of an LSP character, counted in UTF-16 units
+func byteOffset(line string, character int) int {
+	units := 0
+	for i, r := range line {
+		if units >= character {
+			return i
+		}
+		units += len(utf16.Encode([]rune{r}))
+	}
+	return len(line)
+}
@@ -0,0 +1,278 @@
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"github.com/champii/og/lib/ast/walker"
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"path/filepath"
+)
+
+type Server struct {
+	Version   string
+	reader    *bufio.Reader
+	writer    io.Writer
+	parser    *og.OgParser
+	documents map[string]*Document
+}
+
+// Serves until `exit` or the end of the input
+func (this *Server) Run() error {
+	for true {
+		msg, err := ReadMessage(this.reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+		if err := this.handle(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (this *Server) handle(msg *Message) error {
+	method := msg.Method
+	switch method {
+	case "initialize":
+		return this.initialize(msg)
+	case "initialized":
+		return nil
+	case "shutdown":
+		return this.reply(msg, nil)
+	case "textDocument/didOpen":
+		return this.didOpen(msg)
+	case "textDocument/didChange":
+		return this.didChange(msg)
+	case "textDocument/didClose":
+		return this.didClose(msg)
+	case "textDocument/documentSymbol":
+		return this.documentSymbol(msg)
+	case "textDocument/definition":
+		return this.definition(msg)
+	}
+	// Unknown notifications are ignored
+	if msg.ID != nil {
+		return this.replyError(msg, CodeMethodNotFound, "Unknown method: "+method)
+	}
+	return nil
+}
+func (this *Server) initialize(msg *Message) error {
+	result := InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync:       TextDocumentSyncFull,
+			DocumentSymbolProvider: true,
+			DefinitionProvider:     true,
+		},
+		ServerInfo: ServerInfo{
+			Name:    "og",
+			Version: this.Version,
+		},
+	}
+	return this.reply(msg, result)
+}
+func (this *Server) didOpen(msg *Message) error {
+	params := DidOpenTextDocumentParams{}
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return nil
+	}
+	doc := NewDocument(params.TextDocument.URI, uriToPath(params.TextDocument.URI))
+	this.documents[doc.URI] = doc
+	return this.update(doc, params.TextDocument.Text)
+}
+func (this *Server) didChange(msg *Message) error {
+	params := DidChangeTextDocumentParams{}
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return nil
+	}
+	doc, ok := this.documents[params.TextDocument.URI]
+	if !ok || len(params.ContentChanges) == 0 {
+		return nil
+	}
+	// Full sync: the last change holds the whole text
+	return this.update(doc, params.ContentChanges[len(params.ContentChanges)-1].Text)
+}
+func (this *Server) didClose(msg *Message) error {
+	params := DidCloseTextDocumentParams{}
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return nil
+	}
+	delete(this.documents, params.TextDocument.URI)
+	return this.publishDiagnostics(params.TextDocument.URI, []Diagnostic{})
+}
+func (this *Server) update(doc *Document, text string) error {
+	doc.Update(this.parser, text)
+	return this.publishDiagnostics(doc.URI, doc.Diagnostics)
+}
+func (this *Server) publishDiagnostics(uri string, diagnostics []Diagnostic) error {
+	if diagnostics == nil {
+		diagnostics = []Diagnostic{}
+	}
+	notification := Notification{
+		Jsonrpc: "2.0",
+		Method:  "textDocument/publishDiagnostics",
+		Params: PublishDiagnosticsParams{
+			URI:         uri,
+			Diagnostics: diagnostics,
+		},
+	}
+	return WriteMessage(this.writer, notification)
+}
+func (this *Server) documentSymbol(msg *Message) error {
+	params := DocumentSymbolParams{}
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return this.replyError(msg, CodeInvalidParams, err.Error())
+	}
+	res := []SymbolInformation{}
+	doc, ok := this.documents[params.TextDocument.URI]
+	if !ok {
+		return this.reply(msg, res)
+	}
+	for _, symbol := range doc.Symbols {
+		info := SymbolInformation{
+			Name:          symbol.Name,
+			Kind:          symbolKind(symbol.Kind),
+			Location:      *doc.Locate(symbol),
+			ContainerName: symbol.Container,
+		}
+		res = append(res, info)
+	}
+	return this.reply(msg, res)
+}
+func (this *Server) definition(msg *Message) error {
+	params := TextDocumentPositionParams{}
+	if err := json.Unmarshal(msg.Params, &params); err != nil {
+		return this.replyError(msg, CodeInvalidParams, err.Error())
+	}
+	doc, ok := this.documents[params.TextDocument.URI]
+	if !ok {
+		return this.reply(msg, nil)
+	}
+	ref, name := doc.Reference(params.Position)
+	if ref == nil || ref.Imported {
+		return this.reply(msg, nil)
+	}
+	if ref.Found != nil {
+		return this.reply(msg, []Location{*doc.location(name, ref.Found.Line(), ref.Found.Col())})
+	}
+	if locations := this.findDefinitions(doc, name, ref); len(locations) > 0 {
+		return this.reply(msg, locations)
+	}
+	return this.reply(msg, nil)
+}
+
+// Looks in the current document and in the other files of its package. A
+// name that follows a dot is a method, of the type of its receiver when it
+// is known and has one, of any type otherwise
+func (this *Server) findDefinitions(doc *Document, name string, ref *walker.Definition) []Location {
+	all := []Location{}
+	typed := []Location{}
+	for _, other := range this.packageDocuments(doc) {
+		for _, symbol := range other.Symbols {
+			if symbol.Name != name || (symbol.Container != "") != ref.Selector {
+				continue
+			}
+			location := *other.Locate(symbol)
+			all = append(all, location)
+			if ref.Type != "" && symbol.Container == ref.Type {
+				typed = append(typed, location)
+			}
+		}
+	}
+	if len(typed) > 0 {
+		return typed
+	}
+	return all
+}
+
+// The document and the other files of its package, read from the disk when
+// they are not opened
+func (this *Server) packageDocuments(doc *Document) []*Document {
+	res := []*Document{doc}
+	paths, _ := filepath.Glob(filepath.Join(filepath.Dir(doc.Path), "*.og"))
+	for _, p := range paths {
+		uri := pathToURI(p)
+		if uri == doc.URI {
+			continue
+		}
+		other, ok := this.documents[uri]
+		if !ok {
+			source, err := ioutil.ReadFile(p)
+			if err != nil {
+				continue
+			}
+			other = NewDocument(uri, p)
+			other.Update(this.parser, string(source))
+		}
+		res = append(res, other)
+	}
+	return res
+}
+func (this *Server) reply(msg *Message, result interface{}) error {
+	if msg.ID == nil {
+		return nil
+	}
+	return WriteMessage(this.writer, Response{
+		Jsonrpc: "2.0",
+		ID:      msg.ID,
+		Result:  result,
+	})
+}
+func (this *Server) replyError(msg *Message, code int, message string) error {
+	if msg.ID == nil {
+		return nil
+	}
+	err := &ResponseError{
+		Code:    code,
+		Message: message,
+	}
+	return WriteMessage(this.writer, ErrorResponse{
+		Jsonrpc: "2.0",
+		ID:      msg.ID,
+		Error:   err,
+	})
+}
+func NewServer(r io.Reader, w io.Writer) *Server {
+	return &Server{
+		reader:    bufio.NewReader(r),
+		writer:    w,
+		parser:    og.NewOgParser(common.NewOgConfig()),
+		documents: make(map[string]*Document),
+	}
+}
+func symbolKind(kind string) int {
+	switch kind {
+	case walker.SymbolMethod:
+		return SymbolKindMethod
+	case walker.SymbolStruct:
+		return SymbolKindStruct
+	case walker.SymbolInterface:
+		return SymbolKindInterface
+	}
+	return SymbolKindFunction
+}
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	return u.Path
+}
+func pathToURI(p string) string {
+	if abs, err := filepath.Abs(p); err == nil {
+		p = abs
+	}
+	u := url.URL{
+		Scheme: "file",
+		Path:   filepath.ToSlash(p),
+	}
+	return u.String()
+}
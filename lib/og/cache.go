@@ -0,0 +1,128 @@
+package og
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"github.com/champii/og/lib/common"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+const (
+	CacheDir = ".og"
+)
+const (
+	CachePath = ".og/cache"
+)
+
+type CacheEntry struct {
+	Hash      string            `json:"hash"`
+	Templates map[string]string `json:"templates"` // blob path -> hash, empty if missing
+}
+
+// Hashes of the last successful compilation of each file,
+// to skip the ones that did not change since
+type Cache struct {
+	Entries map[string]*CacheEntry `json:"entries"`
+	options string
+}
+
+// Fresh when the source, the compiler and the templates it uses are the same.
+// The templates of its own package change with the other files of the package
+func (this *Cache) IsFresh(filePath string) bool {
+	filePath = filepath.Clean(filePath)
+	if !this.sourceFresh(filePath) {
+		return false
+	}
+	for blobPath, blobHash := range this.Entries[filePath].Templates {
+		if hashFile(blobPath) != blobHash {
+			return false
+		}
+	}
+	siblings, _ := filepath.Glob(filepath.Join(filepath.Dir(filePath), "*.og"))
+	for _, sibling := range siblings {
+		if sibling != filePath && !this.sourceFresh(sibling) {
+			return false
+		}
+	}
+	return true
+}
+func (this Cache) sourceFresh(filePath string) bool {
+	entry, ok := this.Entries[filePath]
+	if !ok {
+		return false
+	}
+	source, err := ioutil.ReadFile(filePath)
+	return err == nil && entry.Hash == this.hash(source)
+}
+func (this *Cache) Set(file *common.File) {
+	dir := path.Dir(file.FullPath)
+	templates := make(map[string]string)
+	ownBlob := path.Join(dir, ".og", "template")
+	templates[ownBlob] = hashFile(ownBlob)
+	for _, importPath := range file.Imports {
+		blobPath := path.Join(common.PackageDir(dir, importPath), ".og", "template")
+		templates[blobPath] = hashFile(blobPath)
+	}
+	this.Entries[filepath.Clean(file.Path)] = &CacheEntry{
+		Hash:      this.hash(file.Source),
+		Templates: templates,
+	}
+}
+func (this Cache) Save() error {
+	content, err := json.Marshal(this)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(CacheDir, os.ModePerm); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(CachePath, content, 0644)
+}
+func (this Cache) hash(source []byte) string {
+	sum := sha256.Sum256(append([]byte(this.options), source...))
+	return fmt.Sprintf("%x", sum)
+}
+
+// A missing or invalid cache is an empty one
+func LoadCache(config *common.OgConfig) *Cache {
+	res := &Cache{
+		Entries: make(map[string]*CacheEntry),
+		options: fmt.Sprint(compilerHash(), config.OutPath, config.LineDirectives, config.Generics),
+	}
+	if content, err := ioutil.ReadFile(CachePath); err == nil {
+		json.Unmarshal(content, res)
+	}
+	if res.Entries == nil {
+		res.Entries = make(map[string]*CacheEntry)
+	}
+	return res
+}
+
+// Another build of the compiler can give another output, even with the same version
+func compilerHash() string {
+	executable, err := os.Executable()
+	if err != nil {
+		return common.Version
+	}
+	return hashFile(executable)
+}
+
+// Empty for a missing file
+func hashFile(filePath string) string {
+	content, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprintf("%x", sha256.Sum256(content))
+}
+func CleanCache() error {
+	err := os.Remove(CachePath)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
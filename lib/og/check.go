@@ -0,0 +1,100 @@
+package og
+
+import (
+	"github.com/champii/og/lib/common"
+	goast "go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// The files of a package to type check
+type checkPackage struct {
+	name  string
+	files []*goast.File
+}
+
+// Type checks the generated files in memory, along with the Go files next to them,
+// and maps the errors back to the Og sources. Nothing is written
+func CheckTypes(files []*common.File) error {
+	fset := token.NewFileSet()
+	maps := NewSourceMaps()
+	packages := make(map[string]*checkPackage)
+	outputs := make(map[string]bool)
+	errs := common.Errors{}
+	for _, file := range files {
+		outputs[filepath.Clean(file.OutPath)] = true
+	}
+	for _, file := range files {
+		parsed, err := parser.ParseFile(fset, file.OutPath, file.Output, parser.ParseComments)
+		if err != nil {
+			errs.Add(common.NewError(file.Path, nil, 0, 0, err.Error(), ""))
+			continue
+		}
+		maps.maps[file.OutPath] = file.SourceMap
+		maps.sources[file.OutPath] = strings.Split(file.Output, "\n")
+		maps.sources[file.Path] = strings.Split(string(file.Source), "\n")
+		dir := filepath.Dir(file.OutPath)
+		key := dir + ":" + parsed.Name.Name
+		pack, ok := packages[key]
+		if !ok {
+			pack = &checkPackage{name: parsed.Name.Name}
+			pack.files = parseSiblings(fset, dir, pack.name, outputs)
+			packages[key] = pack
+		}
+		pack.files = append(pack.files, parsed)
+	}
+	keys := []string{}
+	for key, _ := range packages {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	conf := types.Config{
+		Importer:    importer.ForCompiler(fset, "source", nil),
+		FakeImportC: true,
+		Error: func(err error) {
+			errs.Add(checkError(maps, err))
+		},
+	}
+	for _, key := range keys {
+		conf.Check(packages[key].name, fset, packages[key].files, nil)
+	}
+	return errs.Err()
+}
+
+// The Go files of the package `name` in `dir` that are not generated by this
+// compilation, and that the build constraints keep
+func parseSiblings(fset *token.FileSet, dir, name string, outputs map[string]bool) []*goast.File {
+	res := []*goast.File{}
+	paths, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	for _, goPath := range paths {
+		if outputs[goPath] || strings.HasSuffix(goPath, "_test.go") {
+			continue
+		}
+		if matched, err := build.Default.MatchFile(dir, filepath.Base(goPath)); err != nil || !matched {
+			continue
+		}
+		parsed, err := parser.ParseFile(fset, goPath, nil, parser.ParseComments)
+		if err != nil || parsed.Name.Name != name {
+			continue
+		}
+		res = append(res, parsed)
+	}
+	return res
+}
+
+// The positions in the generated files are the ones before the `//line`
+// directives, the source maps already take them into account
+func checkError(maps *SourceMaps, err error) *common.Error {
+	typeErr, ok := err.(types.Error)
+	if !ok {
+		return common.NewError("", nil, 0, 0, err.Error(), "")
+	}
+	pos := typeErr.Fset.PositionFor(typeErr.Pos, false)
+	return maps.Error(pos.Filename, pos.Line, pos.Column, typeErr.Msg)
+}
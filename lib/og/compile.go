@@ -11,17 +11,17 @@ import (
 )
 
 type OgCompiler struct {
-	Config  *common.OgConfig
-	Parser  *OgParser
-	Preproc *OgPreproc
-	Files   []*common.File
+	Config *common.OgConfig
+	Parser *OgParser
+	Files  []*common.File
+	Cache  *Cache
 }
 
 func (this *OgCompiler) Compile() error {
+	this.Cache = LoadCache(this.Config)
 	for _, p := range this.Config.Paths {
 		if err := filepath.Walk(p, this.walker); err != nil {
-			fmt.Println("Error", err)
-			return err
+			return common.NewError(p, nil, 0, 0, err.Error(), "")
 		}
 	}
 	if len(this.Files) == 0 {
@@ -43,24 +43,52 @@ func (this *OgCompiler) Compile() error {
 	if this.Config.Blocks {
 		return nil
 	}
-	walker.NewDesugar().Run(this.Files)
+	if !this.Config.NoCheck {
+		if err := walker.RunTypeChecker(this.Files); err != nil {
+			return err
+		}
+	}
+	desugar := walker.NewDesugar()
+	desugar.Generics = this.Config.Generics
+	desugar.NoStore = this.Config.Check
+	if err := desugar.Run(this.Files); err != nil {
+		return err
+	}
 	return this.outputFiles()
 }
 func (this *OgCompiler) outputFiles() error {
+	errs := common.Errors{}
 	for _, file := range this.Files {
 		file.Output = file.Ast.Eval()
-		if !this.Config.Dirty {
-			if err := file.Format(); err != nil {
-				return err
-			}
+		if this.Config.Dirty {
+			file.ExtractSourceMap()
+		} else if err := file.Format(); err != nil {
+			errs.Add(err)
+			continue
+		}
+		if this.Config.LineDirectives {
+			file.AddLineDirectives()
+		}
+		if this.Config.Check {
+			continue
 		}
 		if this.Config.Print || this.Config.Dirty || this.Config.Blocks {
 			fmt.Println(file.Output)
 		} else {
 			file.Write()
+			this.Cache.Set(file)
 		}
 	}
-	return nil
+	if this.Config.Check {
+		if len(errs) == 0 {
+			errs.Add(CheckTypes(this.Files))
+		}
+		return errs.Err()
+	}
+	if !this.Config.Print && !this.Config.Dirty && !this.Config.Blocks {
+		errs.Add(this.Cache.Save())
+	}
+	return errs.Err()
 }
 func (this *OgCompiler) walker(filePath string, info os.FileInfo, err error) error {
 	if err != nil {
@@ -80,19 +108,17 @@ func (this *OgCompiler) walker(filePath string, info os.FileInfo, err error) err
 }
 func (this OgCompiler) mustCompile(filePath string, info os.FileInfo) bool {
 	newPath := this.getNewPath(filePath)
-	stat, err := os.Stat(newPath)
-	if err != nil {
+	if _, err := os.Stat(newPath); err != nil {
 		return true
 	}
-	if this.Config.Ast || this.Config.Print || this.Config.Dirty || this.Config.Blocks || this.Config.Force {
+	if this.Config.Ast || this.Config.Print || this.Config.Dirty || this.Config.Blocks || this.Config.Check || this.Config.Force {
 		return true
 	}
-	return info.ModTime().After(stat.ModTime())
+	return !this.Cache.IsFresh(filePath)
 }
 func (this *OgCompiler) ParseFile(file *common.File) error {
-	this.Preproc.Run(file)
 	if this.Config.Blocks {
-		fmt.Println(file.Output)
+		fmt.Println(NewOgLexer(file).Blocks())
 		return nil
 	}
 	var (
@@ -117,9 +143,8 @@ func (this OgCompiler) getNewPath(filePath string) string {
 }
 func NewOgCompiler(config *common.OgConfig) *OgCompiler {
 	return &OgCompiler{
-		Config:  config,
-		Parser:  NewOgParser(config),
-		Preproc: NewOgPreproc(),
-		Files:   []*common.File{},
+		Config: config,
+		Parser: NewOgParser(config),
+		Files:  []*common.File{},
 	}
 }
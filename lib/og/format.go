@@ -0,0 +1,112 @@
+package og
+
+import (
+	"fmt"
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/common"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+)
+
+// `og fmt`: prints the canonical form of the .og files found in the paths,
+// or of the standard input when there is none
+type FormatCommand struct {
+	Write bool // Replaces the files instead of printing them
+	List  bool // Prints the files that are not formatted
+	Diff  bool // Prints the changes
+	errs  common.Errors
+}
+
+func (this *FormatCommand) Run(paths []string) error {
+	if len(paths) == 0 {
+		return this.stdin()
+	}
+	for _, p := range paths {
+		if err := filepath.Walk(p, this.walk); err != nil {
+			this.errs.Add(common.NewError(p, nil, 0, 0, err.Error(), ""))
+		}
+	}
+	return this.errs.Err()
+}
+func (this *FormatCommand) walk(filePath string, info os.FileInfo, err error) error {
+	if err != nil {
+		return err
+	}
+	if info.IsDir() || path.Ext(filePath) != ".og" {
+		return nil
+	}
+	this.errs.Add(this.file(filePath, info.Mode()))
+	return nil
+}
+func (this *FormatCommand) file(filePath string, mode os.FileMode) error {
+	source, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+	res, err := FormatSource(filePath, source)
+	if err != nil {
+		return err
+	}
+	changed := res != string(source)
+	if this.List && changed {
+		fmt.Println(filePath)
+	}
+	if this.Diff && changed {
+		if err := printDiff(filePath, res); err != nil {
+			return err
+		}
+	}
+	if this.Write && changed {
+		return ioutil.WriteFile(filePath, []byte(res), mode)
+	}
+	if !this.List && !this.Diff && !this.Write {
+		fmt.Print(res)
+	}
+	return nil
+}
+func (this FormatCommand) stdin() error {
+	source, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		return err
+	}
+	res, err := FormatSource("STDIN", source)
+	if err != nil {
+		return err
+	}
+	fmt.Print(res)
+	return nil
+}
+
+// Parses an Og source and prints it back in its canonical form, with its comments
+func FormatSource(filePath string, source []byte) (string, error) {
+	file := &common.File{
+		Path:   filePath,
+		Name:   path.Base(filePath),
+		Source: source,
+	}
+	if err := NewOgParser(common.NewOgConfig()).Parse(file); err != nil {
+		return "", err
+	}
+	return ast.NewFormatter(source).Format(file.Ast.(*ast.SourceFile)), nil
+}
+
+// Unified diff between a file and its formatted source
+func printDiff(filePath, formatted string) error {
+	tmp, err := ioutil.TempFile("", "og_fmt")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	tmp.WriteString(formatted)
+	tmp.Close()
+	out, err := exec.Command("diff", "-u", "-L", filePath+".orig", "-L", filePath, filePath, tmp.Name()).Output()
+	// diff exits with 1 when the files differ
+	if _, ok := err.(*exec.ExitError); err != nil && !ok {
+		return err
+	}
+	fmt.Print(string(out))
+	return nil
+}
@@ -3,53 +3,244 @@ package og
 import (
 	"bufio"
 	"fmt"
+	"github.com/champii/og/lib/ast"
+	"github.com/champii/og/lib/ast/walker"
 	"github.com/champii/og/lib/common"
-	"io/ioutil"
+	"go/format"
 	"os"
-	"os/exec"
+	"strings"
 )
 
+const (
+	replHelp = `:type <expr>  Print the type of an expression
+:ast <code>   Print the AST of some code
+:go [code]    Print the Go code generated for some code, or for the whole session
+:help         Print this help
+:quit         Exit the interpreter
+
+Unfinished code, like a line that opens a block, is followed by the next lines,
+up to an empty line or to a line that is not indented and ends the code.
+Each statement builds and runs the session again, which takes a moment`
+)
+
+// Reads Og code line by line. The declarations and the variables are kept along
+// the session, which is built and run again for each statement.
 func RunInterpreter(compiler *OgCompiler) {
-	fmt.Println("-> This is an experimental feature, only small expressions are allowed, no declaration")
-	running := true
+	session, err := NewSession()
+	if err != nil {
+		common.Print.Errors(err)
+		return
+	}
+	defer session.Close()
+	compiler.Config.Quiet = true
 	scanner := bufio.NewScanner(os.Stdin)
 	scanner.Split(bufio.ScanLines)
-	for running {
-		fmt.Print("> ")
-		if !scanner.Scan() {
+	for true {
+		input, ok := ReadInput(compiler, scanner)
+		if !ok || input == ":quit" {
 			return
 		}
-		ln := scanner.Text()
-		if len(ln) == 0 {
+		if len(strings.TrimSpace(input)) == 0 {
 			continue
 		}
-		file := &common.File{
-			Path:   "STDIN",
-			Name:   "STDIN",
-			Source: []byte(ln),
+		if err := runInput(compiler, session, input); err != nil {
+			common.Print.Errors(err)
 		}
-		err := compiler.ParseFile(file)
-		if err != nil {
-			fmt.Println(err)
-		} else {
-			execCode(file.Output)
-		}
-	}
-}
-func execCode(str string) {
-	skelton := `package main
-  import "fmt"
-  func main() {
-  fmt.Print(` + str[:len(str)-1] + `)
-  }`
-	ioutil.WriteFile("/tmp/main.go", []byte(skelton), os.ModePerm)
-	cmd := exec.Command("go", "run", "/tmp/main.go")
-	stdin, _ := cmd.StdinPipe()
-	stdin.Write([]byte(str))
-	stdin.Close()
-	final, err := cmd.CombinedOutput()
+	}
+}
+
+// A line, followed by the next ones while the code is unfinished. Once it
+// goes on, an indented line can always be followed by more of its block
+func ReadInput(compiler *OgCompiler, scanner *bufio.Scanner) (string, bool) {
+	fmt.Print("> ")
+	if !scanner.Scan() {
+		return "", false
+	}
+	input := scanner.Text()
+	if strings.HasPrefix(input, ":") || !unfinished(compiler, input) {
+		return input, true
+	}
+	for true {
+		fmt.Print("... ")
+		if !scanner.Scan() {
+			break
+		}
+		line := scanner.Text()
+		if len(strings.TrimSpace(line)) == 0 {
+			break
+		}
+		input += "\n" + line
+		if !indented(line) && !unfinished(compiler, input) {
+			break
+		}
+	}
+	return input, true
+}
+
+// The parser stops at the end of the code, that misses a block or the rest of
+// an expression. A struct or an interface that has no block yet is given one
+func unfinished(compiler *OgCompiler, code string) bool {
+	file := replFile(code)
+	var (
+		err error
+	)
+	if strings.HasPrefix(strings.TrimSpace(code), "import") {
+		file = replFile("!main\n" + code)
+		err = compiler.Parser.Parse(file)
+	} else {
+		err = compiler.Parser.ParseInterpret(file)
+	}
+	if errs, ok := err.(common.Errors); ok && len(errs) > 0 {
+		return errs[0].Msg2 == "<EOF>"
+	}
+	interp, ok := file.Ast.(*ast.Interpret)
+	if err != nil || !ok || interp.TopLevel == nil || interp.TopLevel.Declaration == nil {
+		return false
+	}
+	decl := interp.TopLevel.Declaration.TypeDecl
+	if decl == nil {
+		return false
+	}
+	if decl.StructType != nil {
+		return !strings.Contains(decl.StructType.Text(), "{")
+	}
+	return decl.InterfaceType != nil && !strings.Contains(decl.InterfaceType.Text(), "{")
+}
+func indented(line string) bool {
+	return len(line) > 0 && (line[0] == ' ' || line[0] == '\t')
+}
+func runInput(compiler *OgCompiler, session *Session, input string) error {
+	command := ""
+	code := input
+	if strings.HasPrefix(input, ":") {
+		fields := strings.SplitN(input, " ", 2)
+		command = fields[0]
+		code = ""
+		if len(fields) > 1 {
+			code = strings.TrimSpace(fields[1])
+		}
+	}
+	switch command {
+	case ":help":
+		fmt.Println(replHelp)
+	case ":type":
+		return printType(compiler, session, code)
+	case ":ast":
+		return printAst(compiler, code)
+	case ":go":
+		return printGo(compiler, session, code)
+	case "":
+		return runCode(compiler, session, code)
+	default:
+		return common.NewError("repl", nil, 0, 0, "Unknown command", command)
+	}
+	return nil
+}
+func runCode(compiler *OgCompiler, session *Session, code string) error {
+	if strings.HasPrefix(strings.TrimSpace(code), "import") {
+		return addImports(compiler, session, code)
+	}
+	interp, err := compileInput(compiler, code)
 	if err != nil {
-		fmt.Println(err)
+		return err
+	}
+	goCode := strings.TrimSpace(common.StripMarkers(interp.Eval()))
+	if interp.TopLevel != nil {
+		return session.AddDecl(goCode)
+	}
+	return session.Run(goCode)
+}
+func printType(compiler *OgCompiler, session *Session, code string) error {
+	interp, err := compileInput(compiler, code)
+	if err != nil {
+		return err
+	}
+	if interp.Statement == nil {
+		return common.NewError("repl", nil, 0, 0, "Not an expression", code)
+	}
+	typ, isExpr, err := session.Check(strings.TrimSpace(common.StripMarkers(interp.Eval())), nil)
+	if err != nil {
+		return err
+	}
+	if !isExpr {
+		return common.NewError("repl", nil, 0, 0, "Not an expression", code)
+	}
+	if typ == nil {
+		fmt.Println("no value")
+	} else {
+		fmt.Println(typ.String())
+	}
+	return nil
+}
+func printAst(compiler *OgCompiler, code string) error {
+	interp, err := compileInput(compiler, code)
+	if err != nil {
+		return err
+	}
+	walker.Print(interp, false)
+	return nil
+}
+
+// Without code, the program generated for the session is printed
+func printGo(compiler *OgCompiler, session *Session, code string) error {
+	goCode := session.Program("", nil)
+	if len(code) > 0 {
+		interp, err := compileInput(compiler, code)
+		if err != nil {
+			return err
+		}
+		goCode = common.StripMarkers(interp.Eval())
+	}
+	if formatted, err := format.Source([]byte(goCode)); err == nil {
+		goCode = string(formatted)
+	}
+	fmt.Println(strings.TrimSpace(goCode))
+	return nil
+}
+
+// Parses an import declaration as the header of an Og file
+func addImports(compiler *OgCompiler, session *Session, code string) error {
+	file := replFile("!main\n" + code)
+	if err := compiler.Parser.Parse(file); err != nil {
+		return err
+	}
+	tree := file.Ast.(*ast.SourceFile)
+	if tree.Import == nil {
+		return nil
+	}
+	for _, spec := range tree.Import.Items {
+		goSpec := strings.TrimSpace(spec.Eval())
+		session.AddImport(importAlias(goSpec), goSpec)
+	}
+	return nil
+}
+func compileInput(compiler *OgCompiler, code string) (*ast.Interpret, error) {
+	file := replFile(code)
+	if err := compiler.ParseFile(file); err != nil {
+		return nil, err
+	}
+	interp := file.Ast.(*ast.Interpret)
+	// A lone identifier is parsed as a function declaration without body
+	if decl := interp.TopLevel; decl != nil && decl.FunctionDecl != nil && decl.FunctionDecl.Function == nil {
+		file = replFile(code)
+		if err := compiler.Parser.ParseStmt(file); err != nil {
+			return nil, err
+		}
+		interp = &ast.Interpret{
+			Node:      common.NewNodeNoCtx(&ast.Interpret{}),
+			Statement: file.Ast.(*ast.Statement),
+		}
+	}
+	file.Ast = interp
+	if err := walker.RunReturnable(file, nil); err != nil {
+		return nil, err
+	}
+	return file.Ast.(*ast.Interpret), nil
+}
+func replFile(code string) *common.File {
+	return &common.File{
+		Path:   "STDIN",
+		Name:   "STDIN",
+		Source: []byte(code + "\n"),
 	}
-	fmt.Println(string(final))
 }
@@ -0,0 +1,171 @@
+package og
+
+import (
+	"github.com/champii/antlr4/runtime/Go/antlr"
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/parser"
+	"strings"
+)
+
+// Wraps the generated lexer to turn the indentation into blocks.
+// An INDENT is emitted at the end of the line that opens the block, and each
+// DEDENT on its own line. They keep the text of the braces they stand for.
+// Only the lines holding tokens count: the strings, the comments and the
+// blank lines are left alone, and the tokens keep their source position.
+type OgLexer struct {
+	*parser.OgLexer
+	file    *common.File
+	lines   []string
+	indents []int
+	pending []antlr.Token
+	hidden  []antlr.Token
+	last    antlr.Token
+	header  bool // An `if` or a `for` header is opened on the current line
+	Errors  common.Errors
+}
+
+func (this *OgLexer) NextToken() antlr.Token {
+	for len(this.pending) == 0 {
+		this.fill()
+	}
+	res := this.pending[0]
+	this.pending = this.pending[1:len(this.pending)]
+	return res
+}
+
+// Reads up to the next token of the default channel and queues it,
+// preceded by its hidden tokens and by the blocks it opens or closes
+func (this *OgLexer) fill() {
+	token := this.OgLexer.NextToken()
+	if token.GetChannel() != antlr.TokenDefaultChannel && token.GetTokenType() != antlr.TokenEOF {
+		this.hidden = append(this.hidden, token)
+		return
+	}
+	eof := token.GetTokenType() == antlr.TokenEOF
+	if this.last == nil && !eof {
+		this.indents = []int{this.indentOf(token.GetLine())}
+	} else if this.last != nil && (eof || token.GetLine() > lastLine(this.last)) {
+		this.newLine(token, eof)
+	}
+	// The grammar only takes identifiers as import aliases,
+	// the blank one of `"embed": _` is made one
+	if token.GetTokenType() == parser.OgLexerBLANK && this.last != nil && this.last.GetTokenType() == parser.OgLexerCOLON {
+		token = this.GetTokenFactory().Create(this.GetTokenSourceCharStreamPair(), parser.OgLexerIDENTIFIER, "_", antlr.TokenDefaultChannel, token.GetStart(), token.GetStop(), token.GetLine(), token.GetColumn())
+	}
+	this.pending = append(this.pending, this.hidden...)
+	this.pending = append(this.pending, token)
+	this.hidden = []antlr.Token{}
+	ttype := token.GetTokenType()
+	if ttype == parser.OgLexerIF || ttype == parser.OgLexerFOR {
+		this.header = true
+	} else if ttype == parser.OgLexerARROW {
+		this.header = false
+	}
+	this.last = token
+}
+
+// `token` starts a line, it opens a block or closes some
+func (this *OgLexer) newLine(token antlr.Token, eof bool) {
+	header := this.header
+	this.header = false
+	indent := 0
+	if !eof {
+		indent = this.indentOf(token.GetLine())
+	}
+	top := this.indents[len(this.indents)-1]
+	if indent > top {
+		// The condition of an `if` or a `for` ends before its block
+		if header {
+			this.pending = append(this.pending, this.after(parser.OgLexerSEMI, ";"))
+		}
+		this.pending = append(this.pending, this.after(parser.OgParserINDENT, "{"))
+		this.indents = append(this.indents, indent)
+		return
+	}
+	closing := []antlr.Token{}
+	for len(this.indents) > 1 && indent < this.indents[len(this.indents)-1] {
+		// Between two levels, the line stays in the inner block
+		if indent > this.indents[len(this.indents)-2] {
+			this.Errors.Add(this.file.Error(token.GetLine(), token.GetColumn(), "Inconsistent indentation", ""))
+			this.indents[len(this.indents)-1] = indent
+			break
+		}
+		this.indents = this.indents[:len(this.indents)-1]
+		column := this.indents[len(this.indents)-1]
+		closing = append(closing, this.create(parser.OgParserDEDENT, "}", antlr.TokenDefaultChannel, token, column))
+		closing = append(closing, this.create(parser.OgLexerTERMINATOR, "\n", antlr.TokenHiddenChannel, token, 0))
+	}
+	// The blocks are closed before the indentation of the line
+	at := len(this.hidden)
+	if at > 0 && this.hidden[at-1].GetTokenType() == parser.OgLexerWS {
+		at--
+	}
+	this.hidden = append(this.hidden[:at], append(closing, this.hidden[at:len(this.hidden)]...)...)
+}
+
+// A token placed right after the last one
+func (this *OgLexer) after(ttype int, text string) antlr.Token {
+	line := lastLine(this.last)
+	column := this.last.GetColumn() + len(this.last.GetText())
+	if line > this.last.GetLine() {
+		column = len(this.last.GetText()) - strings.LastIndex(this.last.GetText(), "\n") - 1
+	}
+	return this.GetTokenFactory().Create(this.GetTokenSourceCharStreamPair(), ttype, text, antlr.TokenDefaultChannel, -1, -1, line, column)
+}
+
+// A token placed on the line of `next`
+func (this *OgLexer) create(ttype int, text string, channel int, next antlr.Token, column int) antlr.Token {
+	line := next.GetLine()
+	if next.GetTokenType() == antlr.TokenEOF {
+		line = len(this.lines)
+	}
+	return this.GetTokenFactory().Create(this.GetTokenSourceCharStreamPair(), ttype, text, channel, -1, -1, line, column)
+}
+
+// Width of the leading whitespaces of a line, a tab counts for two spaces
+func (this OgLexer) indentOf(line int) int {
+	res := 0
+	for _, c := range this.lines[line-1] {
+		if c == ' ' {
+			res++
+		} else if c == '\t' {
+			res += 2
+		} else {
+			break
+		}
+	}
+	return res
+}
+
+// The source with the blocks as seen by the parser
+func (this *OgLexer) Blocks() string {
+	res := ""
+	for token := this.NextToken(); token.GetTokenType() != antlr.TokenEOF; token = this.NextToken() {
+		if token.GetTokenType() == parser.OgParserINDENT {
+			res += " "
+		} else if token.GetTokenType() == parser.OgParserDEDENT {
+			res += strings.Repeat(" ", token.GetColumn())
+		}
+		res += token.GetText()
+	}
+	return res
+}
+
+// Line of the end of a token, that can hold new lines
+func lastLine(token antlr.Token) int {
+	return token.GetLine() + strings.Count(token.GetText(), "\n")
+}
+func NewOgLexer(file *common.File) *OgLexer {
+	lines := strings.Split(string(file.Source), "\n")
+	// The tokens keep their source lines
+	file.LineMapping = []int{}
+	for i := 0; len(lines) >= i; i++ {
+		file.LineMapping = append(file.LineMapping, i)
+	}
+	return &OgLexer{
+		OgLexer: parser.NewOgLexer(antlr.NewInputStream(string(file.Source))),
+		file:    file,
+		lines:   lines,
+		indents: []int{0},
+	}
+}
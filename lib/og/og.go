@@ -5,22 +5,41 @@ import (
 	"github.com/champii/og/lib/common"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
 )
 
+// Exit status of a binary run with `-r`, to be forwarded by og
+type ExitError struct {
+	Status int
+}
+
+func (this ExitError) Error() string {
+	return "exit status " + strconv.Itoa(this.Status)
+}
+
 type Og struct {
 	Config   *common.OgConfig
 	Compiler *OgCompiler
+	tempDir  string // The folder of the binary of a single file, removed after the run
 }
 
-func (this Og) Run() error {
+func (this *Og) Run() error {
 	if len(this.Config.Paths) == 0 {
 		this.Config.Paths = []string{"."}
 	}
+	defer this.removeTempDir()
 	if this.Config.Interpreter {
 		RunInterpreter(this.Compiler)
 		return nil
 	}
+	if this.Config.Watch {
+		return this.Watch()
+	}
 	if err := this.Compiler.Compile(); err != nil {
 		return err
 	}
@@ -30,7 +49,7 @@ func (this Og) Run() error {
 			return nil
 		}
 	}
-	if this.Config.Print || this.Config.Ast || this.Config.SimpleAst || this.Config.Blocks || this.Config.Dirty {
+	if this.Config.Print || this.Config.Ast || this.Config.SimpleAst || this.Config.Blocks || this.Config.Dirty || this.Config.Check {
 		return nil
 	}
 	if !this.Config.NoBuild {
@@ -45,11 +64,23 @@ func (this Og) Run() error {
 	}
 	return nil
 }
-func (this Og) Build() error {
+func (this *Og) Build() error {
 	common.Print.Compiling(len(this.Compiler.Files))
-	cmd := exec.Command("go", "build")
+	args := []string{"build"}
+	// A single file is built apart from the rest of its folder
+	if file := this.singleFile(); len(file) > 0 {
+		binary, err := this.tempBinary(file)
+		if err != nil {
+			return err
+		}
+		args = append(args, "-o", binary, this.Compiler.getNewPath(file))
+	}
+	cmd := exec.Command("go", args...)
 	out, err := cmd.CombinedOutput()
 	if err != nil {
+		if errs := NewSourceMaps().BuildErrors(string(out)); len(errs) > 0 {
+			return errs
+		}
 		fmt.Println(string(out))
 		return err
 	}
@@ -58,21 +89,79 @@ func (this Og) Build() error {
 	}
 	return nil
 }
-func (this Og) RunBinary() error {
-	dir, err := os.Getwd()
+
+// The signals received by og are forwarded to the binary
+func (this *Og) RunBinary() error {
+	process, err := this.StartBinary()
 	if err != nil {
 		return err
 	}
-	current := path.Base(dir)
-	common.Print.Running()
-	cmd := exec.Command("./" + current)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
-	if err = cmd.Start(); err != nil {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go process.Forward(signals)
+	err = process.Wait()
+	signal.Stop(signals)
+	close(signals)
+	exitErr, ok := err.(*exec.ExitError)
+	if !ok {
 		return err
 	}
-	cmd.Wait()
-	return nil
+	// Killed by a signal, as a shell would report it
+	if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return &ExitError{Status: 128 + int(status.Signal())}
+	}
+	return &ExitError{Status: exitErr.ExitCode()}
+}
+func (this *Og) StartBinary() (*Process, error) {
+	binary, err := this.binaryPath()
+	if err != nil {
+		return nil, err
+	}
+	common.Print.Running()
+	process := NewProcess(exec.Command(binary, this.Config.RunArgs...))
+	if err = process.Start(); err != nil {
+		return nil, err
+	}
+	return process, nil
+}
+
+// The binary of a single file is put in a temporary folder,
+// otherwise it is named after the current one
+func (this *Og) binaryPath() (string, error) {
+	if file := this.singleFile(); len(file) > 0 {
+		return this.tempBinary(file)
+	}
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	return "./" + path.Base(dir), nil
+}
+
+// The .og file given alone on the command line, if any
+func (this Og) singleFile() string {
+	if len(this.Config.Paths) == 1 && path.Ext(this.Config.Paths[0]) == ".og" {
+		return this.Config.Paths[0]
+	}
+	return ""
+}
+
+// Each run has its own folder, so that two runs of a same file do not share their binary
+func (this *Og) tempBinary(file string) (string, error) {
+	if this.tempDir == "" {
+		dir, err := os.MkdirTemp("", "og-run")
+		if err != nil {
+			return "", err
+		}
+		this.tempDir = dir
+	}
+	return filepath.Join(this.tempDir, strings.TrimSuffix(path.Base(file), ".og")), nil
+}
+func (this *Og) removeTempDir() {
+	if this.tempDir != "" {
+		os.RemoveAll(this.tempDir)
+		this.tempDir = ""
+	}
 }
 func NewOg(config *common.OgConfig) *Og {
 	common.Print = common.NewPrinter(config)
@@ -1,10 +1,6 @@
 package og
 
 import (
-	"errors"
-	"os"
-	"strings"
-
 	"github.com/champii/antlr4/runtime/Go/antlr"
 	"github.com/champii/og/lib/ast"
 	"github.com/champii/og/lib/ast/walker"
@@ -17,8 +13,89 @@ type ErrorHandler struct {
 	*antlr.DefaultErrorStrategy
 }
 
-func (this ErrorHandler) Recover(p antlr.Parser, r antlr.RecognitionException) {
-	os.Exit(1)
+// Single token insertion and deletion do not know about line boundaries
+// and would merge two statements, so every error goes through Recover
+func (this ErrorHandler) RecoverInline(p antlr.Parser) antlr.Token {
+	if err := antlr.NewInputMisMatchException(p); err != nil {
+		panic(err)
+	}
+	return nil
+}
+func (this ErrorHandler) Sync(p antlr.Parser) {
+	return
+}
+
+// The block parsed by Recover reports the actual error
+func (this ErrorHandler) ReportError(p antlr.Parser, e antlr.RecognitionException) {
+	if !isBodyPrediction(p) {
+		this.DefaultErrorStrategy.ReportError(p, e)
+	}
+}
+
+// Unwinds up to the enclosing statement or top level declaration,
+// then skips what remains of it so the parse can go on
+func (this *ErrorHandler) Recover(p antlr.Parser, e antlr.RecognitionException) {
+	ctx := p.GetParserRuleContext()
+	if ctx.GetParent() == nil {
+		return
+	}
+	if isBodyPrediction(p) {
+		p.(*parser.OgParser).Block()
+	} else if _, ok := ctx.(*parser.StatementContext); ok {
+		this.sync(p, ctx, false)
+	} else if _, ok := ctx.(*parser.TopLevelDeclContext); ok {
+		this.sync(p, ctx, true)
+	} else {
+		panic(e)
+	}
+}
+
+// Consumes tokens until the next line that is not more indented than `ctx`,
+// or until the `}` that closes the enclosing block
+func (this ErrorHandler) sync(p antlr.Parser, ctx antlr.ParserRuleContext, topLevel bool) {
+	stream := p.GetTokenStream()
+	start := ctx.GetStart()
+	errorLine := p.GetCurrentToken().GetLine()
+	// The offending token can already be the start of the next line
+	if stream.Index() > start.GetTokenIndex() {
+		errorLine = stream.LT(-1).GetLine()
+	}
+	// Blocks opened by the rule before the error
+	depth := 0
+	for i := start.GetTokenIndex(); stream.Index() > i; i++ {
+		switch stream.Get(i).GetText() {
+		case "{":
+			depth++
+		case "}":
+			depth--
+		}
+	}
+	for true {
+		token := p.GetCurrentToken()
+		text := token.GetText()
+		if token.GetTokenType() == antlr.TokenEOF {
+			return
+		}
+		if depth <= 0 && token.GetLine() > errorLine && text != "}" && token.GetColumn() <= start.GetColumn() {
+			return
+		}
+		if text == "{" {
+			depth++
+		} else if text == "}" {
+			if depth == 0 && !topLevel {
+				return
+			}
+			depth--
+		}
+		p.Consume()
+	}
+}
+
+// `'->' (block | statement)` is predicted by scanning the whole body,
+// so an error anywhere in it fails the prediction of the function
+func isBodyPrediction(p antlr.Parser) bool {
+	_, ok := p.GetParserRuleContext().(*parser.FunctionContext)
+	return ok && p.GetCurrentToken().GetText() == "{"
 }
 func NewErrorHandler() *ErrorHandler {
 	return &ErrorHandler{DefaultErrorStrategy: antlr.NewDefaultErrorStrategy()}
@@ -26,19 +103,24 @@ func NewErrorHandler() *ErrorHandler {
 
 type ErrorListener struct {
 	*antlr.DefaultErrorListener
-	filePath string
-	source   []string
+	file   *common.File
+	Errors common.Errors
 }
 
 func (this *ErrorListener) SyntaxError(rec antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	err := common.NewError(this.filePath, this.source, line, column, "Unexpected", offendingSymbol.(antlr.Token).GetText())
-	common.Print.Error(err)
+	if len(this.file.LineMapping) > line {
+		line = this.file.LineMapping[line]
+	}
+	text := ""
+	if token, ok := offendingSymbol.(antlr.Token); ok {
+		text = token.GetText()
+	}
+	this.Errors = append(this.Errors, this.file.Error(line, column, "Unexpected", text))
 }
-func NewErrorListener(filePath, source string) *ErrorListener {
+func NewErrorListener(file *common.File) *ErrorListener {
 	return &ErrorListener{
 		DefaultErrorListener: antlr.NewDefaultErrorListener(),
-		filePath:             filePath,
-		source:               strings.Split(source, "\n"),
+		file:                 file,
 	}
 }
 
@@ -46,27 +128,36 @@ type OgParser struct {
 	Config *common.OgConfig
 }
 
-func (this *OgParser) parserInit(file *common.File) *parser.OgParser {
-	input := antlr.NewInputStream(string(file.Output))
-	lexer := parser.NewOgLexer(input)
+func (this *OgParser) parserInit(file *common.File, listener *ErrorListener) (*parser.OgParser, *OgLexer) {
+	lexer := NewOgLexer(file)
 	stream := antlr.NewCommonTokenStream(lexer, 0)
 	p := parser.NewOgParser(stream)
 	p.GetInterpreter().SetPredictionMode(antlr.PredictionModeSLL)
 	p.RemoveErrorListeners()
 	p.SetErrorHandler(NewErrorHandler())
-	p.AddErrorListener(NewErrorListener(file.Path, string(file.Source)))
+	p.AddErrorListener(listener)
 	p.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
-	return p
+	// p.SetErrorHandler(antlr.NewBailErrorStrategy())
+
+	return p, lexer
 }
 func (this *OgParser) Parse(file *common.File) error {
-	p := this.parserInit(file)
+	listener := NewErrorListener(file)
+	p, lexer := this.parserInit(file, listener)
 	res := p.SourceFile()
+	if errs := append(lexer.Errors, listener.Errors...); len(errs) > 0 {
+		return errs
+	}
 	if res == nil {
-		return errors.New("Cannot parse file: " + file.Path)
+		return file.Error(0, 0, "Cannot parse file", "")
 	}
 	t := new(translator.OgVisitor)
 	t.File = file
 	tree := t.VisitSourceFile(res.(*parser.SourceFileContext), t).(*ast.SourceFile)
+	if len(t.Errors) > 0 {
+		return t.Errors
+	}
+	walker.AttachComments(tree, string(file.Source))
 	if this.Config.Ast || this.Config.SimpleAst {
 		walker.Print(tree, this.Config.SimpleAst)
 	}
@@ -75,18 +166,28 @@ func (this *OgParser) Parse(file *common.File) error {
 	return nil
 }
 func (this *OgParser) ParseStmt(file *common.File) error {
-	p := this.parserInit(file)
+	listener := NewErrorListener(file)
+	p, lexer := this.parserInit(file, listener)
 	res := p.Statement()
+	if errs := append(lexer.Errors, listener.Errors...); len(errs) > 0 {
+		return errs
+	}
 	t := new(translator.OgVisitor)
+	t.File = file
 	file.Ast = t.VisitStatement(res.(*parser.StatementContext), t).(*ast.Statement)
-	return nil
+	return t.Errors.Err()
 }
 func (this *OgParser) ParseInterpret(file *common.File) error {
-	p := this.parserInit(file)
+	listener := NewErrorListener(file)
+	p, lexer := this.parserInit(file, listener)
 	res := p.Interp()
+	if errs := append(lexer.Errors, listener.Errors...); len(errs) > 0 {
+		return errs
+	}
 	t := new(translator.OgVisitor)
+	t.File = file
 	file.Ast = t.VisitInterp(res.(*parser.InterpContext), t).(*ast.Interpret)
-	return nil
+	return t.Errors.Err()
 }
 func NewOgParser(config *common.OgConfig) *OgParser {
 	return &OgParser{Config: config}
@@ -56,16 +56,14 @@ func (this *Pool) Run() error {
 			this.Print()
 		}
 	}()
+	errs := common.Errors{}
 	for this.Finished < this.Total {
-		if err := <-this.Out; err != nil {
-			ticker.Stop()
-			return err
-		}
+		errs.Add(<-this.Out)
 		this.Finished++
 	}
 	ticker.Stop()
 	this.Print()
-	return nil
+	return errs.Err()
 }
 func (this Pool) Print() {
 	workerIds := []int{}
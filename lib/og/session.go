@@ -0,0 +1,580 @@
+package og
+
+import (
+	"encoding/gob"
+	"github.com/champii/og/lib/common"
+	goast "go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type SessionDecl struct {
+	Names []string
+	Code  string
+	Types []string // The types it declares, that an interface can hold in the saved values
+}
+
+// What a statement declared for the next ones
+type SessionStmt struct {
+	Consts string // Declared again as they are
+	Vars   []*SessionVar
+}
+
+// A variable declared by a statement, its value is saved after each one
+type SessionVar struct {
+	Name string
+	Type string
+}
+
+// The part of the saved variables that tells the ones that could not be saved
+type savedState struct {
+	Errors map[string]string
+}
+
+// Declarations and statements of a REPL session. The program is generated,
+// built and run again for each statement, the variables of the previous ones
+// are loaded and saved around the new one so they are never run again.
+type Session struct {
+	dir      string
+	imports  map[string]string // alias -> spec
+	aliases  []string
+	decls    []*SessionDecl
+	stmts    []*SessionStmt
+	fset     *token.FileSet
+	importer types.Importer
+	state    *goast.File    // The code that saves and loads the variables
+	defined  []types.Object // The names declared by the last checked statement
+}
+
+func (this *Session) AddImport(alias, spec string) {
+	if _, ok := this.imports[alias]; !ok {
+		this.aliases = append(this.aliases, alias)
+	}
+	this.imports[alias] = spec
+}
+
+// A declaration with the same names replaces the previous one, it is type checked
+// with the rest of the session but nothing is run
+func (this *Session) AddDecl(code string) error {
+	names, err := declNames(code)
+	if err != nil {
+		return err
+	}
+	decls := this.decls
+	decl := &SessionDecl{
+		Names: names,
+		Code:  code,
+		Types: declTypes(code),
+	}
+	replaced := false
+	for i, other := range this.decls {
+		if strings.Join(other.Names, ",") == strings.Join(names, ",") {
+			this.decls = append(append([]*SessionDecl{}, this.decls[:i]...), this.decls[i+1:len(this.decls)]...)
+			this.decls = append(this.decls, decl)
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		this.decls = append(this.decls, decl)
+	}
+	if _, _, err := this.Check("", nil); err != nil {
+		this.decls = decls
+		return err
+	}
+	return nil
+}
+
+// Runs a statement, printing its value if it has one. The variables that cannot
+// be kept for the next statements are reported as warnings
+func (this *Session) Run(code string) error {
+	names, err := stmtNames(code)
+	if err != nil {
+		return err
+	}
+	typ, isExpr, err := this.Check(code, names)
+	if err != nil {
+		return err
+	}
+	stmt := &SessionStmt{Consts: constDecls(code)}
+	warnings := common.Errors{}
+	for _, obj := range this.defined {
+		if _, ok := obj.(*types.Const); ok {
+			continue
+		}
+		if _, ok := obj.(*types.TypeName); ok {
+			warnings.Add(notKept(obj.Name(), "a type is only kept as a declaration"))
+			continue
+		}
+		if hasLocalType(obj.Type()) {
+			warnings.Add(notKept(obj.Name(), "its type is declared by the statement"))
+			continue
+		}
+		stmt.Vars = append(stmt.Vars, &SessionVar{
+			Name: obj.Name(),
+			Type: types.TypeString(obj.Type(), this.qualifier),
+		})
+	}
+	saved := []string{}
+	for _, v := range this.vars() {
+		if !contains(names, v.Name) {
+			saved = append(saved, v.Name)
+		}
+	}
+	for _, v := range stmt.Vars {
+		saved = append(saved, v.Name)
+	}
+	// Calls with several results are only run
+	_, isTuple := typ.(*types.Tuple)
+	if isExpr && typ != nil && !isTuple {
+		code = "__og_fmt.Println(" + code + ")"
+	}
+	if err := this.execute(this.program(code, names, saved)); err != nil {
+		return err
+	}
+	this.forget(names)
+	if len(stmt.Consts) > 0 || len(stmt.Vars) > 0 {
+		this.stmts = append(this.stmts, stmt)
+	}
+	if len(saved) == 0 {
+		return warnings.Err()
+	}
+	errs, err := this.stateErrors()
+	if err != nil {
+		return err
+	}
+	for _, name := range saved {
+		if msg, ok := errs[name]; ok {
+			warnings.Add(notKept(name, msg))
+			this.forget([]string{name})
+		}
+	}
+	return warnings.Err()
+}
+
+// The variables of the previous statements that are still visible
+func (this *Session) vars() []*SessionVar {
+	res := []*SessionVar{}
+	for _, stmt := range this.stmts {
+		res = append(res, stmt.Vars...)
+	}
+	return res
+}
+
+// The variables hidden by `names` are not declared anymore, nor the
+// statements that have nothing left
+func (this *Session) forget(names []string) {
+	stmts := []*SessionStmt{}
+	for _, stmt := range this.stmts {
+		vars := []*SessionVar{}
+		for _, v := range stmt.Vars {
+			if !contains(names, v.Name) {
+				vars = append(vars, v)
+			}
+		}
+		stmt.Vars = vars
+		if len(stmt.Consts) > 0 || len(stmt.Vars) > 0 {
+			stmts = append(stmts, stmt)
+		}
+	}
+	this.stmts = stmts
+}
+
+// The variables that could not be saved by the last statement, with the reason
+func (this Session) stateErrors() (map[string]string, error) {
+	state := savedState{}
+	file, err := os.Open(this.statePath())
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	if err := gob.NewDecoder(file).Decode(&state); err != nil {
+		return nil, err
+	}
+	return state.Errors, nil
+}
+func (this Session) statePath() string {
+	return filepath.Join(this.dir, "state")
+}
+
+// The name of a package in the type of a variable, it is imported when it is not
+func (this *Session) qualifier(pkg *types.Package) string {
+	if pkg.Path() == "main" {
+		return ""
+	}
+	for _, alias := range this.aliases {
+		fields := strings.Fields(this.imports[alias])
+		if alias != "." && alias != "_" && strings.Trim(fields[len(fields)-1], "\"") == pkg.Path() {
+			return alias
+		}
+	}
+	this.AddImport(pkg.Name(), pkg.Name()+" "+strconv.Quote(pkg.Path()))
+	return pkg.Name()
+}
+
+// Type checks the session with `next` as its last statement, declaring `names`.
+// When `next` is an expression, its type is returned, nil for a call without result
+func (this *Session) Check(next string, names []string) (types.Type, bool, error) {
+	program := this.Program(next, names)
+	file, err := parser.ParseFile(this.fset, "main.go", program, 0)
+	if err != nil {
+		return nil, false, common.NewError("repl", nil, 0, 0, err.Error(), "")
+	}
+	block := this.innerBlock(file)
+	expr := lastExpr(block)
+	errs := common.Errors{}
+	conf := types.Config{
+		Importer: this.importer,
+		Error: func(err error) {
+			errs.Add(common.NewError("repl", nil, 0, 0, typeError(err), ""))
+		},
+	}
+	info := &types.Info{
+		Types:  make(map[goast.Expr]types.TypeAndValue),
+		Scopes: make(map[goast.Node]*types.Scope),
+	}
+	conf.Check("main", this.fset, []*goast.File{
+		file,
+		this.state,
+	}, info)
+	// A lone expression is not used
+	if expr != nil {
+		kept := common.Errors{}
+		for _, err := range errs {
+			if !strings.HasSuffix(err.Msg, "is not used") {
+				kept = append(kept, err)
+			}
+		}
+		errs = kept
+	}
+	if len(errs) > 0 {
+		return nil, false, errs
+	}
+	this.defined = []types.Object{}
+	if scope := info.Scopes[block]; scope != nil {
+		for _, name := range names {
+			if obj := scope.Lookup(name); obj != nil {
+				this.defined = append(this.defined, obj)
+			}
+		}
+	}
+	if expr == nil {
+		return nil, false, nil
+	}
+	typ := info.Types[expr].Type
+	if tuple, ok := typ.(*types.Tuple); ok && tuple.Len() == 0 {
+		return nil, true, nil
+	}
+	return typ, true, nil
+}
+
+// The block of the new statement in the generated main
+func (this *Session) innerBlock(file *goast.File) *goast.BlockStmt {
+	for _, decl := range file.Decls {
+		fun, ok := decl.(*goast.FuncDecl)
+		if !ok || fun.Name.Name != "main" || fun.Recv != nil {
+			continue
+		}
+		block := fun.Body
+		for i := 0; i <= len(this.stmts); i++ {
+			block = block.List[len(block.List)-1].(*goast.BlockStmt)
+		}
+		return block
+	}
+	return nil
+}
+func (this Session) Program(next string, names []string) string {
+	return this.program(next, names, nil)
+}
+
+// The names of each statement are declared in a new block, so the next ones
+// can declare the same names again. The variables are loaded before `next`,
+// and the `saved` ones are saved after it
+func (this Session) program(next string, names, saved []string) string {
+	decls := ""
+	for _, decl := range this.decls {
+		decls += decl.Code + "\n"
+	}
+	body := ""
+	loaded := []string{}
+	for _, stmt := range this.stmts {
+		body += stmt.Consts
+		for _, v := range stmt.Vars {
+			body += "var " + v.Name + " " + v.Type + "\n"
+			loaded = append(loaded, v.Name)
+		}
+		body += "{\n"
+	}
+	if len(loaded) > 0 {
+		body += this.register() + "__og_load(" + strconv.Quote(this.statePath()) + ", " + varsMap(loaded) + ")\n"
+	}
+	body += "{\n" + next + "\n" + useNames(names)
+	if len(saved) > 0 {
+		if len(loaded) == 0 {
+			body += this.register()
+		}
+		body += "__og_save(" + strconv.Quote(this.statePath()) + ", " + varsMap(saved) + ")\n"
+	}
+	body += strings.Repeat("}\n", len(this.stmts)+1)
+	imports := ""
+	if isUsed(body, "__og_fmt") {
+		imports += "__og_fmt \"fmt\"\n"
+	}
+	for _, alias := range this.aliases {
+		if alias == "." || alias == "_" || isUsed(decls+body, alias) {
+			imports += this.imports[alias] + "\n"
+		}
+	}
+	return "package main\n\nimport (\n" + imports + ")\n\n" + decls + "\nfunc main() {\n" + body + "}\n"
+}
+
+// The types of the declarations, that an interface can hold in a saved variable
+func (this *Session) register() string {
+	res := ""
+	for _, decl := range this.decls {
+		for _, t := range decl.Types {
+			res += "(*" + t + ")(nil), "
+		}
+	}
+	if res == "" {
+		return ""
+	}
+	return "__og_register(" + res + ")\n"
+}
+
+// Builds and runs the program
+func (this Session) execute(program string) error {
+	mainPath := filepath.Join(this.dir, "main.go")
+	binPath := filepath.Join(this.dir, "repl")
+	if err := ioutil.WriteFile(mainPath, []byte(program), 0644); err != nil {
+		return err
+	}
+	build := exec.Command("go", "build", "-o", binPath, mainPath, filepath.Join(this.dir, "state.go"))
+	build.Dir = this.dir
+	if out, err := build.CombinedOutput(); err != nil {
+		return common.NewError("repl", nil, 0, 0, "Cannot build the session", string(out))
+	}
+	cmd := exec.Command(binPath)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+func (this Session) Close() {
+	os.RemoveAll(this.dir)
+}
+func NewSession() (*Session, error) {
+	dir, err := ioutil.TempDir("", "og_repl")
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "state.go"), []byte(sessionState), 0644); err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	state, err := parser.ParseFile(fset, "state.go", sessionState, 0)
+	if err != nil {
+		return nil, err
+	}
+	res := &Session{
+		dir:      dir,
+		imports:  make(map[string]string),
+		fset:     fset,
+		importer: importer.ForCompiler(fset, "source", nil),
+		state:    state,
+	}
+	return res, nil
+}
+
+// Alias under which an import spec like `foo "some/repo"` is used
+func importAlias(spec string) string {
+	fields := strings.Fields(spec)
+	if len(fields) > 1 {
+		return fields[0]
+	}
+	return path.Base(strings.Trim(spec, "\""))
+}
+
+// Unused imports are an error, they are only added once referenced
+func isUsed(code, alias string) bool {
+	return regexp.MustCompile(`\b` + regexp.QuoteMeta(alias) + `\.`).MatchString(code)
+}
+
+// The last statement of a block, if it is an expression
+func lastExpr(block *goast.BlockStmt) goast.Expr {
+	if block == nil || len(block.List) == 0 {
+		return nil
+	}
+	if stmt, ok := block.List[len(block.List)-1].(*goast.ExprStmt); ok {
+		return stmt.X
+	}
+	return nil
+}
+
+// The address of each variable by its name
+func varsMap(names []string) string {
+	res := "map[string]interface{}{"
+	for _, name := range names {
+		res += strconv.Quote(name) + ": &" + name + ", "
+	}
+	return res + "}"
+}
+func useNames(names []string) string {
+	res := ""
+	for _, name := range names {
+		res += "_ = " + name + "\n"
+	}
+	return res
+}
+
+// Names declared by Go top level declarations
+func declNames(code string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package main\n"+code, 0)
+	if err != nil {
+		return nil, common.NewError("repl", nil, 0, 0, err.Error(), "")
+	}
+	res := []string{}
+	for _, decl := range file.Decls {
+		if fun, ok := decl.(*goast.FuncDecl); ok {
+			name := fun.Name.Name
+			if fun.Recv != nil && len(fun.Recv.List) > 0 {
+				name = types.ExprString(fun.Recv.List[0].Type) + "." + name
+			}
+			res = append(res, name)
+		} else if gen, ok := decl.(*goast.GenDecl); ok {
+			for _, spec := range gen.Specs {
+				res = append(res, specNames(spec)...)
+			}
+		}
+	}
+	return res, nil
+}
+
+// The types declared by Go top level declarations, but the templates of Go
+func declTypes(code string) []string {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package main\n"+code, 0)
+	if err != nil {
+		return nil
+	}
+	res := []string{}
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*goast.GenDecl); ok {
+			for _, spec := range gen.Specs {
+				if typeSpec, ok := spec.(*goast.TypeSpec); ok && typeSpec.TypeParams == nil {
+					res = append(res, typeSpec.Name.Name)
+				}
+			}
+		}
+	}
+	return res
+}
+
+// The constant declarations of a Go statement, as they are written
+func constDecls(code string) string {
+	prefix := "package main\nfunc _() {\n"
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", prefix+code+"\n}", 0)
+	if err != nil {
+		return ""
+	}
+	res := ""
+	for _, stmt := range file.Decls[0].(*goast.FuncDecl).Body.List {
+		if declStmt, ok := stmt.(*goast.DeclStmt); ok {
+			if decl, ok := declStmt.Decl.(*goast.GenDecl); ok && decl.Tok == token.CONST {
+				start := fset.Position(decl.Pos()).Offset - len(prefix)
+				end := fset.Position(decl.End()).Offset - len(prefix)
+				res += code[start:end] + "\n"
+			}
+		}
+	}
+	return res
+}
+
+// Names declared at the top of a Go statement
+func stmtNames(code string) ([]string, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), "", "package main\nfunc _() {\n"+code+"\n}", 0)
+	if err != nil {
+		return nil, common.NewError("repl", nil, 0, 0, err.Error(), "")
+	}
+	res := []string{}
+	for _, stmt := range file.Decls[0].(*goast.FuncDecl).Body.List {
+		if assign, ok := stmt.(*goast.AssignStmt); ok && assign.Tok == token.DEFINE {
+			for _, expr := range assign.Lhs {
+				if ident, ok := expr.(*goast.Ident); ok && ident.Name != "_" {
+					res = append(res, ident.Name)
+				}
+			}
+		} else if declStmt, ok := stmt.(*goast.DeclStmt); ok {
+			if decl, ok := declStmt.Decl.(*goast.GenDecl); ok {
+				for _, spec := range decl.Specs {
+					for _, name := range specNames(spec) {
+						if name != "_" {
+							res = append(res, name)
+						}
+					}
+				}
+			}
+		}
+	}
+	return res, nil
+}
+func specNames(spec goast.Spec) []string {
+	res := []string{}
+	if typeSpec, ok := spec.(*goast.TypeSpec); ok {
+		res = append(res, typeSpec.Name.Name)
+	} else if valueSpec, ok := spec.(*goast.ValueSpec); ok {
+		for _, name := range valueSpec.Names {
+			res = append(res, name.Name)
+		}
+	}
+	return res
+}
+
+// A type declared in a statement cannot be written out of it
+func hasLocalType(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Named:
+		return t.Obj().Pkg() != nil && t.Obj().Parent() != t.Obj().Pkg().Scope()
+	case *types.Pointer:
+		return hasLocalType(t.Elem())
+	case *types.Slice:
+		return hasLocalType(t.Elem())
+	case *types.Array:
+		return hasLocalType(t.Elem())
+	case *types.Chan:
+		return hasLocalType(t.Elem())
+	case *types.Map:
+		return hasLocalType(t.Key()) || hasLocalType(t.Elem())
+	}
+	return false
+}
+func notKept(name, reason string) *common.Error {
+	res := common.NewError("repl", nil, 0, 0, name+" is not kept for the next statements, "+reason, "")
+	res.Severity = common.SeverityWarning
+	return res
+}
+func contains(arr []string, str string) bool {
+	for _, item := range arr {
+		if str == item {
+			return true
+		}
+	}
+	return false
+}
+
+// Without the position in the generated program
+func typeError(err error) string {
+	if typeErr, ok := err.(types.Error); ok {
+		return typeErr.Msg
+	}
+	return err.Error()
+}
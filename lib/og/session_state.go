@@ -0,0 +1,396 @@
+package og
+
+// Written along the program of a REPL session, it saves the variables after a
+// statement and loads them before the next one, so no statement runs twice.
+// The unexported fields are read through unsafe, the pointers that are shared
+// stay shared, and an interface only keeps a value of a registered type
+const (
+	sessionState = `package main
+
+import (
+	__og_errors "errors"
+	__og_gob "encoding/gob"
+	__og_math "math"
+	__og_os "os"
+	__og_reflect "reflect"
+	__og_sort "sort"
+	__og_unsafe "unsafe"
+)
+
+type __og_state struct {
+	Vars     map[string]int
+	Pointees []*__og_node
+	Errors   map[string]string
+}
+
+type __og_node struct {
+	Bits  uint64
+	Imag  uint64
+	Str   string
+	Nil   bool
+	Ref   int
+	Type  string
+	Names []string
+	Items []*__og_node
+}
+
+type __og_ref struct {
+	addr uintptr
+	typ  __og_reflect.Type
+}
+
+var __og_types = map[string]__og_reflect.Type{}
+
+func __og_register(ptrs ...interface{}) {
+	for _, ptr := range ptrs {
+		t := __og_reflect.TypeOf(ptr).Elem()
+		__og_types[t.String()] = t
+	}
+}
+
+func init() {
+	__og_register((*bool)(nil), (*string)(nil), (*int)(nil), (*int8)(nil), (*int16)(nil), (*int32)(nil), (*int64)(nil), (*uint)(nil), (*uint8)(nil), (*uint16)(nil), (*uint32)(nil), (*uint64)(nil), (*uintptr)(nil), (*float32)(nil), (*float64)(nil), (*complex64)(nil), (*complex128)(nil))
+}
+
+func __og_typeOf(name string) (__og_reflect.Type, bool) {
+	if t, ok := __og_types[name]; ok {
+		return t, true
+	}
+
+	if len(name) > 1 && name[0] == '*' {
+		if t, ok := __og_typeOf(name[1:]); ok {
+			return __og_reflect.PtrTo(t), true
+		}
+	}
+
+	if len(name) > 2 && name[:2] == "[]" {
+		if t, ok := __og_typeOf(name[2:]); ok {
+			return __og_reflect.SliceOf(t), true
+		}
+	}
+
+	return nil, false
+}
+
+func __og_names(vars map[string]interface{}) []string {
+	res := []string{}
+	for name := range vars {
+		res = append(res, name)
+	}
+
+	__og_sort.Strings(res)
+
+	return res
+}
+
+// The field of an addressable struct, even when it is not exported
+func __og_field(v __og_reflect.Value, i int) __og_reflect.Value {
+	field := v.Field(i)
+
+	return __og_reflect.NewAt(field.Type(), __og_unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+type __og_encoder struct {
+	state *__og_state
+	refs  map[__og_ref]int
+}
+
+func (e *__og_encoder) pointer(ptr __og_reflect.Value) (int, error) {
+	ref := __og_ref{ptr.Pointer(), ptr.Type()}
+	if id, ok := e.refs[ref]; ok {
+		return id, nil
+	}
+
+	e.state.Pointees = append(e.state.Pointees, nil)
+	id := len(e.state.Pointees)
+	e.refs[ref] = id
+
+	node, err := e.encode(ptr.Elem())
+	if err != nil {
+		return 0, err
+	}
+
+	e.state.Pointees[id-1] = node
+
+	return id, nil
+}
+
+func (e *__og_encoder) encode(v __og_reflect.Value) (*__og_node, error) {
+	res := &__og_node{}
+
+	switch v.Kind() {
+	case __og_reflect.Bool:
+		if v.Bool() {
+			res.Bits = 1
+		}
+	case __og_reflect.Int, __og_reflect.Int8, __og_reflect.Int16, __og_reflect.Int32, __og_reflect.Int64:
+		res.Bits = uint64(v.Int())
+	case __og_reflect.Uint, __og_reflect.Uint8, __og_reflect.Uint16, __og_reflect.Uint32, __og_reflect.Uint64, __og_reflect.Uintptr:
+		res.Bits = v.Uint()
+	case __og_reflect.Float32, __og_reflect.Float64:
+		res.Bits = __og_math.Float64bits(v.Float())
+	case __og_reflect.Complex64, __og_reflect.Complex128:
+		res.Bits = __og_math.Float64bits(real(v.Complex()))
+		res.Imag = __og_math.Float64bits(imag(v.Complex()))
+	case __og_reflect.String:
+		res.Str = v.String()
+	case __og_reflect.Array, __og_reflect.Slice:
+		if v.Kind() == __og_reflect.Slice && v.IsNil() {
+			res.Nil = true
+			break
+		}
+
+		for i := 0; i < v.Len(); i++ {
+			item, err := e.encode(v.Index(i))
+			if err != nil {
+				return nil, err
+			}
+
+			res.Items = append(res.Items, item)
+		}
+	case __og_reflect.Map:
+		if v.IsNil() {
+			res.Nil = true
+			break
+		}
+
+		iter := v.MapRange()
+		for iter.Next() {
+			key, err := e.encode(iter.Key())
+			if err != nil {
+				return nil, err
+			}
+
+			value, err := e.encode(iter.Value())
+			if err != nil {
+				return nil, err
+			}
+
+			res.Items = append(res.Items, key, value)
+		}
+	case __og_reflect.Struct:
+		if !v.CanAddr() {
+			copied := __og_reflect.New(v.Type()).Elem()
+			copied.Set(v)
+			v = copied
+		}
+
+		for i := 0; i < v.NumField(); i++ {
+			item, err := e.encode(__og_field(v, i))
+			if err != nil {
+				return nil, err
+			}
+
+			res.Names = append(res.Names, v.Type().Field(i).Name)
+			res.Items = append(res.Items, item)
+		}
+	case __og_reflect.Ptr:
+		if v.IsNil() {
+			res.Nil = true
+			break
+		}
+
+		id, err := e.pointer(v)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Ref = id
+	case __og_reflect.Interface:
+		if v.IsNil() {
+			res.Nil = true
+			break
+		}
+
+		res.Type = v.Elem().Type().String()
+		if _, ok := __og_typeOf(res.Type); !ok {
+			return nil, __og_errors.New("it holds a " + res.Type)
+		}
+
+		item, err := e.encode(v.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		res.Items = []*__og_node{item}
+	default:
+		if !v.IsNil() {
+			return nil, __og_errors.New("it is a " + v.Kind().String())
+		}
+
+		res.Nil = true
+	}
+
+	return res, nil
+}
+
+type __og_decoder struct {
+	state    *__og_state
+	pointers map[int]__og_reflect.Value
+}
+
+func (d *__og_decoder) decode(n *__og_node, v __og_reflect.Value) error {
+	switch v.Kind() {
+	case __og_reflect.Bool:
+		v.SetBool(n.Bits == 1)
+	case __og_reflect.Int, __og_reflect.Int8, __og_reflect.Int16, __og_reflect.Int32, __og_reflect.Int64:
+		v.SetInt(int64(n.Bits))
+	case __og_reflect.Uint, __og_reflect.Uint8, __og_reflect.Uint16, __og_reflect.Uint32, __og_reflect.Uint64, __og_reflect.Uintptr:
+		v.SetUint(n.Bits)
+	case __og_reflect.Float32, __og_reflect.Float64:
+		v.SetFloat(__og_math.Float64frombits(n.Bits))
+	case __og_reflect.Complex64, __og_reflect.Complex128:
+		v.SetComplex(complex(__og_math.Float64frombits(n.Bits), __og_math.Float64frombits(n.Imag)))
+	case __og_reflect.String:
+		v.SetString(n.Str)
+	case __og_reflect.Array:
+		for i := 0; i < v.Len() && i < len(n.Items); i++ {
+			if err := d.decode(n.Items[i], v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case __og_reflect.Slice:
+		if n.Nil {
+			return nil
+		}
+
+		slice := __og_reflect.MakeSlice(v.Type(), len(n.Items), len(n.Items))
+		for i, item := range n.Items {
+			if err := d.decode(item, slice.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		v.Set(slice)
+	case __og_reflect.Map:
+		if n.Nil {
+			return nil
+		}
+
+		m := __og_reflect.MakeMapWithSize(v.Type(), len(n.Items)/2)
+		for i := 0; i+1 < len(n.Items); i += 2 {
+			key := __og_reflect.New(v.Type().Key()).Elem()
+			if err := d.decode(n.Items[i], key); err != nil {
+				return err
+			}
+
+			value := __og_reflect.New(v.Type().Elem()).Elem()
+			if err := d.decode(n.Items[i+1], value); err != nil {
+				return err
+			}
+
+			m.SetMapIndex(key, value)
+		}
+
+		v.Set(m)
+	case __og_reflect.Struct:
+		for i, name := range n.Names {
+			if field, ok := v.Type().FieldByName(name); ok && len(field.Index) == 1 {
+				if err := d.decode(n.Items[i], __og_field(v, field.Index[0])); err != nil {
+					return err
+				}
+			}
+		}
+	case __og_reflect.Ptr:
+		if n.Nil || n.Ref == 0 || n.Ref > len(d.state.Pointees) {
+			return nil
+		}
+
+		ptr, ok := d.pointers[n.Ref]
+		if !ok {
+			ptr = __og_reflect.New(v.Type().Elem())
+			d.pointers[n.Ref] = ptr
+
+			if err := d.decode(d.state.Pointees[n.Ref-1], ptr.Elem()); err != nil {
+				return err
+			}
+		}
+
+		if ptr.Type() == v.Type() {
+			v.Set(ptr)
+		}
+	case __og_reflect.Interface:
+		if n.Nil || len(n.Items) == 0 {
+			return nil
+		}
+
+		t, ok := __og_typeOf(n.Type)
+		if !ok || !t.AssignableTo(v.Type()) {
+			return __og_errors.New("it holds a " + n.Type)
+		}
+
+		value := __og_reflect.New(t).Elem()
+		if err := d.decode(n.Items[0], value); err != nil {
+			return err
+		}
+
+		v.Set(value)
+	}
+
+	return nil
+}
+
+func __og_load(path string, vars map[string]interface{}) {
+	file, err := __og_os.Open(path)
+	if err != nil {
+		return
+	}
+
+	defer file.Close()
+
+	state := &__og_state{}
+	if err := __og_gob.NewDecoder(file).Decode(state); err != nil {
+		panic(err)
+	}
+
+	d := &__og_decoder{state: state, pointers: map[int]__og_reflect.Value{}}
+
+	for _, name := range __og_names(vars) {
+		if id, ok := state.Vars[name]; ok {
+			d.pointers[id] = __og_reflect.ValueOf(vars[name])
+		}
+	}
+
+	for _, name := range __og_names(vars) {
+		if id, ok := state.Vars[name]; ok && id <= len(state.Pointees) {
+			if err := d.decode(state.Pointees[id-1], __og_reflect.ValueOf(vars[name]).Elem()); err != nil {
+				panic("Cannot load " + name + ", " + err.Error())
+			}
+		}
+	}
+}
+
+func __og_save(path string, vars map[string]interface{}) {
+	state := &__og_state{Vars: map[string]int{}, Errors: map[string]string{}}
+	e := &__og_encoder{state: state, refs: map[__og_ref]int{}}
+
+	for _, name := range __og_names(vars) {
+		id, err := e.pointer(__og_reflect.ValueOf(vars[name]))
+		if err != nil {
+			state.Errors[name] = err.Error()
+			continue
+		}
+
+		state.Vars[name] = id
+	}
+
+	// The values that could not be encoded are left empty
+	for i, node := range state.Pointees {
+		if node == nil {
+			state.Pointees[i] = &__og_node{Nil: true}
+		}
+	}
+
+	file, err := __og_os.Create(path)
+	if err != nil {
+		panic(err)
+	}
+
+	defer file.Close()
+
+	if err := __og_gob.NewEncoder(file).Encode(state); err != nil {
+		panic(err)
+	}
+}
+`
+)
@@ -0,0 +1,184 @@
+package og
+
+import (
+	"github.com/champii/og/lib/common"
+	"io"
+	"io/ioutil"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// `file.go:line:col: message`, as printed by `go build`.
+// The file is an .og one when the output has `//line` directives
+var (
+	buildErrorRegexp = regexp.MustCompile(`^(\S+\.(?:go|og)):(\d+):(?:(\d+):)? (.*)$`)
+)
+
+// `	/path/file.go:line +0x42`, as printed in a stack trace
+var (
+	traceRegexp = regexp.MustCompile(`^(\s+)(\S+\.(?:go|og)):(\d+)( \+0x[0-9a-f]+)?$`)
+)
+
+// Source maps of the generated files, loaded on demand
+type SourceMaps struct {
+	maps    map[string]*common.SourceMap
+	sources map[string][]string
+}
+
+func (this *SourceMaps) Get(goPath string) *common.SourceMap {
+	if sourceMap, ok := this.maps[goPath]; ok {
+		return sourceMap
+	}
+	this.maps[goPath] = common.LoadSourceMap(goPath)
+	return this.maps[goPath]
+}
+func (this *SourceMaps) source(filePath string) []string {
+	if lines, ok := this.sources[filePath]; ok {
+		return lines
+	}
+	content, _ := ioutil.ReadFile(filePath)
+	this.sources[filePath] = strings.Split(string(content), "\n")
+	return this.sources[filePath]
+}
+
+// Maps a position in a generated file back to its Og source,
+// or keeps it as is when the file has no source map
+func (this *SourceMaps) Error(goPath string, line, column int, msg string) *common.Error {
+	// Already mapped by a `//line` directive
+	if path.Ext(goPath) == ".og" {
+		if column > 0 {
+			column--
+		}
+		return common.NewError(goPath, this.source(goPath), line, column, msg, "")
+	}
+	sourceMap := this.Get(goPath)
+	if sourceMap == nil {
+		return common.NewError(goPath, nil, line, column, msg, "")
+	}
+	mapping := sourceMap.Lookup(line, column)
+	if mapping == nil {
+		return common.NewError(goPath, nil, line, column, msg, "")
+	}
+	source := this.source(sourceMap.Source)
+	res := common.NewError(sourceMap.Source, source, mapping.Line, mapping.Col, msg, "")
+	// Highlight the Go identifier at the error position in the Og line
+	goSource := this.source(goPath)
+	if len(goSource) >= line && len(source) >= mapping.Line {
+		word := wordAt(goSource[line-1], column-1)
+		ogLine := source[mapping.Line-1]
+		if word != "" && len(ogLine) > mapping.Col {
+			if idx := strings.Index(ogLine[mapping.Col:len(ogLine)], word); idx >= 0 {
+				res.Column = mapping.Col + idx
+				res.Msg2 = word
+			} else if idx := strings.Index(ogLine, word); idx >= 0 {
+				res.Column = idx
+				res.Msg2 = word
+			}
+		}
+	}
+	return res
+}
+
+// Errors found in the output of `go build`
+func (this *SourceMaps) BuildErrors(output string) common.Errors {
+	errs := common.Errors{}
+	for _, line := range strings.Split(output, "\n") {
+		groups := buildErrorRegexp.FindStringSubmatch(line)
+		if groups == nil {
+			continue
+		}
+		lineNb, _ := strconv.Atoi(groups[2])
+		column, _ := strconv.Atoi(groups[3])
+		errs.Add(this.Error(groups[1], lineNb, column, groups[4]))
+	}
+	return errs
+}
+func NewSourceMaps() *SourceMaps {
+	return &SourceMaps{
+		maps:    make(map[string]*common.SourceMap),
+		sources: make(map[string][]string),
+	}
+}
+
+// Rewrites the stack traces written through it to point to the Og sources
+type TraceWriter struct {
+	out     io.Writer
+	maps    *SourceMaps
+	buf     string
+	message string
+	Error   *common.Error // Og position of the first mapped frame, if any
+}
+
+func (this *TraceWriter) Write(p []byte) (int, error) {
+	this.buf += string(p)
+	for true {
+		idx := strings.Index(this.buf, "\n")
+		if idx < 0 {
+			break
+		}
+		line := this.buf[:idx]
+		this.buf = this.buf[idx+1 : len(this.buf)]
+		if _, err := io.WriteString(this.out, this.rewrite(line)+"\n"); err != nil {
+			return 0, err
+		}
+	}
+	return len(p), nil
+}
+
+// Writes what remains of an unterminated last line
+func (this *TraceWriter) Flush() {
+	if len(this.buf) > 0 {
+		io.WriteString(this.out, this.rewrite(this.buf))
+		this.buf = ""
+	}
+}
+func (this *TraceWriter) rewrite(line string) string {
+	if strings.HasPrefix(line, "panic: ") {
+		this.message = line
+	}
+	groups := traceRegexp.FindStringSubmatch(line)
+	if groups == nil {
+		return line
+	}
+	if path.Ext(groups[2]) == ".og" {
+		lineNb, _ := strconv.Atoi(groups[3])
+		if this.Error == nil {
+			this.Error = this.maps.Error(groups[2], lineNb, 0, this.message)
+		}
+		return line
+	}
+	if this.maps.Get(groups[2]) == nil {
+		return line
+	}
+	lineNb, _ := strconv.Atoi(groups[3])
+	err := this.maps.Error(groups[2], lineNb, 0, this.message)
+	if this.Error == nil {
+		this.Error = err
+	}
+	return groups[1] + err.Path + ":" + strconv.Itoa(err.Line) + groups[4]
+}
+func NewTraceWriter(out io.Writer) *TraceWriter {
+	return &TraceWriter{
+		out:  out,
+		maps: NewSourceMaps(),
+	}
+}
+func wordAt(line string, idx int) string {
+	if idx < 0 || idx >= len(line) {
+		return ""
+	}
+	start := idx
+	end := idx
+	for start > 0 && isWordChar(line[start-1]) {
+		start--
+	}
+	for len(line) > end && isWordChar(line[end]) {
+		end++
+	}
+	return line[start:end]
+}
+func isWordChar(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
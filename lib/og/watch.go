@@ -0,0 +1,145 @@
+package og
+
+import (
+	"github.com/champii/og/lib/common"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"time"
+)
+
+const (
+	WatchInterval = 500 * time.Millisecond
+)
+
+// Polls the .og files of `Paths` for changes
+type Watcher struct {
+	Paths []string
+	files map[string]time.Time
+	next  map[string]time.Time
+}
+
+// Takes a new snapshot of the modification times, true if it differs from the last one
+func (this *Watcher) Changed() bool {
+	this.next = make(map[string]time.Time)
+	for _, p := range this.Paths {
+		filepath.Walk(p, this.visit)
+	}
+	changed := len(this.next) != len(this.files)
+	for filePath, modTime := range this.next {
+		if last, ok := this.files[filePath]; !ok || !last.Equal(modTime) {
+			changed = true
+		}
+	}
+	this.files = this.next
+	return changed
+}
+func (this *Watcher) Wait() {
+	for !this.Changed() {
+		time.Sleep(WatchInterval)
+	}
+}
+
+// Files that cannot be read anymore are only missing from the snapshot
+func (this *Watcher) visit(filePath string, info os.FileInfo, err error) error {
+	if err != nil || info.IsDir() || path.Ext(filePath) != ".og" {
+		return nil
+	}
+	this.next[filePath] = info.ModTime()
+	return nil
+}
+func NewWatcher(paths []string) *Watcher {
+	return &Watcher{
+		Paths: paths,
+		files: make(map[string]time.Time),
+	}
+}
+
+// A running binary, with its stack traces mapped back to the Og sources
+type Process struct {
+	cmd   *exec.Cmd
+	trace *TraceWriter
+	done  chan bool
+}
+
+func (this *Process) Start() error {
+	this.cmd.Stdin = os.Stdin
+	this.cmd.Stdout = os.Stdout
+	this.cmd.Stderr = this.trace
+	return this.cmd.Start()
+}
+
+// Waits for the end of the binary and shows where it panicked
+func (this *Process) Wait() error {
+	defer close(this.done)
+	err := this.cmd.Wait()
+	this.trace.Flush()
+	if err != nil && this.trace.Error != nil {
+		common.Print.Error(this.trace.Error)
+	}
+	return err
+}
+
+// Sends the received signals to the binary, until `signals` is closed
+func (this Process) Forward(signals chan os.Signal) {
+	for sig := range signals {
+		this.cmd.Process.Signal(sig)
+	}
+}
+
+// Kills the binary, `Wait` must be running in another goroutine
+func (this *Process) Stop() {
+	this.cmd.Process.Kill()
+	<-this.done
+}
+func NewProcess(cmd *exec.Cmd) *Process {
+	return &Process{
+		cmd:   cmd,
+		trace: NewTraceWriter(os.Stderr),
+		done:  make(chan bool),
+	}
+}
+
+// Only the first pass can be forced, the next ones compile the changed files
+func (this *Og) Watch() error {
+	watcher := NewWatcher(this.Config.Paths)
+	watcher.Changed()
+	var (
+		process *Process
+	)
+	for true {
+		if this.rebuild() && this.Config.Run {
+			if process != nil {
+				process.Stop()
+			}
+			if started, err := this.StartBinary(); err != nil {
+				common.Print.Errors(err)
+			} else {
+				process = started
+				go process.Wait()
+			}
+		}
+		this.Config.Force = false
+		common.Print.Watching()
+		watcher.Wait()
+	}
+	return nil
+}
+
+// Errors are printed, so they do not stop the watcher
+func (this *Og) rebuild() bool {
+	this.Compiler.Files = []*common.File{}
+	if err := this.Compiler.Compile(); err != nil {
+		common.Print.Errors(err)
+		return false
+	}
+	if this.Config.NoBuild {
+		return true
+	}
+	if err := this.Build(); err != nil {
+		common.Print.Errors(err)
+		return false
+	}
+	return true
+}
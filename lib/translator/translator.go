@@ -9,10 +9,19 @@ import (
 	"github.com/champii/og/parser"
 )
 
+// type Scope map[string]string
+
+// struct Stack
+//   scopes []Scope
+//   *newScope                -> @scopes = append([]Scope{Scope{}}, @scopes...)
+//   *popScope                -> @scopes = @scopes[1:]
+//   *add(v string, t string) -> @scopes[0][v] = t
+
 type OgVisitor struct {
 	*antlr.BaseParseTreeVisitor
-	Line int
-	File *common.File
+	Line   int
+	File   *common.File
+	Errors common.Errors // The errors of the rules that parse more than they accept
 }
 
 func (this OgVisitor) Aggregate(resultSoFar interface{}, childResult interface{}) interface{} {
@@ -29,8 +38,11 @@ func (this OgVisitor) Aggregate(resultSoFar interface{}, childResult interface{}
 			}
 		}
 	}
-	return nil
 }
+
+// *parser.SourceFileContext -> antlr.ParseTreeVisitor -> interface
+// VisitSourceFile(ctx, delegate) -> @VisitChildren(ctx, delegate)
+
 func (this *OgVisitor) VisitSourceFile(ctx *parser.SourceFileContext, delegate antlr.ParseTreeVisitor) interface{} {
 	node := &SourceFile{
 		Node:    common.NewNode(ctx, this.File, &SourceFile{}),
@@ -111,6 +123,9 @@ func (this *OgVisitor) VisitDeclaration(ctx *parser.DeclarationContext, delegate
 	if ctx.VarDecl() != nil {
 		node.VarDecl = this.VisitVarDecl(ctx.VarDecl().(*parser.VarDeclContext), delegate).(*VarDecl)
 	}
+	if ctx.EnumDecl() != nil {
+		node.EnumDecl = this.VisitEnumDecl(ctx.EnumDecl().(*parser.EnumDeclContext), delegate).(*EnumDecl)
+	}
 	return node
 }
 func (this *OgVisitor) VisitConstDecl(ctx *parser.ConstDeclContext, delegate antlr.ParseTreeVisitor) interface{} {
@@ -136,6 +151,31 @@ func (this *OgVisitor) VisitConstSpec(ctx *parser.ConstSpecContext, delegate ant
 	}
 	return node
 }
+func (this *OgVisitor) VisitEnumDecl(ctx *parser.EnumDeclContext, delegate antlr.ParseTreeVisitor) interface{} {
+	node := &EnumDecl{
+		Node: common.NewNode(ctx, this.File, &EnumDecl{}),
+		Name: ctx.IDENTIFIER().GetText(),
+	}
+	res := []*ConstSpec{}
+	bodies := ctx.AllEnumSpec()
+	for _, spec := range bodies {
+		res = append(res, this.VisitEnumSpec(spec.(*parser.EnumSpecContext), delegate).(*ConstSpec))
+	}
+	node.ConstSpecs = res
+	return node
+}
+
+// The values of an enum are the constants it is lowered to
+func (this *OgVisitor) VisitEnumSpec(ctx *parser.EnumSpecContext, delegate antlr.ParseTreeVisitor) interface{} {
+	node := &ConstSpec{
+		Node:           common.NewNode(ctx, this.File, &ConstSpec{}),
+		IdentifierList: this.VisitIdentifierList(ctx.IdentifierList().(*parser.IdentifierListContext), delegate).(*IdentifierList),
+	}
+	if ctx.ExpressionList() != nil {
+		node.ExpressionList = this.VisitExpressionList(ctx.ExpressionList().(*parser.ExpressionListContext), delegate).(*ExpressionList)
+	}
+	return node
+}
 func (this *OgVisitor) VisitIdentifierList(ctx *parser.IdentifierListContext, delegate antlr.ParseTreeVisitor) interface{} {
 	return &IdentifierList{
 		Node: common.NewNode(ctx, this.File, &IdentifierList{}),
@@ -168,6 +208,44 @@ func (this *OgVisitor) VisitTypeDecl(ctx *parser.TypeDeclContext, delegate antlr
 	if ctx.InterfaceType() != nil {
 		node.InterfaceType = this.VisitInterfaceType(ctx.InterfaceType().(*parser.InterfaceTypeContext), delegate).(*InterfaceType)
 	}
+	if ctx.DataDecl() != nil {
+		node.DataType = this.VisitDataDecl(ctx.DataDecl().(*parser.DataDeclContext), delegate).(*DataType)
+	}
+	return node
+}
+func (this *OgVisitor) VisitDataDecl(ctx *parser.DataDeclContext, delegate antlr.ParseTreeVisitor) interface{} {
+	node := &DataType{
+		Node: common.NewNode(ctx, this.File, &DataType{}),
+		Name: ctx.IDENTIFIER(1).GetText(),
+	}
+	res := []*Variant{}
+	for _, spec := range ctx.AllVariant() {
+		if variant, ok := this.VisitVariant(spec.(*parser.VariantContext), delegate).(*Variant); ok {
+			res = append(res, variant)
+		}
+	}
+	node.Variants = res
+	return node
+}
+
+// A name alone is read as a field, any other field is reported and nil is
+// returned for it
+func (this *OgVisitor) VisitVariant(ctx *parser.VariantContext, delegate antlr.ParseTreeVisitor) interface{} {
+	node := &Variant{Node: common.NewNode(ctx, this.File, &Variant{})}
+	if ctx.IDENTIFIER() == nil {
+		field := this.VisitFieldDecl(ctx.FieldDecl(0).(*parser.FieldDeclContext), delegate).(*FieldDecl)
+		if anon := field.Anonymous; anon == nil || anon.IsPointerReceiver || strings.Contains(anon.Type, ".") || len(field.Tag) > 0 {
+			start := ctx.GetStart()
+			this.Errors.Add(this.File.Error(start.GetLine(), start.GetColumn(), "A variant is a name, alone or followed by the block of its fields", ""))
+			return nil
+		}
+		node.Name = field.Anonymous.Type
+		return node
+	}
+	node.Name = ctx.IDENTIFIER().GetText()
+	for _, spec := range ctx.AllFieldDecl() {
+		node.Fields = append(node.Fields, this.VisitFieldDecl(spec.(*parser.FieldDeclContext), delegate).(*FieldDecl))
+	}
 	return node
 }
 func (this *OgVisitor) VisitTypeSpec(ctx *parser.TypeSpecContext, delegate antlr.ParseTreeVisitor) interface{} {
@@ -338,6 +416,10 @@ func (this *OgVisitor) VisitSimpleStmt(ctx *parser.SimpleStmtContext, delegate a
 	}
 	return node
 }
+
+// VisitExpressionStmt(ctx *parser.ExpressionStmtContext, delegate antlr.ParseTreeVisitor): interface ->
+//   @VisitExpression(ctx.Expression().(*parser.ExpressionContext), delegate).(*Expression)
+
 func (this *OgVisitor) VisitSendStmt(ctx *parser.SendStmtContext, delegate antlr.ParseTreeVisitor) interface{} {
 	return &SendStmt{
 		Node:  common.NewNode(ctx, this.File, &SendStmt{}),
@@ -366,6 +448,10 @@ func (this *OgVisitor) VisitAssign_op(ctx *parser.Assign_opContext, delegate ant
 	}
 	return ctx.GetText()
 }
+
+// VisitBinary_op(ctx *parser.Binary_opContext, delegate antlr.ParseTreeVisitor): interface ->
+//   return ctx.GetText()
+
 func (this *OgVisitor) VisitShortVarDecl(ctx *parser.ShortVarDeclContext, delegate antlr.ParseTreeVisitor) interface{} {
 	node := &ShortVarDecl{
 		Node:           common.NewNode(ctx, this.File, &ShortVarDecl{}),
@@ -373,6 +459,20 @@ func (this *OgVisitor) VisitShortVarDecl(ctx *parser.ShortVarDeclContext, delega
 	}
 	if ctx.ExpressionList() != nil {
 		node.Expressions = this.VisitExpressionList(ctx.ExpressionList().(*parser.ExpressionListContext), delegate).(*ExpressionList)
+		return node
+	}
+	node.Statement = &Statement{Node: common.NewNode(ctx, this.File, &Statement{})}
+	if ctx.IfStmt() != nil {
+		node.Statement.IfStmt = this.VisitIfStmt(ctx.IfStmt().(*parser.IfStmtContext), delegate).(*IfStmt)
+	}
+	if ctx.SwitchStmt() != nil {
+		node.Statement.SwitchStmt = this.VisitSwitchStmt(ctx.SwitchStmt().(*parser.SwitchStmtContext), delegate).(*SwitchStmt)
+	}
+	if ctx.SelectStmt() != nil {
+		node.Statement.SelectStmt = this.VisitSelectStmt(ctx.SelectStmt().(*parser.SelectStmtContext), delegate).(*SelectStmt)
+	}
+	if ctx.ForStmt() != nil {
+		node.Statement.ForStmt = this.VisitForStmt(ctx.ForStmt().(*parser.ForStmtContext), delegate).(*ForStmt)
 	}
 	return node
 }
@@ -456,6 +556,9 @@ func (this *OgVisitor) VisitSwitchStmt(ctx *parser.SwitchStmtContext, delegate a
 	if ctx.TypeSwitchStmt() != nil {
 		node.TypeSwitchStmt = this.VisitTypeSwitchStmt(ctx.TypeSwitchStmt().(*parser.TypeSwitchStmtContext), delegate).(*TypeSwitchStmt)
 	}
+	if ctx.MatchStmt() != nil {
+		node.MatchStmt = this.VisitMatchStmt(ctx.MatchStmt().(*parser.MatchStmtContext), delegate).(*MatchStmt)
+	}
 	return node
 }
 func (this *OgVisitor) VisitExprSwitchStmt(ctx *parser.ExprSwitchStmtContext, delegate antlr.ParseTreeVisitor) interface{} {
@@ -492,6 +595,48 @@ func (this *OgVisitor) VisitExprSwitchCase(ctx *parser.ExprSwitchCaseContext, de
 	}
 	return node
 }
+func (this *OgVisitor) VisitMatchStmt(ctx *parser.MatchStmtContext, delegate antlr.ParseTreeVisitor) interface{} {
+	node := &MatchStmt{
+		Node:       common.NewNode(ctx, this.File, &MatchStmt{}),
+		Expression: this.VisitExpression(ctx.Expression().(*parser.ExpressionContext), delegate).(*Expression),
+	}
+	res := []*MatchArm{}
+	bodies := ctx.AllMatchArm()
+	for _, spec := range bodies {
+		res = append(res, this.VisitMatchArm(spec.(*parser.MatchArmContext), delegate).(*MatchArm))
+	}
+	node.Arms = res
+	return node
+}
+func (this *OgVisitor) VisitMatchArm(ctx *parser.MatchArmContext, delegate antlr.ParseTreeVisitor) interface{} {
+	node := &MatchArm{
+		Node:       common.NewNode(ctx, this.File, &MatchArm{}),
+		Statements: this.VisitStatementList(ctx.StatementList().(*parser.StatementListContext), delegate).([]*Statement),
+	}
+	res := []*Pattern{}
+	bodies := ctx.AllPattern()
+	for _, spec := range bodies {
+		res = append(res, this.VisitPattern(spec.(*parser.PatternContext), delegate).(*Pattern))
+	}
+	node.Patterns = res
+	if ctx.Expression() != nil {
+		node.Guard = this.VisitExpression(ctx.Expression().(*parser.ExpressionContext), delegate).(*Expression)
+	}
+	return node
+}
+func (this *OgVisitor) VisitPattern(ctx *parser.PatternContext, delegate antlr.ParseTreeVisitor) interface{} {
+	var (
+		expr *Expression
+	)
+	if ctx.Expression() != nil {
+		expr = this.VisitExpression(ctx.Expression().(*parser.ExpressionContext), delegate).(*Expression)
+	}
+	binding := ""
+	if ctx.IDENTIFIER() != nil {
+		binding = ctx.IDENTIFIER().GetText()
+	}
+	return NewPattern(common.NewNode(ctx, this.File, &Pattern{}), expr, binding)
+}
 func (this *OgVisitor) VisitTypeSwitchStmt(ctx *parser.TypeSwitchStmtContext, delegate antlr.ParseTreeVisitor) interface{} {
 	node := &TypeSwitchStmt{
 		Node:            common.NewNode(ctx, this.File, &TypeSwitchStmt{}),
@@ -626,6 +771,9 @@ func (this *OgVisitor) VisitRangeClause(ctx *parser.RangeClauseContext, delegate
 		Node:       common.NewNode(ctx, this.File, &RangeClause{}),
 		Expression: this.VisitExpression(ctx.Expression().(*parser.ExpressionContext), delegate).(*Expression),
 	}
+	// if ctx.ExpressionList() != nil
+	//   node.Expressions = @VisitExpressionList(ctx.ExpressionList().(*parser.ExpressionListContext), delegate).(*ExpressionList)
+
 	if ctx.IdentifierList() != nil {
 		node.IdentifierList = this.VisitIdentifierList(ctx.IdentifierList().(*parser.IdentifierListContext), delegate).(*IdentifierList)
 	}
@@ -773,10 +921,39 @@ func (this *OgVisitor) VisitSignature(ctx *parser.SignatureContext, delegate ant
 	return node
 }
 func (this *OgVisitor) VisitTemplateSpec(ctx *parser.TemplateSpecContext, delegate antlr.ParseTreeVisitor) interface{} {
-	return &TemplateSpec{
-		Node:   common.NewNode(ctx, this.File, &TemplateSpec{}),
-		Result: this.VisitResult(ctx.Result().(*parser.ResultContext), delegate).(*Result),
+	node := &TemplateSpec{
+		Node: common.NewNode(ctx, this.File, &TemplateSpec{}),
+		Result: &Result{
+			Node:  common.NewNode(ctx, this.File, &Result{}),
+			Types: []*Type{},
+		},
+	}
+	bodies := ctx.AllTemplateParam()
+	for _, spec := range bodies {
+		param := spec.(*parser.TemplateParamContext)
+		node.Result.Types = append(node.Result.Types, this.VisitType_(param.Type_().(*parser.Type_Context), delegate).(*Type))
+		constraint := ""
+		if param.Constraint() != nil {
+			constraint = this.VisitConstraint(param.Constraint().(*parser.ConstraintContext), delegate).(string)
+		}
+		node.Constraints = append(node.Constraints, constraint)
 	}
+	return node
+}
+func (this *OgVisitor) VisitConstraint(ctx *parser.ConstraintContext, delegate antlr.ParseTreeVisitor) interface{} {
+	res := []string{}
+	bodies := ctx.AllConstraintTerm()
+	for _, spec := range bodies {
+		res = append(res, this.VisitConstraintTerm(spec.(*parser.ConstraintTermContext), delegate).(string))
+	}
+	return strings.Join(res, " | ")
+}
+func (this *OgVisitor) VisitConstraintTerm(ctx *parser.ConstraintTermContext, delegate antlr.ParseTreeVisitor) interface{} {
+	res := this.VisitType_(ctx.Type_().(*parser.Type_Context), delegate).(*Type).Eval()
+	if strings.HasPrefix(ctx.GetText(), "~") {
+		res = "~" + res
+	}
+	return res
 }
 func (this *OgVisitor) VisitResult(ctx *parser.ResultContext, delegate antlr.ParseTreeVisitor) interface{} {
 	node := &Result{Node: common.NewNode(ctx, this.File, &Result{})}
@@ -993,6 +1170,9 @@ func (this *OgVisitor) VisitInlineStructMethod(ctx *parser.InlineStructMethodCon
 		IsPointerReceiver: strings.Contains(ctx.GetText(), "*"),
 	}
 }
+
+// Warning here, can consume a `*` later in the stream
+
 func (this *OgVisitor) VisitAnonymousField(ctx *parser.AnonymousFieldContext, delegate antlr.ParseTreeVisitor) interface{} {
 	return &AnonymousField{
 		Node:              common.NewNode(ctx, this.File, &AnonymousField{}),
@@ -1000,6 +1180,9 @@ func (this *OgVisitor) VisitAnonymousField(ctx *parser.AnonymousFieldContext, de
 		IsPointerReceiver: strings.Contains(ctx.GetText(), "*"),
 	}
 }
+
+// Warning here, can consume a `*` later in the stream
+
 func (this *OgVisitor) VisitFunctionLit(ctx *parser.FunctionLitContext, delegate antlr.ParseTreeVisitor) interface{} {
 	return &FunctionLit{
 		Node:     common.NewNode(ctx, this.File, &FunctionLit{}),
@@ -1025,6 +1208,9 @@ func (this *OgVisitor) VisitSecondaryExpr(ctx *parser.SecondaryExprContext, dele
 	if ctx.Selector() != nil {
 		node.Selector = this.VisitSelector(ctx.Selector().(*parser.SelectorContext), delegate).(string)
 	}
+	if ctx.QUESTION() != nil {
+		node.Bubble = true
+	}
 	if ctx.Index() != nil {
 		node.Index = this.VisitIndex(ctx.Index().(*parser.IndexContext), delegate).(*Index)
 	}
@@ -1125,6 +1311,9 @@ func (this *OgVisitor) VisitExpression(ctx *parser.ExpressionContext, delegate a
 	if ctx.UnaryExpr() != nil {
 		node.UnaryExpr = this.VisitUnaryExpr(ctx.UnaryExpr().(*parser.UnaryExprContext), delegate).(*UnaryExpr)
 	}
+	// if ctx.FunctionLit() != nil
+	//   node.FunctionLit = @VisitFunctionLit(ctx.FunctionLit().(*parser.FunctionLitContext), delegate).(*FunctionLit)
+
 	if ctx.Expression(0) != nil {
 		node.LeftExpression = this.VisitExpression(ctx.Expression(0).(*parser.ExpressionContext), delegate).(*Expression)
 		node.Op = ctx.GetChild(1).GetPayload().(*antlr.CommonToken).GetText()
@@ -1143,6 +1332,10 @@ func (this *OgVisitor) VisitUnaryExpr(ctx *parser.UnaryExprContext, delegate ant
 	}
 	return node
 }
+
+// VisitUnary_op(ctx *parser.Unary_opContext, delegate antlr.ParseTreeVisitor): interface ->
+//   return ctx.GetText()
+
 func (this *OgVisitor) VisitConversion(ctx *parser.ConversionContext, delegate antlr.ParseTreeVisitor) interface{} {
 	return &Conversion{
 		Node:       common.NewNode(ctx, this.File, &Conversion{}),
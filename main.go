@@ -1,7 +1,7 @@
 package main
 
 import (
-	"fmt"
+	"os"
 
 	"github.com/champii/og/lib/common"
 	"github.com/champii/og/lib/og"
@@ -14,7 +14,12 @@ func main() {
 		ogLang := og.NewOg(options)
 
 		if err := ogLang.Run(); err != nil {
-			fmt.Println(err)
+			if exitErr, ok := err.(*og.ExitError); ok {
+				os.Exit(exitErr.Status)
+			}
+
+			common.Print.Errors(err)
+			os.Exit(1)
 		}
 	})
 }
@@ -32,6 +32,9 @@
  */
 grammar Og;
 
+// Emitted by the lexer for the indentation, in place of the braces
+tokens { INDENT, DEDENT }
+
 @parser::members {
 
     /**
@@ -103,6 +106,11 @@ grammar Og;
         return true
     }
 
+    // The next token is the `data` that starts a data declaration
+    func (this *OgParser) isData() bool {
+        return this.BaseParser.GetTokenStream().LT(1).GetText() == "data"
+    }
+
     func (this *OgParser) lookAhead(token int) bool {
         possibleIndexEosToken := this.BaseParser.GetCurrentToken().GetTokenIndex() - 1
 
@@ -219,7 +227,7 @@ importDecl
     ;
 
 importBody
-    : importSpec | '{' ( importSpec eos )* '}'
+    : importSpec | ( '{' | INDENT ) ( importSpec eos )* ( '}' | DEDENT )
     ;
 importSpec
     : importPath ( ':' ('.' | IDENTIFIER ))?
@@ -236,11 +244,12 @@ topLevelDecl
     | methodDecl
     ;
 
-//Declaration   = ConstDecl | TypeDecl | VarDecl .
+//Declaration   = ConstDecl | TypeDecl | VarDecl | EnumDecl .
 declaration
     : constDecl
     | typeDecl
     | varDecl
+    | enumDecl
     ;
 
 //ConstDecl      = "const" ( ConstSpec | "(" { ConstSpec ";" } ")" ) .
@@ -253,6 +262,16 @@ constSpec
     : identifierList ( type_? '=' expressionList )
     ;
 
+//EnumDecl      = "enum" identifier "{" { EnumSpec ";" } "}" .
+enumDecl
+    : 'enum' IDENTIFIER ( '{' | INDENT ) ( enumSpec eos )* ( '}' | DEDENT )
+    ;
+
+//EnumSpec      = IdentifierList [ "=" ExpressionList ] .
+enumSpec
+    : identifierList ( '=' expressionList )?
+    ;
+
 //
 //IdentifierList = identifier { "," identifier } .
 identifierList
@@ -270,6 +289,20 @@ typeDecl
     // Probable perf problem here
     | structType
     | interfaceType
+    | dataDecl
+    ;
+
+// `data` is a keyword only in front of the name of a data and the block of
+// its variants, it stays a name elsewhere
+dataDecl
+    : {p.isData()}? IDENTIFIER IDENTIFIER ( '{' | INDENT ) ( variant eos )* ( '}' | DEDENT )
+    ;
+
+// A variant is a name followed by the block of its fields, or a name alone
+// read as a field. Any other field is parsed to be reported
+variant
+    : IDENTIFIER ( '{' | INDENT ) ( fieldDecl eos )* ( '}' | DEDENT )
+    | fieldDecl
     ;
 
 //TypeSpec     = identifier Type .
@@ -315,7 +348,7 @@ varSpec
 
 //Block = "{" StatementList "}" .
 block
-    : '{' statementList '}'
+    : ( '{' | INDENT ) statementList ( '}' | DEDENT )
     // | statementNoBlock
     ;
 
@@ -373,10 +406,9 @@ assign_op
     : ('+' | '-' | '|' | '^' | '*' | '/' | '%' | '<<' | '>>' | '&' | '&^')? '='
     ;
 
-//ShortVarDecl = IdentifierList ":=" ExpressionList .
+//ShortVarDecl = IdentifierList ":=" ( ExpressionList | IfStmt | SwitchStmt | SelectStmt | ForStmt ) .
 shortVarDecl
-    // : identifierList ':=' ( expressionList | statement )
-    : identifierList ':=' expressionList
+    : identifierList ':=' ( expressionList | ifStmt | switchStmt | selectStmt | forStmt )
     ;
 
 emptyStmt
@@ -427,14 +459,14 @@ ifStmt
 
 //SwitchStmt = ExprSwitchStmt | TypeSwitchStmt .
 switchStmt
-    : exprSwitchStmt | typeSwitchStmt
+    : exprSwitchStmt | typeSwitchStmt | matchStmt
     ;
 
 //ExprSwitchStmt = "switch" [ SimpleStmt ";" ] [ Expression ] "{" { ExprCaseClause } "}" .
 //ExprCaseClause = ExprSwitchCase ":" StatementList .
 //ExprSwitchCase = "case" ExpressionList | "default" .
 exprSwitchStmt
-    : 'switch' ( simpleStmt ';' )? expression? '{' exprCaseClause* '}'
+    : 'switch' ( simpleStmt ';' )? expression? ( '{' | INDENT ) exprCaseClause* ( '}' | DEDENT )
     ;
 
 exprCaseClause
@@ -445,13 +477,28 @@ exprSwitchCase
     : expressionList | '_'
     ;
 
+//MatchStmt = "match" Expression "{" { MatchArm } "}" .
+//MatchArm  = Pattern { "," Pattern } [ "if" Expression ] "=>" StatementList .
+//Pattern   = ( "_" | Expression ) [ "as" identifier ] .
+matchStmt
+    : 'match' expression ( '{' | INDENT ) matchArm* ( '}' | DEDENT )
+    ;
+
+matchArm
+    : pattern ( ',' pattern )* ( 'if' expression )? '=>' statementList
+    ;
+
+pattern
+    : ( '_' | expression ) ( 'as' IDENTIFIER )?
+    ;
+
 //TypeSwitchStmt  = "switch" [ SimpleStmt ";" ] TypeSwitchGuard "{" { TypeCaseClause } "}" .
 //TypeSwitchGuard = [ identifier ":=" ] PrimaryExpr "." "(" "type_" ")" .
 //TypeCaseClause  = TypeSwitchCase ":" StatementList .
 //TypeSwitchCase  = "case" TypeList | "default" .
 //TypeList        = Type { "," Type } .
 typeSwitchStmt
-    : 'switch' ( simpleStmt ';' )? typeSwitchGuard '{' typeCaseClause* '}'
+    : 'switch' ( simpleStmt ';' )? typeSwitchGuard ( '{' | INDENT ) typeCaseClause* ( '}' | DEDENT )
     ;
 typeSwitchGuard
     : ( IDENTIFIER ':=' )? primaryExpr '.' '(' 'type' ')'
@@ -472,7 +519,7 @@ typeList
 //RecvStmt   = [ ExpressionList "=" | IdentifierList ":=" ] RecvExpr .
 //RecvExpr   = Expression .
 selectStmt
-    : 'select' '{' commClause* '}'
+    : 'select' ( '{' | INDENT ) commClause* ( '}' | DEDENT )
     ;
 commClause
     : commCase '=>' ( block | statement )
@@ -558,7 +605,7 @@ pointerType
 //MethodName         = identifier .
 //InterfaceTypeName  = TypeName .
 interfaceType
-    : 'interface' IDENTIFIER? ('{' ( methodSpec eos )* '}')?
+    : 'interface' IDENTIFIER? (( '{' | INDENT ) ( methodSpec eos )* ( '}' | DEDENT ))?
     ;
 
 //SliceType = "[" "]" ElementType .
@@ -602,8 +649,24 @@ signature
     | templateSpec? parameters
     ;
 
+//TemplateSpec   = "<" TemplateParam { "," TemplateParam } ">" .
+//TemplateParam  = Type [ ":" Constraint ] .
+//Constraint     = ConstraintTerm { "|" ConstraintTerm } .
+//ConstraintTerm = [ "~" ] Type .
 templateSpec
-    : '<' result '>'
+    : '<' templateParam ( ',' templateParam )* '>'
+    ;
+
+templateParam
+    : type_ ( ':' constraint )?
+    ;
+
+constraint
+    : constraintTerm ( '|' constraintTerm )*
+    ;
+
+constraintTerm
+    : '~'? type_
     ;
 
 result
@@ -697,7 +760,7 @@ literalType
     ;
 
 literalValue
-    : '{' ( elementList ','? )? '}'
+    : ( '{' | INDENT ) ( elementList ','? )? ( '}' | DEDENT )
     ;
 
 elementList
@@ -724,7 +787,7 @@ element
 //AnonymousField = [ "*" ] TypeName .
 //Tag            = string_lit .
 structType
-    : ('struct' | 'class') IDENTIFIER? templateSpec? ('{' ( fieldDecl eos )* '}')?
+    : ('struct' | 'class') IDENTIFIER? templateSpec? (( '{' | INDENT ) ( fieldDecl eos )* ( '}' | DEDENT ))?
     ;
 
 fieldDecl
@@ -774,6 +837,7 @@ secondaryExpr
     | slice
     | typeAssertion
     | arguments
+    | QUESTION
     ;
 
 selector
@@ -811,8 +875,9 @@ receiverType
 //Expression = UnaryExpr | Expression binary_op Expression .
 //UnaryExpr  = PrimaryExpr | unary_op UnaryExpr .
 
+// An operator that starts a line starts a new statement
 expression
-    : expression ('||' | '&&' | '==' | '!=' | '<' | '<=' | '>' | '>=' | '+' | '-' | '|' | '^' | '*' | '/' | '%' | '<<' | '>>' | '&' | '&^') expression
+    : expression {p.noTerminatorBetween(1)}? ('||' | '&&' | '==' | '!=' | '<' | '<=' | '>' | '>=' | '+' | '-' | '|' | '^' | '*' | '/' | '%' | '<<' | '>>' | '&' | '&^') expression
     | unaryExpr
     ;
 
@@ -843,6 +908,17 @@ eos
 // LEXER
 
 
+// Punctuation and keywords, named for the lexer and the translator.
+// They come before the identifiers, that would take `_` and the keywords
+SEMI      : ';' ;
+COLON     : ':' ;
+BLANK     : '_' ;
+ARROW     : '=>' ;
+QUESTION  : '?' ;
+IF        : 'if' ;
+FOR       : 'for' ;
+STRUCT    : 'struct' ;
+
 // Identifiers
 //identifier = letter { letter | unicode_digit } .
 IDENTIFIER
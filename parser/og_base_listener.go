@@ -85,6 +85,18 @@ func (s *BaseOgListener) EnterConstSpec(ctx *ConstSpecContext) {}
 // ExitConstSpec is called when production constSpec is exited.
 func (s *BaseOgListener) ExitConstSpec(ctx *ConstSpecContext) {}
 
+// EnterEnumDecl is called when production enumDecl is entered.
+func (s *BaseOgListener) EnterEnumDecl(ctx *EnumDeclContext) {}
+
+// ExitEnumDecl is called when production enumDecl is exited.
+func (s *BaseOgListener) ExitEnumDecl(ctx *EnumDeclContext) {}
+
+// EnterEnumSpec is called when production enumSpec is entered.
+func (s *BaseOgListener) EnterEnumSpec(ctx *EnumSpecContext) {}
+
+// ExitEnumSpec is called when production enumSpec is exited.
+func (s *BaseOgListener) ExitEnumSpec(ctx *EnumSpecContext) {}
+
 // EnterIdentifierList is called when production identifierList is entered.
 func (s *BaseOgListener) EnterIdentifierList(ctx *IdentifierListContext) {}
 
@@ -103,6 +115,18 @@ func (s *BaseOgListener) EnterTypeDecl(ctx *TypeDeclContext) {}
 // ExitTypeDecl is called when production typeDecl is exited.
 func (s *BaseOgListener) ExitTypeDecl(ctx *TypeDeclContext) {}
 
+// EnterDataDecl is called when production dataDecl is entered.
+func (s *BaseOgListener) EnterDataDecl(ctx *DataDeclContext) {}
+
+// ExitDataDecl is called when production dataDecl is exited.
+func (s *BaseOgListener) ExitDataDecl(ctx *DataDeclContext) {}
+
+// EnterVariant is called when production variant is entered.
+func (s *BaseOgListener) EnterVariant(ctx *VariantContext) {}
+
+// ExitVariant is called when production variant is exited.
+func (s *BaseOgListener) ExitVariant(ctx *VariantContext) {}
+
 // EnterTypeSpec is called when production typeSpec is entered.
 func (s *BaseOgListener) EnterTypeSpec(ctx *TypeSpecContext) {}
 
@@ -277,6 +301,24 @@ func (s *BaseOgListener) EnterExprSwitchCase(ctx *ExprSwitchCaseContext) {}
 // ExitExprSwitchCase is called when production exprSwitchCase is exited.
 func (s *BaseOgListener) ExitExprSwitchCase(ctx *ExprSwitchCaseContext) {}
 
+// EnterMatchStmt is called when production matchStmt is entered.
+func (s *BaseOgListener) EnterMatchStmt(ctx *MatchStmtContext) {}
+
+// ExitMatchStmt is called when production matchStmt is exited.
+func (s *BaseOgListener) ExitMatchStmt(ctx *MatchStmtContext) {}
+
+// EnterMatchArm is called when production matchArm is entered.
+func (s *BaseOgListener) EnterMatchArm(ctx *MatchArmContext) {}
+
+// ExitMatchArm is called when production matchArm is exited.
+func (s *BaseOgListener) ExitMatchArm(ctx *MatchArmContext) {}
+
+// EnterPattern is called when production pattern is entered.
+func (s *BaseOgListener) EnterPattern(ctx *PatternContext) {}
+
+// ExitPattern is called when production pattern is exited.
+func (s *BaseOgListener) ExitPattern(ctx *PatternContext) {}
+
 // EnterTypeSwitchStmt is called when production typeSwitchStmt is entered.
 func (s *BaseOgListener) EnterTypeSwitchStmt(ctx *TypeSwitchStmtContext) {}
 
@@ -451,6 +493,24 @@ func (s *BaseOgListener) EnterTemplateSpec(ctx *TemplateSpecContext) {}
 // ExitTemplateSpec is called when production templateSpec is exited.
 func (s *BaseOgListener) ExitTemplateSpec(ctx *TemplateSpecContext) {}
 
+// EnterTemplateParam is called when production templateParam is entered.
+func (s *BaseOgListener) EnterTemplateParam(ctx *TemplateParamContext) {}
+
+// ExitTemplateParam is called when production templateParam is exited.
+func (s *BaseOgListener) ExitTemplateParam(ctx *TemplateParamContext) {}
+
+// EnterConstraint is called when production constraint is entered.
+func (s *BaseOgListener) EnterConstraint(ctx *ConstraintContext) {}
+
+// ExitConstraint is called when production constraint is exited.
+func (s *BaseOgListener) ExitConstraint(ctx *ConstraintContext) {}
+
+// EnterConstraintTerm is called when production constraintTerm is entered.
+func (s *BaseOgListener) EnterConstraintTerm(ctx *ConstraintTermContext) {}
+
+// ExitConstraintTerm is called when production constraintTerm is exited.
+func (s *BaseOgListener) ExitConstraintTerm(ctx *ConstraintTermContext) {}
+
 // EnterResult is called when production result is entered.
 func (s *BaseOgListener) EnterResult(ctx *ResultContext) {}
 
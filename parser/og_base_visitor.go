@@ -105,6 +105,20 @@ package parser // Og
 //  return r
 //}
 
+//func (v *OgVisitor) VisitEnumDecl(ctx *parser.EnumDeclContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
+//func (v *OgVisitor) VisitEnumSpec(ctx *parser.EnumSpecContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
 //func (v *OgVisitor) VisitIdentifierList(ctx *parser.IdentifierListContext, delegate antlr.ParseTreeVisitor) interface{} {
 //  // before children
 //  r := v.VisitChildren(ctx, delegate)
@@ -126,6 +140,20 @@ package parser // Og
 //  return r
 //}
 
+//func (v *OgVisitor) VisitDataDecl(ctx *parser.DataDeclContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
+//func (v *OgVisitor) VisitVariant(ctx *parser.VariantContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
 //func (v *OgVisitor) VisitTypeSpec(ctx *parser.TypeSpecContext, delegate antlr.ParseTreeVisitor) interface{} {
 //  // before children
 //  r := v.VisitChildren(ctx, delegate)
@@ -329,6 +357,27 @@ package parser // Og
 //  return r
 //}
 
+//func (v *OgVisitor) VisitMatchStmt(ctx *parser.MatchStmtContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
+//func (v *OgVisitor) VisitMatchArm(ctx *parser.MatchArmContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
+//func (v *OgVisitor) VisitPattern(ctx *parser.PatternContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
 //func (v *OgVisitor) VisitTypeSwitchStmt(ctx *parser.TypeSwitchStmtContext, delegate antlr.ParseTreeVisitor) interface{} {
 //  // before children
 //  r := v.VisitChildren(ctx, delegate)
@@ -532,6 +581,27 @@ package parser // Og
 //  return r
 //}
 
+//func (v *OgVisitor) VisitTemplateParam(ctx *parser.TemplateParamContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
+//func (v *OgVisitor) VisitConstraint(ctx *parser.ConstraintContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
+//func (v *OgVisitor) VisitConstraintTerm(ctx *parser.ConstraintTermContext, delegate antlr.ParseTreeVisitor) interface{} {
+//  // before children
+//  r := v.VisitChildren(ctx, delegate)
+//  // afer children
+//  return r
+//}
+
 //func (v *OgVisitor) VisitResult(ctx *parser.ResultContext, delegate antlr.ParseTreeVisitor) interface{} {
 //  // before children
 //  r := v.VisitChildren(ctx, delegate)
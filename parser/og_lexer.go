@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 86, 867,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 90, 891,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -35,426 +35,437 @@ var serializedLexerAtn = []uint16{
 	91, 4, 92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96,
 	4, 97, 9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101,
 	4, 102, 9, 102, 4, 103, 9, 103, 4, 104, 9, 104, 4, 105, 9, 105, 4, 106,
-	9, 106, 4, 107, 9, 107, 4, 108, 9, 108, 4, 109, 9, 109, 3, 2, 3, 2, 3,
-	2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3,
-	4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3,
-	9, 3, 10, 3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 12, 3, 12,
-	3, 13, 3, 13, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3,
-	16, 3, 16, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19,
-	3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23, 3, 23, 3,
-	24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28,
-	3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3,
-	32, 3, 33, 3, 33, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
-	3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3,
-	37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38,
-	3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3,
-	39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41,
-	3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3,
-	44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 45,
-	3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3,
-	48, 3, 49, 3, 49, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51, 3, 51,
-	3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 53, 3, 53, 3,
-	53, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 56, 3, 56, 3, 57,
-	3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58, 3, 58, 3, 58, 3, 59, 3, 59, 3,
-	59, 3, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 62,
-	3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 3,
-	63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 66, 3, 66, 3, 66,
-	3, 67, 3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 70, 3,
-	70, 5, 70, 478, 10, 70, 3, 70, 3, 70, 5, 70, 482, 10, 70, 3, 70, 7, 70,
-	485, 10, 70, 12, 70, 14, 70, 488, 11, 70, 3, 71, 3, 71, 3, 71, 3, 71, 3,
-	71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3,
-	71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3,
-	71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3,
-	71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3,
-	71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3,
-	71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71,
-	3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3, 71, 3,
-	71, 3, 71, 3, 71, 3, 71, 5, 71, 624, 10, 71, 3, 72, 3, 72, 3, 72, 3, 72,
-	3, 72, 3, 72, 3, 72, 5, 72, 633, 10, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3,
-	73, 3, 73, 3, 73, 3, 73, 3, 73, 3, 73, 5, 73, 645, 10, 73, 3, 74, 3, 74,
-	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 5, 75, 657, 10,
-	75, 3, 76, 3, 76, 3, 76, 5, 76, 662, 10, 76, 3, 77, 3, 77, 3, 77, 3, 78,
-	3, 78, 3, 78, 5, 78, 670, 10, 78, 3, 79, 3, 79, 7, 79, 674, 10, 79, 12,
-	79, 14, 79, 677, 11, 79, 3, 80, 3, 80, 7, 80, 681, 10, 80, 12, 80, 14,
-	80, 684, 11, 80, 3, 81, 3, 81, 3, 81, 6, 81, 689, 10, 81, 13, 81, 14, 81,
-	690, 3, 82, 3, 82, 3, 82, 5, 82, 696, 10, 82, 3, 82, 5, 82, 699, 10, 82,
-	3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 5, 82, 707, 10, 82, 5, 82, 709,
-	10, 82, 3, 83, 6, 83, 712, 10, 83, 13, 83, 14, 83, 713, 3, 84, 3, 84, 5,
-	84, 718, 10, 84, 3, 84, 3, 84, 3, 85, 3, 85, 5, 85, 724, 10, 85, 3, 85,
-	3, 85, 3, 86, 3, 86, 3, 86, 5, 86, 731, 10, 86, 3, 86, 3, 86, 3, 87, 3,
-	87, 3, 87, 3, 87, 5, 87, 739, 10, 87, 3, 88, 3, 88, 5, 88, 743, 10, 88,
-	3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 3, 90, 3, 90, 3,
-	91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91, 3, 92, 3, 92, 3, 92,
-	3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 92, 3, 93, 3,
-	93, 3, 93, 3, 94, 3, 94, 5, 94, 780, 10, 94, 3, 95, 3, 95, 3, 95, 3, 95,
-	7, 95, 786, 10, 95, 12, 95, 14, 95, 789, 11, 95, 3, 95, 3, 95, 3, 96, 3,
-	96, 3, 96, 3, 96, 3, 96, 7, 96, 798, 10, 96, 12, 96, 14, 96, 801, 11, 96,
-	3, 96, 3, 96, 3, 97, 3, 97, 5, 97, 807, 10, 97, 3, 98, 3, 98, 3, 99, 3,
-	99, 3, 100, 3, 100, 3, 101, 3, 101, 3, 102, 3, 102, 3, 103, 5, 103, 820,
-	10, 103, 3, 104, 5, 104, 823, 10, 104, 3, 105, 6, 105, 826, 10, 105, 13,
-	105, 14, 105, 827, 3, 105, 3, 105, 3, 106, 3, 106, 3, 106, 3, 106, 7, 106,
-	836, 10, 106, 12, 106, 14, 106, 839, 11, 106, 3, 106, 3, 106, 3, 106, 3,
-	106, 3, 106, 3, 107, 3, 107, 3, 107, 5, 107, 849, 10, 107, 3, 107, 7, 107,
-	852, 10, 107, 12, 107, 14, 107, 855, 11, 107, 3, 107, 3, 107, 3, 108, 6,
-	108, 860, 10, 108, 13, 108, 14, 108, 861, 3, 108, 3, 108, 3, 109, 3, 109,
-	5, 787, 799, 837, 2, 110, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9,
-	17, 10, 19, 11, 21, 12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18,
-	35, 19, 37, 20, 39, 21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27,
-	53, 28, 55, 29, 57, 30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36,
-	71, 37, 73, 38, 75, 39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45,
-	89, 46, 91, 47, 93, 48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105,
-	54, 107, 55, 109, 56, 111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121,
-	62, 123, 63, 125, 64, 127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137,
-	70, 139, 71, 141, 72, 143, 73, 145, 2, 147, 2, 149, 2, 151, 2, 153, 74,
-	155, 75, 157, 2, 159, 2, 161, 2, 163, 76, 165, 2, 167, 2, 169, 77, 171,
-	78, 173, 2, 175, 2, 177, 2, 179, 2, 181, 79, 183, 80, 185, 2, 187, 81,
-	189, 2, 191, 2, 193, 2, 195, 2, 197, 2, 199, 2, 201, 2, 203, 2, 205, 2,
-	207, 2, 209, 82, 211, 83, 213, 84, 215, 85, 217, 86, 3, 2, 19, 6, 2, 45,
-	45, 47, 47, 96, 96, 126, 126, 5, 2, 39, 39, 44, 44, 49, 49, 7, 2, 35, 35,
-	40, 40, 44, 45, 47, 47, 96, 96, 3, 2, 51, 59, 4, 2, 90, 90, 122, 122, 4,
-	2, 71, 71, 103, 103, 4, 2, 45, 45, 47, 47, 11, 2, 36, 36, 41, 41, 94, 94,
-	99, 100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120, 4, 2, 98, 98,
-	128, 128, 3, 2, 50, 59, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72, 99, 104, 3,
-	2, 12, 12, 22, 2, 50, 59, 1634, 1643, 1778, 1787, 2408, 2417, 2536, 2545,
-	2664, 2673, 2792, 2801, 2920, 2929, 3049, 3057, 3176, 3185, 3304, 3313,
-	3432, 3441, 3666, 3675, 3794, 3803, 3874, 3883, 4162, 4171, 4971, 4979,
-	6114, 6123, 6162, 6171, 65298, 65307, 260, 2, 67, 92, 99, 124, 172, 172,
-	183, 183, 188, 188, 194, 216, 218, 248, 250, 545, 548, 565, 594, 687, 690,
-	698, 701, 707, 722, 723, 738, 742, 752, 752, 892, 892, 904, 904, 906, 908,
-	910, 910, 912, 931, 933, 976, 978, 985, 988, 1013, 1026, 1155, 1166, 1222,
-	1225, 1226, 1229, 1230, 1234, 1271, 1274, 1275, 1331, 1368, 1371, 1371,
-	1379, 1417, 1490, 1516, 1522, 1524, 1571, 1596, 1602, 1612, 1651, 1749,
-	1751, 1751, 1767, 1768, 1788, 1790, 1810, 1810, 1812, 1838, 1922, 1959,
-	2311, 2363, 2367, 2367, 2386, 2386, 2394, 2403, 2439, 2446, 2449, 2450,
-	2453, 2474, 2476, 2482, 2484, 2484, 2488, 2491, 2526, 2527, 2529, 2531,
-	2546, 2547, 2567, 2572, 2577, 2578, 2581, 2602, 2604, 2610, 2612, 2613,
-	2615, 2616, 2618, 2619, 2651, 2654, 2656, 2656, 2676, 2678, 2695, 2701,
-	2703, 2703, 2705, 2707, 2709, 2730, 2732, 2738, 2740, 2741, 2743, 2747,
-	2751, 2751, 2770, 2770, 2786, 2786, 2823, 2830, 2833, 2834, 2837, 2858,
-	2860, 2866, 2868, 2869, 2872, 2875, 2879, 2879, 2910, 2911, 2913, 2915,
-	2951, 2956, 2960, 2962, 2964, 2967, 2971, 2972, 2974, 2974, 2976, 2977,
-	2981, 2982, 2986, 2988, 2992, 2999, 3001, 3003, 3079, 3086, 3088, 3090,
-	3092, 3114, 3116, 3125, 3127, 3131, 3170, 3171, 3207, 3214, 3216, 3218,
-	3220, 3242, 3244, 3253, 3255, 3259, 3296, 3296, 3298, 3299, 3335, 3342,
-	3344, 3346, 3348, 3370, 3372, 3387, 3426, 3427, 3463, 3480, 3484, 3507,
-	3509, 3517, 3519, 3519, 3522, 3528, 3587, 3634, 3636, 3637, 3650, 3656,
-	3715, 3716, 3718, 3718, 3721, 3722, 3724, 3724, 3727, 3727, 3734, 3737,
-	3739, 3745, 3747, 3749, 3751, 3751, 3753, 3753, 3756, 3757, 3759, 3762,
-	3764, 3765, 3775, 3782, 3784, 3784, 3806, 3807, 3842, 3842, 3906, 3948,
-	3978, 3981, 4098, 4131, 4133, 4137, 4139, 4140, 4178, 4183, 4258, 4295,
-	4306, 4344, 4354, 4443, 4449, 4516, 4522, 4603, 4610, 4616, 4618, 4680,
-	4682, 4682, 4684, 4687, 4690, 4696, 4698, 4698, 4700, 4703, 4706, 4744,
-	4746, 4746, 4748, 4751, 4754, 4784, 4786, 4786, 4788, 4791, 4794, 4800,
-	4802, 4802, 4804, 4807, 4810, 4816, 4818, 4824, 4826, 4848, 4850, 4880,
-	4882, 4882, 4884, 4887, 4890, 4896, 4898, 4936, 4938, 4956, 5026, 5110,
-	5123, 5752, 5763, 5788, 5794, 5868, 6018, 6069, 6178, 6265, 6274, 6314,
-	7682, 7837, 7842, 7931, 7938, 7959, 7962, 7967, 7970, 8007, 8010, 8015,
-	8018, 8025, 8027, 8027, 8029, 8029, 8031, 8031, 8033, 8063, 8066, 8118,
-	8120, 8126, 8128, 8128, 8132, 8134, 8136, 8142, 8146, 8149, 8152, 8157,
-	8162, 8174, 8180, 8182, 8184, 8190, 8321, 8321, 8452, 8452, 8457, 8457,
-	8460, 8469, 8471, 8471, 8475, 8479, 8486, 8486, 8488, 8488, 8490, 8490,
-	8492, 8495, 8497, 8499, 8501, 8507, 8546, 8581, 12295, 12297, 12323, 12331,
-	12339, 12343, 12346, 12348, 12355, 12438, 12447, 12448, 12451, 12540, 12542,
-	12544, 12551, 12590, 12595, 12688, 12706, 12729, 13314, 13314, 19895, 19895,
-	19970, 19970, 40871, 40871, 40962, 42126, 44034, 44034, 55205, 55205, 63746,
-	64047, 64258, 64264, 64277, 64281, 64287, 64287, 64289, 64298, 64300, 64312,
-	64314, 64318, 64320, 64320, 64322, 64323, 64325, 64326, 64328, 64435, 64469,
-	64831, 64850, 64913, 64916, 64969, 65010, 65021, 65138, 65140, 65142, 65142,
-	65144, 65278, 65315, 65340, 65347, 65372, 65384, 65472, 65476, 65481, 65484,
-	65489, 65492, 65497, 65500, 65502, 4, 2, 11, 11, 34, 34, 4, 2, 12, 12,
-	15, 15, 2, 916, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2,
-	9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2,
-	2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2,
-	2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2,
-	2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3,
-	2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47,
-	3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2,
-	55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2,
-	2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2,
-	2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2,
-	2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3,
-	2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93,
-	3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2,
-	101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 107, 3, 2,
-	2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3, 2, 2, 2, 2, 115,
-	3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2, 121, 3, 2, 2, 2,
-	2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3, 2, 2, 2, 2, 129, 3,
-	2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135, 3, 2, 2, 2, 2,
-	137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2, 2, 143, 3, 2,
-	2, 2, 2, 153, 3, 2, 2, 2, 2, 155, 3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2, 169,
-	3, 2, 2, 2, 2, 171, 3, 2, 2, 2, 2, 181, 3, 2, 2, 2, 2, 183, 3, 2, 2, 2,
-	2, 187, 3, 2, 2, 2, 2, 209, 3, 2, 2, 2, 2, 211, 3, 2, 2, 2, 2, 213, 3,
-	2, 2, 2, 2, 215, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 3, 219, 3, 2, 2, 2, 5,
-	227, 3, 2, 2, 2, 7, 229, 3, 2, 2, 2, 9, 236, 3, 2, 2, 2, 11, 238, 3, 2,
-	2, 2, 13, 240, 3, 2, 2, 2, 15, 242, 3, 2, 2, 2, 17, 244, 3, 2, 2, 2, 19,
-	246, 3, 2, 2, 2, 21, 248, 3, 2, 2, 2, 23, 254, 3, 2, 2, 2, 25, 256, 3,
-	2, 2, 2, 27, 258, 3, 2, 2, 2, 29, 260, 3, 2, 2, 2, 31, 265, 3, 2, 2, 2,
-	33, 268, 3, 2, 2, 2, 35, 270, 3, 2, 2, 2, 37, 274, 3, 2, 2, 2, 39, 277,
-	3, 2, 2, 2, 41, 280, 3, 2, 2, 2, 43, 283, 3, 2, 2, 2, 45, 285, 3, 2, 2,
-	2, 47, 287, 3, 2, 2, 2, 49, 289, 3, 2, 2, 2, 51, 291, 3, 2, 2, 2, 53, 293,
-	3, 2, 2, 2, 55, 295, 3, 2, 2, 2, 57, 298, 3, 2, 2, 2, 59, 301, 3, 2, 2,
-	2, 61, 303, 3, 2, 2, 2, 63, 306, 3, 2, 2, 2, 65, 309, 3, 2, 2, 2, 67, 311,
-	3, 2, 2, 2, 69, 313, 3, 2, 2, 2, 71, 320, 3, 2, 2, 2, 73, 326, 3, 2, 2,
-	2, 75, 335, 3, 2, 2, 2, 77, 340, 3, 2, 2, 2, 79, 352, 3, 2, 2, 2, 81, 358,
-	3, 2, 2, 2, 83, 361, 3, 2, 2, 2, 85, 364, 3, 2, 2, 2, 87, 369, 3, 2, 2,
-	2, 89, 376, 3, 2, 2, 2, 91, 383, 3, 2, 2, 2, 93, 387, 3, 2, 2, 2, 95, 390,
-	3, 2, 2, 2, 97, 393, 3, 2, 2, 2, 99, 395, 3, 2, 2, 2, 101, 397, 3, 2, 2,
-	2, 103, 407, 3, 2, 2, 2, 105, 411, 3, 2, 2, 2, 107, 416, 3, 2, 2, 2, 109,
-	419, 3, 2, 2, 2, 111, 421, 3, 2, 2, 2, 113, 423, 3, 2, 2, 2, 115, 427,
-	3, 2, 2, 2, 117, 432, 3, 2, 2, 2, 119, 438, 3, 2, 2, 2, 121, 442, 3, 2,
-	2, 2, 123, 444, 3, 2, 2, 2, 125, 451, 3, 2, 2, 2, 127, 457, 3, 2, 2, 2,
-	129, 460, 3, 2, 2, 2, 131, 463, 3, 2, 2, 2, 133, 466, 3, 2, 2, 2, 135,
-	469, 3, 2, 2, 2, 137, 472, 3, 2, 2, 2, 139, 477, 3, 2, 2, 2, 141, 623,
-	3, 2, 2, 2, 143, 632, 3, 2, 2, 2, 145, 644, 3, 2, 2, 2, 147, 646, 3, 2,
-	2, 2, 149, 656, 3, 2, 2, 2, 151, 661, 3, 2, 2, 2, 153, 663, 3, 2, 2, 2,
-	155, 669, 3, 2, 2, 2, 157, 671, 3, 2, 2, 2, 159, 678, 3, 2, 2, 2, 161,
-	685, 3, 2, 2, 2, 163, 708, 3, 2, 2, 2, 165, 711, 3, 2, 2, 2, 167, 715,
-	3, 2, 2, 2, 169, 723, 3, 2, 2, 2, 171, 727, 3, 2, 2, 2, 173, 738, 3, 2,
-	2, 2, 175, 742, 3, 2, 2, 2, 177, 744, 3, 2, 2, 2, 179, 749, 3, 2, 2, 2,
-	181, 754, 3, 2, 2, 2, 183, 762, 3, 2, 2, 2, 185, 774, 3, 2, 2, 2, 187,
-	779, 3, 2, 2, 2, 189, 781, 3, 2, 2, 2, 191, 792, 3, 2, 2, 2, 193, 806,
-	3, 2, 2, 2, 195, 808, 3, 2, 2, 2, 197, 810, 3, 2, 2, 2, 199, 812, 3, 2,
-	2, 2, 201, 814, 3, 2, 2, 2, 203, 816, 3, 2, 2, 2, 205, 819, 3, 2, 2, 2,
-	207, 822, 3, 2, 2, 2, 209, 825, 3, 2, 2, 2, 211, 831, 3, 2, 2, 2, 213,
-	848, 3, 2, 2, 2, 215, 859, 3, 2, 2, 2, 217, 865, 3, 2, 2, 2, 219, 220,
-	7, 114, 2, 2, 220, 221, 7, 99, 2, 2, 221, 222, 7, 101, 2, 2, 222, 223,
-	7, 109, 2, 2, 223, 224, 7, 99, 2, 2, 224, 225, 7, 105, 2, 2, 225, 226,
-	7, 103, 2, 2, 226, 4, 3, 2, 2, 2, 227, 228, 7, 35, 2, 2, 228, 6, 3, 2,
-	2, 2, 229, 230, 7, 107, 2, 2, 230, 231, 7, 111, 2, 2, 231, 232, 7, 114,
-	2, 2, 232, 233, 7, 113, 2, 2, 233, 234, 7, 116, 2, 2, 234, 235, 7, 118,
-	2, 2, 235, 8, 3, 2, 2, 2, 236, 237, 7, 42, 2, 2, 237, 10, 3, 2, 2, 2, 238,
-	239, 7, 43, 2, 2, 239, 12, 3, 2, 2, 2, 240, 241, 7, 125, 2, 2, 241, 14,
-	3, 2, 2, 2, 242, 243, 7, 127, 2, 2, 243, 16, 3, 2, 2, 2, 244, 245, 7, 60,
-	2, 2, 245, 18, 3, 2, 2, 2, 246, 247, 7, 48, 2, 2, 247, 20, 3, 2, 2, 2,
-	248, 249, 7, 101, 2, 2, 249, 250, 7, 113, 2, 2, 250, 251, 7, 112, 2, 2,
-	251, 252, 7, 117, 2, 2, 252, 253, 7, 118, 2, 2, 253, 22, 3, 2, 2, 2, 254,
-	255, 7, 63, 2, 2, 255, 24, 3, 2, 2, 2, 256, 257, 7, 97, 2, 2, 257, 26,
-	3, 2, 2, 2, 258, 259, 7, 46, 2, 2, 259, 28, 3, 2, 2, 2, 260, 261, 7, 118,
-	2, 2, 261, 262, 7, 123, 2, 2, 262, 263, 7, 114, 2, 2, 263, 264, 7, 103,
-	2, 2, 264, 30, 3, 2, 2, 2, 265, 266, 7, 60, 2, 2, 266, 267, 7, 60, 2, 2,
-	267, 32, 3, 2, 2, 2, 268, 269, 7, 44, 2, 2, 269, 34, 3, 2, 2, 2, 270, 271,
-	7, 120, 2, 2, 271, 272, 7, 99, 2, 2, 272, 273, 7, 116, 2, 2, 273, 36, 3,
-	2, 2, 2, 274, 275, 7, 62, 2, 2, 275, 276, 7, 47, 2, 2, 276, 38, 3, 2, 2,
-	2, 277, 278, 7, 45, 2, 2, 278, 279, 7, 45, 2, 2, 279, 40, 3, 2, 2, 2, 280,
-	281, 7, 47, 2, 2, 281, 282, 7, 47, 2, 2, 282, 42, 3, 2, 2, 2, 283, 284,
-	7, 45, 2, 2, 284, 44, 3, 2, 2, 2, 285, 286, 7, 47, 2, 2, 286, 46, 3, 2,
-	2, 2, 287, 288, 7, 126, 2, 2, 288, 48, 3, 2, 2, 2, 289, 290, 7, 96, 2,
-	2, 290, 50, 3, 2, 2, 2, 291, 292, 7, 49, 2, 2, 292, 52, 3, 2, 2, 2, 293,
-	294, 7, 39, 2, 2, 294, 54, 3, 2, 2, 2, 295, 296, 7, 62, 2, 2, 296, 297,
-	7, 62, 2, 2, 297, 56, 3, 2, 2, 2, 298, 299, 7, 64, 2, 2, 299, 300, 7, 64,
-	2, 2, 300, 58, 3, 2, 2, 2, 301, 302, 7, 40, 2, 2, 302, 60, 3, 2, 2, 2,
-	303, 304, 7, 40, 2, 2, 304, 305, 7, 96, 2, 2, 305, 62, 3, 2, 2, 2, 306,
-	307, 7, 60, 2, 2, 307, 308, 7, 63, 2, 2, 308, 64, 3, 2, 2, 2, 309, 310,
-	7, 61, 2, 2, 310, 66, 3, 2, 2, 2, 311, 312, 7, 128, 2, 2, 312, 68, 3, 2,
-	2, 2, 313, 314, 7, 116, 2, 2, 314, 315, 7, 103, 2, 2, 315, 316, 7, 118,
-	2, 2, 316, 317, 7, 119, 2, 2, 317, 318, 7, 116, 2, 2, 318, 319, 7, 112,
-	2, 2, 319, 70, 3, 2, 2, 2, 320, 321, 7, 100, 2, 2, 321, 322, 7, 116, 2,
-	2, 322, 323, 7, 103, 2, 2, 323, 324, 7, 99, 2, 2, 324, 325, 7, 109, 2,
-	2, 325, 72, 3, 2, 2, 2, 326, 327, 7, 101, 2, 2, 327, 328, 7, 113, 2, 2,
-	328, 329, 7, 112, 2, 2, 329, 330, 7, 118, 2, 2, 330, 331, 7, 107, 2, 2,
-	331, 332, 7, 112, 2, 2, 332, 333, 7, 119, 2, 2, 333, 334, 7, 103, 2, 2,
-	334, 74, 3, 2, 2, 2, 335, 336, 7, 105, 2, 2, 336, 337, 7, 113, 2, 2, 337,
-	338, 7, 118, 2, 2, 338, 339, 7, 113, 2, 2, 339, 76, 3, 2, 2, 2, 340, 341,
-	7, 104, 2, 2, 341, 342, 7, 99, 2, 2, 342, 343, 7, 110, 2, 2, 343, 344,
-	7, 110, 2, 2, 344, 345, 7, 118, 2, 2, 345, 346, 7, 106, 2, 2, 346, 347,
-	7, 116, 2, 2, 347, 348, 7, 113, 2, 2, 348, 349, 7, 119, 2, 2, 349, 350,
-	7, 105, 2, 2, 350, 351, 7, 106, 2, 2, 351, 78, 3, 2, 2, 2, 352, 353, 7,
-	102, 2, 2, 353, 354, 7, 103, 2, 2, 354, 355, 7, 104, 2, 2, 355, 356, 7,
-	103, 2, 2, 356, 357, 7, 116, 2, 2, 357, 80, 3, 2, 2, 2, 358, 359, 7, 107,
-	2, 2, 359, 360, 7, 104, 2, 2, 360, 82, 3, 2, 2, 2, 361, 362, 7, 63, 2,
-	2, 362, 363, 7, 64, 2, 2, 363, 84, 3, 2, 2, 2, 364, 365, 7, 103, 2, 2,
-	365, 366, 7, 110, 2, 2, 366, 367, 7, 117, 2, 2, 367, 368, 7, 103, 2, 2,
-	368, 86, 3, 2, 2, 2, 369, 370, 7, 117, 2, 2, 370, 371, 7, 121, 2, 2, 371,
-	372, 7, 107, 2, 2, 372, 373, 7, 118, 2, 2, 373, 374, 7, 101, 2, 2, 374,
-	375, 7, 106, 2, 2, 375, 88, 3, 2, 2, 2, 376, 377, 7, 117, 2, 2, 377, 378,
-	7, 103, 2, 2, 378, 379, 7, 110, 2, 2, 379, 380, 7, 103, 2, 2, 380, 381,
-	7, 101, 2, 2, 381, 382, 7, 118, 2, 2, 382, 90, 3, 2, 2, 2, 383, 384, 7,
-	104, 2, 2, 384, 385, 7, 113, 2, 2, 385, 386, 7, 116, 2, 2, 386, 92, 3,
-	2, 2, 2, 387, 388, 7, 107, 2, 2, 388, 389, 7, 112, 2, 2, 389, 94, 3, 2,
-	2, 2, 390, 391, 7, 105, 2, 2, 391, 392, 7, 113, 2, 2, 392, 96, 3, 2, 2,
-	2, 393, 394, 7, 93, 2, 2, 394, 98, 3, 2, 2, 2, 395, 396, 7, 95, 2, 2, 396,
-	100, 3, 2, 2, 2, 397, 398, 7, 107, 2, 2, 398, 399, 7, 112, 2, 2, 399, 400,
-	7, 118, 2, 2, 400, 401, 7, 103, 2, 2, 401, 402, 7, 116, 2, 2, 402, 403,
-	7, 104, 2, 2, 403, 404, 7, 99, 2, 2, 404, 405, 7, 101, 2, 2, 405, 406,
-	7, 103, 2, 2, 406, 102, 3, 2, 2, 2, 407, 408, 7, 111, 2, 2, 408, 409, 7,
-	99, 2, 2, 409, 410, 7, 114, 2, 2, 410, 104, 3, 2, 2, 2, 411, 412, 7, 101,
-	2, 2, 412, 413, 7, 106, 2, 2, 413, 414, 7, 99, 2, 2, 414, 415, 7, 112,
-	2, 2, 415, 106, 3, 2, 2, 2, 416, 417, 7, 104, 2, 2, 417, 418, 7, 112, 2,
-	2, 418, 108, 3, 2, 2, 2, 419, 420, 7, 62, 2, 2, 420, 110, 3, 2, 2, 2, 421,
-	422, 7, 64, 2, 2, 422, 112, 3, 2, 2, 2, 423, 424, 7, 48, 2, 2, 424, 425,
-	7, 48, 2, 2, 425, 426, 7, 48, 2, 2, 426, 114, 3, 2, 2, 2, 427, 428, 7,
-	118, 2, 2, 428, 429, 7, 116, 2, 2, 429, 430, 7, 119, 2, 2, 430, 431, 7,
-	103, 2, 2, 431, 116, 3, 2, 2, 2, 432, 433, 7, 104, 2, 2, 433, 434, 7, 99,
-	2, 2, 434, 435, 7, 110, 2, 2, 435, 436, 7, 117, 2, 2, 436, 437, 7, 103,
-	2, 2, 437, 118, 3, 2, 2, 2, 438, 439, 7, 112, 2, 2, 439, 440, 7, 107, 2,
-	2, 440, 441, 7, 110, 2, 2, 441, 120, 3, 2, 2, 2, 442, 443, 7, 66, 2, 2,
-	443, 122, 3, 2, 2, 2, 444, 445, 7, 117, 2, 2, 445, 446, 7, 118, 2, 2, 446,
-	447, 7, 116, 2, 2, 447, 448, 7, 119, 2, 2, 448, 449, 7, 101, 2, 2, 449,
-	450, 7, 118, 2, 2, 450, 124, 3, 2, 2, 2, 451, 452, 7, 101, 2, 2, 452, 453,
-	7, 110, 2, 2, 453, 454, 7, 99, 2, 2, 454, 455, 7, 117, 2, 2, 455, 456,
-	7, 117, 2, 2, 456, 126, 3, 2, 2, 2, 457, 458, 7, 126, 2, 2, 458, 459, 7,
-	126, 2, 2, 459, 128, 3, 2, 2, 2, 460, 461, 7, 40, 2, 2, 461, 462, 7, 40,
-	2, 2, 462, 130, 3, 2, 2, 2, 463, 464, 7, 63, 2, 2, 464, 465, 7, 63, 2,
-	2, 465, 132, 3, 2, 2, 2, 466, 467, 7, 35, 2, 2, 467, 468, 7, 63, 2, 2,
-	468, 134, 3, 2, 2, 2, 469, 470, 7, 62, 2, 2, 470, 471, 7, 63, 2, 2, 471,
-	136, 3, 2, 2, 2, 472, 473, 7, 64, 2, 2, 473, 474, 7, 63, 2, 2, 474, 138,
-	3, 2, 2, 2, 475, 478, 7, 97, 2, 2, 476, 478, 5, 193, 97, 2, 477, 475, 3,
-	2, 2, 2, 477, 476, 3, 2, 2, 2, 478, 486, 3, 2, 2, 2, 479, 482, 7, 97, 2,
-	2, 480, 482, 5, 193, 97, 2, 481, 479, 3, 2, 2, 2, 481, 480, 3, 2, 2, 2,
-	482, 485, 3, 2, 2, 2, 483, 485, 5, 205, 103, 2, 484, 481, 3, 2, 2, 2, 484,
-	483, 3, 2, 2, 2, 485, 488, 3, 2, 2, 2, 486, 484, 3, 2, 2, 2, 486, 487,
-	3, 2, 2, 2, 487, 140, 3, 2, 2, 2, 488, 486, 3, 2, 2, 2, 489, 490, 7, 100,
-	2, 2, 490, 491, 7, 116, 2, 2, 491, 492, 7, 103, 2, 2, 492, 493, 7, 99,
-	2, 2, 493, 624, 7, 109, 2, 2, 494, 495, 7, 102, 2, 2, 495, 496, 7, 103,
-	2, 2, 496, 497, 7, 104, 2, 2, 497, 498, 7, 99, 2, 2, 498, 499, 7, 119,
-	2, 2, 499, 500, 7, 110, 2, 2, 500, 624, 7, 118, 2, 2, 501, 502, 7, 104,
-	2, 2, 502, 503, 7, 119, 2, 2, 503, 504, 7, 112, 2, 2, 504, 624, 7, 101,
-	2, 2, 505, 506, 7, 107, 2, 2, 506, 507, 7, 112, 2, 2, 507, 508, 7, 118,
-	2, 2, 508, 509, 7, 103, 2, 2, 509, 510, 7, 116, 2, 2, 510, 511, 7, 104,
-	2, 2, 511, 512, 7, 99, 2, 2, 512, 513, 7, 101, 2, 2, 513, 624, 7, 103,
-	2, 2, 514, 515, 7, 117, 2, 2, 515, 516, 7, 103, 2, 2, 516, 517, 7, 110,
-	2, 2, 517, 518, 7, 103, 2, 2, 518, 519, 7, 101, 2, 2, 519, 624, 7, 118,
-	2, 2, 520, 521, 7, 101, 2, 2, 521, 522, 7, 99, 2, 2, 522, 523, 7, 117,
-	2, 2, 523, 624, 7, 103, 2, 2, 524, 525, 7, 102, 2, 2, 525, 526, 7, 103,
-	2, 2, 526, 527, 7, 104, 2, 2, 527, 528, 7, 103, 2, 2, 528, 624, 7, 116,
-	2, 2, 529, 530, 7, 105, 2, 2, 530, 624, 7, 113, 2, 2, 531, 532, 7, 111,
-	2, 2, 532, 533, 7, 99, 2, 2, 533, 624, 7, 114, 2, 2, 534, 535, 7, 117,
-	2, 2, 535, 536, 7, 118, 2, 2, 536, 537, 7, 116, 2, 2, 537, 538, 7, 119,
-	2, 2, 538, 539, 7, 101, 2, 2, 539, 624, 7, 118, 2, 2, 540, 541, 7, 101,
-	2, 2, 541, 542, 7, 106, 2, 2, 542, 543, 7, 99, 2, 2, 543, 624, 7, 112,
-	2, 2, 544, 545, 7, 103, 2, 2, 545, 546, 7, 110, 2, 2, 546, 547, 7, 117,
-	2, 2, 547, 624, 7, 103, 2, 2, 548, 549, 7, 105, 2, 2, 549, 550, 7, 113,
-	2, 2, 550, 551, 7, 118, 2, 2, 551, 624, 7, 113, 2, 2, 552, 553, 7, 114,
-	2, 2, 553, 554, 7, 99, 2, 2, 554, 555, 7, 101, 2, 2, 555, 556, 7, 109,
-	2, 2, 556, 557, 7, 99, 2, 2, 557, 558, 7, 105, 2, 2, 558, 624, 7, 103,
-	2, 2, 559, 560, 7, 117, 2, 2, 560, 561, 7, 121, 2, 2, 561, 562, 7, 107,
-	2, 2, 562, 563, 7, 118, 2, 2, 563, 564, 7, 101, 2, 2, 564, 624, 7, 106,
-	2, 2, 565, 566, 7, 101, 2, 2, 566, 567, 7, 113, 2, 2, 567, 568, 7, 112,
-	2, 2, 568, 569, 7, 117, 2, 2, 569, 624, 7, 118, 2, 2, 570, 571, 7, 104,
-	2, 2, 571, 572, 7, 99, 2, 2, 572, 573, 7, 110, 2, 2, 573, 574, 7, 110,
-	2, 2, 574, 575, 7, 118, 2, 2, 575, 576, 7, 106, 2, 2, 576, 577, 7, 116,
-	2, 2, 577, 578, 7, 113, 2, 2, 578, 579, 7, 119, 2, 2, 579, 580, 7, 105,
-	2, 2, 580, 624, 7, 106, 2, 2, 581, 582, 7, 107, 2, 2, 582, 624, 7, 104,
-	2, 2, 583, 584, 7, 116, 2, 2, 584, 585, 7, 99, 2, 2, 585, 586, 7, 112,
-	2, 2, 586, 587, 7, 105, 2, 2, 587, 624, 7, 103, 2, 2, 588, 589, 7, 118,
-	2, 2, 589, 590, 7, 123, 2, 2, 590, 591, 7, 114, 2, 2, 591, 624, 7, 103,
-	2, 2, 592, 593, 7, 101, 2, 2, 593, 594, 7, 113, 2, 2, 594, 595, 7, 112,
-	2, 2, 595, 596, 7, 118, 2, 2, 596, 597, 7, 107, 2, 2, 597, 598, 7, 112,
-	2, 2, 598, 599, 7, 119, 2, 2, 599, 624, 7, 103, 2, 2, 600, 601, 7, 104,
-	2, 2, 601, 602, 7, 113, 2, 2, 602, 624, 7, 116, 2, 2, 603, 604, 7, 107,
-	2, 2, 604, 605, 7, 111, 2, 2, 605, 606, 7, 114, 2, 2, 606, 607, 7, 113,
-	2, 2, 607, 608, 7, 116, 2, 2, 608, 624, 7, 118, 2, 2, 609, 610, 7, 116,
-	2, 2, 610, 611, 7, 103, 2, 2, 611, 612, 7, 118, 2, 2, 612, 613, 7, 119,
-	2, 2, 613, 614, 7, 116, 2, 2, 614, 624, 7, 112, 2, 2, 615, 616, 7, 120,
-	2, 2, 616, 617, 7, 99, 2, 2, 617, 624, 7, 116, 2, 2, 618, 619, 7, 101,
-	2, 2, 619, 620, 7, 110, 2, 2, 620, 621, 7, 99, 2, 2, 621, 622, 7, 117,
-	2, 2, 622, 624, 7, 117, 2, 2, 623, 489, 3, 2, 2, 2, 623, 494, 3, 2, 2,
-	2, 623, 501, 3, 2, 2, 2, 623, 505, 3, 2, 2, 2, 623, 514, 3, 2, 2, 2, 623,
-	520, 3, 2, 2, 2, 623, 524, 3, 2, 2, 2, 623, 529, 3, 2, 2, 2, 623, 531,
-	3, 2, 2, 2, 623, 534, 3, 2, 2, 2, 623, 540, 3, 2, 2, 2, 623, 544, 3, 2,
-	2, 2, 623, 548, 3, 2, 2, 2, 623, 552, 3, 2, 2, 2, 623, 559, 3, 2, 2, 2,
-	623, 565, 3, 2, 2, 2, 623, 570, 3, 2, 2, 2, 623, 581, 3, 2, 2, 2, 623,
-	583, 3, 2, 2, 2, 623, 588, 3, 2, 2, 2, 623, 592, 3, 2, 2, 2, 623, 600,
-	3, 2, 2, 2, 623, 603, 3, 2, 2, 2, 623, 609, 3, 2, 2, 2, 623, 615, 3, 2,
-	2, 2, 623, 618, 3, 2, 2, 2, 624, 142, 3, 2, 2, 2, 625, 626, 7, 126, 2,
-	2, 626, 633, 7, 126, 2, 2, 627, 628, 7, 40, 2, 2, 628, 633, 7, 40, 2, 2,
-	629, 633, 5, 145, 73, 2, 630, 633, 5, 147, 74, 2, 631, 633, 5, 149, 75,
-	2, 632, 625, 3, 2, 2, 2, 632, 627, 3, 2, 2, 2, 632, 629, 3, 2, 2, 2, 632,
-	630, 3, 2, 2, 2, 632, 631, 3, 2, 2, 2, 633, 144, 3, 2, 2, 2, 634, 635,
-	7, 63, 2, 2, 635, 645, 7, 63, 2, 2, 636, 637, 7, 35, 2, 2, 637, 645, 7,
-	63, 2, 2, 638, 645, 7, 62, 2, 2, 639, 640, 7, 62, 2, 2, 640, 645, 7, 63,
-	2, 2, 641, 645, 7, 64, 2, 2, 642, 643, 7, 64, 2, 2, 643, 645, 7, 63, 2,
-	2, 644, 634, 3, 2, 2, 2, 644, 636, 3, 2, 2, 2, 644, 638, 3, 2, 2, 2, 644,
-	639, 3, 2, 2, 2, 644, 641, 3, 2, 2, 2, 644, 642, 3, 2, 2, 2, 645, 146,
-	3, 2, 2, 2, 646, 647, 9, 2, 2, 2, 647, 148, 3, 2, 2, 2, 648, 657, 9, 3,
-	2, 2, 649, 650, 7, 62, 2, 2, 650, 657, 7, 62, 2, 2, 651, 652, 7, 64, 2,
-	2, 652, 657, 7, 64, 2, 2, 653, 657, 7, 40, 2, 2, 654, 655, 7, 40, 2, 2,
-	655, 657, 7, 96, 2, 2, 656, 648, 3, 2, 2, 2, 656, 649, 3, 2, 2, 2, 656,
-	651, 3, 2, 2, 2, 656, 653, 3, 2, 2, 2, 656, 654, 3, 2, 2, 2, 657, 150,
-	3, 2, 2, 2, 658, 662, 9, 4, 2, 2, 659, 660, 7, 62, 2, 2, 660, 662, 7, 47,
-	2, 2, 661, 658, 3, 2, 2, 2, 661, 659, 3, 2, 2, 2, 662, 152, 3, 2, 2, 2,
-	663, 664, 7, 47, 2, 2, 664, 665, 7, 64, 2, 2, 665, 154, 3, 2, 2, 2, 666,
-	670, 5, 157, 79, 2, 667, 670, 5, 159, 80, 2, 668, 670, 5, 161, 81, 2, 669,
-	666, 3, 2, 2, 2, 669, 667, 3, 2, 2, 2, 669, 668, 3, 2, 2, 2, 670, 156,
-	3, 2, 2, 2, 671, 675, 9, 5, 2, 2, 672, 674, 5, 195, 98, 2, 673, 672, 3,
-	2, 2, 2, 674, 677, 3, 2, 2, 2, 675, 673, 3, 2, 2, 2, 675, 676, 3, 2, 2,
-	2, 676, 158, 3, 2, 2, 2, 677, 675, 3, 2, 2, 2, 678, 682, 7, 50, 2, 2, 679,
-	681, 5, 197, 99, 2, 680, 679, 3, 2, 2, 2, 681, 684, 3, 2, 2, 2, 682, 680,
-	3, 2, 2, 2, 682, 683, 3, 2, 2, 2, 683, 160, 3, 2, 2, 2, 684, 682, 3, 2,
-	2, 2, 685, 686, 7, 50, 2, 2, 686, 688, 9, 6, 2, 2, 687, 689, 5, 199, 100,
-	2, 688, 687, 3, 2, 2, 2, 689, 690, 3, 2, 2, 2, 690, 688, 3, 2, 2, 2, 690,
-	691, 3, 2, 2, 2, 691, 162, 3, 2, 2, 2, 692, 693, 5, 165, 83, 2, 693, 695,
-	7, 48, 2, 2, 694, 696, 5, 165, 83, 2, 695, 694, 3, 2, 2, 2, 695, 696, 3,
-	2, 2, 2, 696, 698, 3, 2, 2, 2, 697, 699, 5, 167, 84, 2, 698, 697, 3, 2,
-	2, 2, 698, 699, 3, 2, 2, 2, 699, 709, 3, 2, 2, 2, 700, 701, 5, 165, 83,
-	2, 701, 702, 5, 167, 84, 2, 702, 709, 3, 2, 2, 2, 703, 704, 7, 48, 2, 2,
-	704, 706, 5, 165, 83, 2, 705, 707, 5, 167, 84, 2, 706, 705, 3, 2, 2, 2,
-	706, 707, 3, 2, 2, 2, 707, 709, 3, 2, 2, 2, 708, 692, 3, 2, 2, 2, 708,
-	700, 3, 2, 2, 2, 708, 703, 3, 2, 2, 2, 709, 164, 3, 2, 2, 2, 710, 712,
-	5, 195, 98, 2, 711, 710, 3, 2, 2, 2, 712, 713, 3, 2, 2, 2, 713, 711, 3,
-	2, 2, 2, 713, 714, 3, 2, 2, 2, 714, 166, 3, 2, 2, 2, 715, 717, 9, 7, 2,
-	2, 716, 718, 9, 8, 2, 2, 717, 716, 3, 2, 2, 2, 717, 718, 3, 2, 2, 2, 718,
-	719, 3, 2, 2, 2, 719, 720, 5, 165, 83, 2, 720, 168, 3, 2, 2, 2, 721, 724,
-	5, 165, 83, 2, 722, 724, 5, 163, 82, 2, 723, 721, 3, 2, 2, 2, 723, 722,
-	3, 2, 2, 2, 724, 725, 3, 2, 2, 2, 725, 726, 7, 107, 2, 2, 726, 170, 3,
-	2, 2, 2, 727, 730, 7, 41, 2, 2, 728, 731, 5, 173, 87, 2, 729, 731, 5, 175,
-	88, 2, 730, 728, 3, 2, 2, 2, 730, 729, 3, 2, 2, 2, 731, 732, 3, 2, 2, 2,
-	732, 733, 7, 41, 2, 2, 733, 172, 3, 2, 2, 2, 734, 739, 5, 203, 102, 2,
-	735, 739, 5, 181, 91, 2, 736, 739, 5, 183, 92, 2, 737, 739, 5, 185, 93,
-	2, 738, 734, 3, 2, 2, 2, 738, 735, 3, 2, 2, 2, 738, 736, 3, 2, 2, 2, 738,
-	737, 3, 2, 2, 2, 739, 174, 3, 2, 2, 2, 740, 743, 5, 177, 89, 2, 741, 743,
-	5, 179, 90, 2, 742, 740, 3, 2, 2, 2, 742, 741, 3, 2, 2, 2, 743, 176, 3,
-	2, 2, 2, 744, 745, 7, 94, 2, 2, 745, 746, 5, 197, 99, 2, 746, 747, 5, 197,
-	99, 2, 747, 748, 5, 197, 99, 2, 748, 178, 3, 2, 2, 2, 749, 750, 7, 94,
-	2, 2, 750, 751, 7, 122, 2, 2, 751, 752, 5, 199, 100, 2, 752, 753, 5, 199,
-	100, 2, 753, 180, 3, 2, 2, 2, 754, 755, 7, 94, 2, 2, 755, 756, 7, 119,
-	2, 2, 756, 757, 3, 2, 2, 2, 757, 758, 5, 199, 100, 2, 758, 759, 5, 199,
-	100, 2, 759, 760, 5, 199, 100, 2, 760, 761, 5, 199, 100, 2, 761, 182, 3,
-	2, 2, 2, 762, 763, 7, 94, 2, 2, 763, 764, 7, 87, 2, 2, 764, 765, 3, 2,
-	2, 2, 765, 766, 5, 199, 100, 2, 766, 767, 5, 199, 100, 2, 767, 768, 5,
-	199, 100, 2, 768, 769, 5, 199, 100, 2, 769, 770, 5, 199, 100, 2, 770, 771,
-	5, 199, 100, 2, 771, 772, 5, 199, 100, 2, 772, 773, 5, 199, 100, 2, 773,
-	184, 3, 2, 2, 2, 774, 775, 7, 94, 2, 2, 775, 776, 9, 9, 2, 2, 776, 186,
-	3, 2, 2, 2, 777, 780, 5, 189, 95, 2, 778, 780, 5, 191, 96, 2, 779, 777,
-	3, 2, 2, 2, 779, 778, 3, 2, 2, 2, 780, 188, 3, 2, 2, 2, 781, 787, 7, 98,
-	2, 2, 782, 786, 5, 203, 102, 2, 783, 786, 5, 201, 101, 2, 784, 786, 9,
-	10, 2, 2, 785, 782, 3, 2, 2, 2, 785, 783, 3, 2, 2, 2, 785, 784, 3, 2, 2,
-	2, 786, 789, 3, 2, 2, 2, 787, 788, 3, 2, 2, 2, 787, 785, 3, 2, 2, 2, 788,
-	790, 3, 2, 2, 2, 789, 787, 3, 2, 2, 2, 790, 791, 7, 98, 2, 2, 791, 190,
-	3, 2, 2, 2, 792, 799, 7, 36, 2, 2, 793, 794, 7, 94, 2, 2, 794, 798, 7,
-	36, 2, 2, 795, 798, 5, 173, 87, 2, 796, 798, 5, 175, 88, 2, 797, 793, 3,
-	2, 2, 2, 797, 795, 3, 2, 2, 2, 797, 796, 3, 2, 2, 2, 798, 801, 3, 2, 2,
-	2, 799, 800, 3, 2, 2, 2, 799, 797, 3, 2, 2, 2, 800, 802, 3, 2, 2, 2, 801,
-	799, 3, 2, 2, 2, 802, 803, 7, 36, 2, 2, 803, 192, 3, 2, 2, 2, 804, 807,
-	7, 97, 2, 2, 805, 807, 5, 207, 104, 2, 806, 804, 3, 2, 2, 2, 806, 805,
-	3, 2, 2, 2, 807, 194, 3, 2, 2, 2, 808, 809, 9, 11, 2, 2, 809, 196, 3, 2,
-	2, 2, 810, 811, 9, 12, 2, 2, 811, 198, 3, 2, 2, 2, 812, 813, 9, 13, 2,
-	2, 813, 200, 3, 2, 2, 2, 814, 815, 9, 14, 2, 2, 815, 202, 3, 2, 2, 2, 816,
-	817, 10, 14, 2, 2, 817, 204, 3, 2, 2, 2, 818, 820, 9, 15, 2, 2, 819, 818,
-	3, 2, 2, 2, 820, 206, 3, 2, 2, 2, 821, 823, 9, 16, 2, 2, 822, 821, 3, 2,
-	2, 2, 823, 208, 3, 2, 2, 2, 824, 826, 9, 17, 2, 2, 825, 824, 3, 2, 2, 2,
-	826, 827, 3, 2, 2, 2, 827, 825, 3, 2, 2, 2, 827, 828, 3, 2, 2, 2, 828,
-	829, 3, 2, 2, 2, 829, 830, 8, 105, 2, 2, 830, 210, 3, 2, 2, 2, 831, 832,
-	7, 49, 2, 2, 832, 833, 7, 44, 2, 2, 833, 837, 3, 2, 2, 2, 834, 836, 11,
-	2, 2, 2, 835, 834, 3, 2, 2, 2, 836, 839, 3, 2, 2, 2, 837, 838, 3, 2, 2,
-	2, 837, 835, 3, 2, 2, 2, 838, 840, 3, 2, 2, 2, 839, 837, 3, 2, 2, 2, 840,
-	841, 7, 44, 2, 2, 841, 842, 7, 49, 2, 2, 842, 843, 3, 2, 2, 2, 843, 844,
-	8, 106, 2, 2, 844, 212, 3, 2, 2, 2, 845, 849, 7, 37, 2, 2, 846, 847, 7,
-	49, 2, 2, 847, 849, 7, 49, 2, 2, 848, 845, 3, 2, 2, 2, 848, 846, 3, 2,
-	2, 2, 849, 853, 3, 2, 2, 2, 850, 852, 10, 18, 2, 2, 851, 850, 3, 2, 2,
-	2, 852, 855, 3, 2, 2, 2, 853, 851, 3, 2, 2, 2, 853, 854, 3, 2, 2, 2, 854,
-	856, 3, 2, 2, 2, 855, 853, 3, 2, 2, 2, 856, 857, 8, 107, 3, 2, 857, 214,
-	3, 2, 2, 2, 858, 860, 9, 18, 2, 2, 859, 858, 3, 2, 2, 2, 860, 861, 3, 2,
-	2, 2, 861, 859, 3, 2, 2, 2, 861, 862, 3, 2, 2, 2, 862, 863, 3, 2, 2, 2,
-	863, 864, 8, 108, 2, 2, 864, 216, 3, 2, 2, 2, 865, 866, 11, 2, 2, 2, 866,
-	218, 3, 2, 2, 2, 39, 2, 477, 481, 484, 486, 623, 632, 644, 656, 661, 669,
-	675, 682, 690, 695, 698, 706, 708, 713, 717, 723, 730, 738, 742, 779, 785,
-	787, 797, 799, 806, 819, 822, 827, 837, 848, 853, 861, 4, 2, 3, 2, 8, 2,
+	9, 106, 4, 107, 9, 107, 4, 108, 9, 108, 4, 109, 9, 109, 4, 110, 9, 110,
+	4, 111, 9, 111, 4, 112, 9, 112, 4, 113, 9, 113, 3, 2, 3, 2, 3, 2, 3, 2,
+	3, 2, 3, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4, 3, 4,
+	3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 10,
+	3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3,
+	12, 3, 12, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15,
+	3, 15, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3,
+	19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 22, 3, 22, 3, 23,
+	3, 23, 3, 24, 3, 24, 3, 25, 3, 25, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3,
+	28, 3, 28, 3, 28, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31,
+	3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3,
+	34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35,
+	3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3,
+	37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38,
+	3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3,
+	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41,
+	3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3,
+	43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 47,
+	3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3,
+	48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51,
+	3, 51, 3, 51, 3, 52, 3, 52, 3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3,
+	55, 3, 55, 3, 55, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56,
+	3, 57, 3, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 59, 3, 59, 3, 59, 3, 59, 3,
+	59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62,
+	3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 3, 65, 3, 66, 3,
+	66, 3, 67, 3, 67, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 70, 3, 70, 3, 71,
+	3, 71, 3, 71, 3, 72, 3, 72, 3, 72, 3, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3,
+	73, 3, 73, 3, 73, 3, 74, 3, 74, 5, 74, 502, 10, 74, 3, 74, 3, 74, 5, 74,
+	506, 10, 74, 3, 74, 7, 74, 509, 10, 74, 12, 74, 14, 74, 512, 11, 74, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75,
+	3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3,
+	75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 3, 75, 5, 75, 648, 10, 75,
+	3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 3, 76, 5, 76, 657, 10, 76, 3,
+	77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 5, 77,
+	669, 10, 77, 3, 78, 3, 78, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3,
+	79, 3, 79, 5, 79, 681, 10, 79, 3, 80, 3, 80, 3, 80, 5, 80, 686, 10, 80,
+	3, 81, 3, 81, 3, 81, 3, 82, 3, 82, 3, 82, 5, 82, 694, 10, 82, 3, 83, 3,
+	83, 7, 83, 698, 10, 83, 12, 83, 14, 83, 701, 11, 83, 3, 84, 3, 84, 7, 84,
+	705, 10, 84, 12, 84, 14, 84, 708, 11, 84, 3, 85, 3, 85, 3, 85, 6, 85, 713,
+	10, 85, 13, 85, 14, 85, 714, 3, 86, 3, 86, 3, 86, 5, 86, 720, 10, 86, 3,
+	86, 5, 86, 723, 10, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 5, 86,
+	731, 10, 86, 5, 86, 733, 10, 86, 3, 87, 6, 87, 736, 10, 87, 13, 87, 14,
+	87, 737, 3, 88, 3, 88, 5, 88, 742, 10, 88, 3, 88, 3, 88, 3, 89, 3, 89,
+	5, 89, 748, 10, 89, 3, 89, 3, 89, 3, 90, 3, 90, 3, 90, 5, 90, 755, 10,
+	90, 3, 90, 3, 90, 3, 91, 3, 91, 3, 91, 3, 91, 5, 91, 763, 10, 91, 3, 92,
+	3, 92, 5, 92, 767, 10, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 3, 94, 3,
+	94, 3, 94, 3, 94, 3, 94, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95, 3, 95,
+	3, 95, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3,
+	96, 3, 96, 3, 96, 3, 97, 3, 97, 3, 97, 3, 98, 3, 98, 5, 98, 804, 10, 98,
+	3, 99, 3, 99, 3, 99, 3, 99, 7, 99, 810, 10, 99, 12, 99, 14, 99, 813, 11,
+	99, 3, 99, 3, 99, 3, 100, 3, 100, 3, 100, 3, 100, 3, 100, 7, 100, 822,
+	10, 100, 12, 100, 14, 100, 825, 11, 100, 3, 100, 3, 100, 3, 101, 3, 101,
+	5, 101, 831, 10, 101, 3, 102, 3, 102, 3, 103, 3, 103, 3, 104, 3, 104, 3,
+	105, 3, 105, 3, 106, 3, 106, 3, 107, 5, 107, 844, 10, 107, 3, 108, 5, 108,
+	847, 10, 108, 3, 109, 6, 109, 850, 10, 109, 13, 109, 14, 109, 851, 3, 109,
+	3, 109, 3, 110, 3, 110, 3, 110, 3, 110, 7, 110, 860, 10, 110, 12, 110,
+	14, 110, 863, 11, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 110, 3, 111,
+	3, 111, 3, 111, 5, 111, 873, 10, 111, 3, 111, 7, 111, 876, 10, 111, 12,
+	111, 14, 111, 879, 11, 111, 3, 111, 3, 111, 3, 112, 6, 112, 884, 10, 112,
+	13, 112, 14, 112, 885, 3, 112, 3, 112, 3, 113, 3, 113, 5, 811, 823, 861,
+	2, 114, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21,
+	12, 23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39,
+	21, 41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57,
+	30, 59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75,
+	39, 77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93,
+	48, 95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56,
+	111, 57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64,
+	127, 65, 129, 66, 131, 67, 133, 68, 135, 69, 137, 70, 139, 71, 141, 72,
+	143, 73, 145, 74, 147, 75, 149, 76, 151, 77, 153, 2, 155, 2, 157, 2, 159,
+	2, 161, 78, 163, 79, 165, 2, 167, 2, 169, 2, 171, 80, 173, 2, 175, 2, 177,
+	81, 179, 82, 181, 2, 183, 2, 185, 2, 187, 2, 189, 83, 191, 84, 193, 2,
+	195, 85, 197, 2, 199, 2, 201, 2, 203, 2, 205, 2, 207, 2, 209, 2, 211, 2,
+	213, 2, 215, 2, 217, 86, 219, 87, 221, 88, 223, 89, 225, 90, 3, 2, 19,
+	6, 2, 45, 45, 47, 47, 96, 96, 126, 126, 5, 2, 39, 39, 44, 44, 49, 49, 7,
+	2, 35, 35, 40, 40, 44, 45, 47, 47, 96, 96, 3, 2, 51, 59, 4, 2, 90, 90,
+	122, 122, 4, 2, 71, 71, 103, 103, 4, 2, 45, 45, 47, 47, 11, 2, 36, 36,
+	41, 41, 94, 94, 99, 100, 104, 104, 112, 112, 116, 116, 118, 118, 120, 120,
+	4, 2, 98, 98, 128, 128, 3, 2, 50, 59, 3, 2, 50, 57, 5, 2, 50, 59, 67, 72,
+	99, 104, 3, 2, 12, 12, 22, 2, 50, 59, 1634, 1643, 1778, 1787, 2408, 2417,
+	2536, 2545, 2664, 2673, 2792, 2801, 2920, 2929, 3049, 3057, 3176, 3185,
+	3304, 3313, 3432, 3441, 3666, 3675, 3794, 3803, 3874, 3883, 4162, 4171,
+	4971, 4979, 6114, 6123, 6162, 6171, 65298, 65307, 260, 2, 67, 92, 99, 124,
+	172, 172, 183, 183, 188, 188, 194, 216, 218, 248, 250, 545, 548, 565, 594,
+	687, 690, 698, 701, 707, 722, 723, 738, 742, 752, 752, 892, 892, 904, 904,
+	906, 908, 910, 910, 912, 931, 933, 976, 978, 985, 988, 1013, 1026, 1155,
+	1166, 1222, 1225, 1226, 1229, 1230, 1234, 1271, 1274, 1275, 1331, 1368,
+	1371, 1371, 1379, 1417, 1490, 1516, 1522, 1524, 1571, 1596, 1602, 1612,
+	1651, 1749, 1751, 1751, 1767, 1768, 1788, 1790, 1810, 1810, 1812, 1838,
+	1922, 1959, 2311, 2363, 2367, 2367, 2386, 2386, 2394, 2403, 2439, 2446,
+	2449, 2450, 2453, 2474, 2476, 2482, 2484, 2484, 2488, 2491, 2526, 2527,
+	2529, 2531, 2546, 2547, 2567, 2572, 2577, 2578, 2581, 2602, 2604, 2610,
+	2612, 2613, 2615, 2616, 2618, 2619, 2651, 2654, 2656, 2656, 2676, 2678,
+	2695, 2701, 2703, 2703, 2705, 2707, 2709, 2730, 2732, 2738, 2740, 2741,
+	2743, 2747, 2751, 2751, 2770, 2770, 2786, 2786, 2823, 2830, 2833, 2834,
+	2837, 2858, 2860, 2866, 2868, 2869, 2872, 2875, 2879, 2879, 2910, 2911,
+	2913, 2915, 2951, 2956, 2960, 2962, 2964, 2967, 2971, 2972, 2974, 2974,
+	2976, 2977, 2981, 2982, 2986, 2988, 2992, 2999, 3001, 3003, 3079, 3086,
+	3088, 3090, 3092, 3114, 3116, 3125, 3127, 3131, 3170, 3171, 3207, 3214,
+	3216, 3218, 3220, 3242, 3244, 3253, 3255, 3259, 3296, 3296, 3298, 3299,
+	3335, 3342, 3344, 3346, 3348, 3370, 3372, 3387, 3426, 3427, 3463, 3480,
+	3484, 3507, 3509, 3517, 3519, 3519, 3522, 3528, 3587, 3634, 3636, 3637,
+	3650, 3656, 3715, 3716, 3718, 3718, 3721, 3722, 3724, 3724, 3727, 3727,
+	3734, 3737, 3739, 3745, 3747, 3749, 3751, 3751, 3753, 3753, 3756, 3757,
+	3759, 3762, 3764, 3765, 3775, 3782, 3784, 3784, 3806, 3807, 3842, 3842,
+	3906, 3948, 3978, 3981, 4098, 4131, 4133, 4137, 4139, 4140, 4178, 4183,
+	4258, 4295, 4306, 4344, 4354, 4443, 4449, 4516, 4522, 4603, 4610, 4616,
+	4618, 4680, 4682, 4682, 4684, 4687, 4690, 4696, 4698, 4698, 4700, 4703,
+	4706, 4744, 4746, 4746, 4748, 4751, 4754, 4784, 4786, 4786, 4788, 4791,
+	4794, 4800, 4802, 4802, 4804, 4807, 4810, 4816, 4818, 4824, 4826, 4848,
+	4850, 4880, 4882, 4882, 4884, 4887, 4890, 4896, 4898, 4936, 4938, 4956,
+	5026, 5110, 5123, 5752, 5763, 5788, 5794, 5868, 6018, 6069, 6178, 6265,
+	6274, 6314, 7682, 7837, 7842, 7931, 7938, 7959, 7962, 7967, 7970, 8007,
+	8010, 8015, 8018, 8025, 8027, 8027, 8029, 8029, 8031, 8031, 8033, 8063,
+	8066, 8118, 8120, 8126, 8128, 8128, 8132, 8134, 8136, 8142, 8146, 8149,
+	8152, 8157, 8162, 8174, 8180, 8182, 8184, 8190, 8321, 8321, 8452, 8452,
+	8457, 8457, 8460, 8469, 8471, 8471, 8475, 8479, 8486, 8486, 8488, 8488,
+	8490, 8490, 8492, 8495, 8497, 8499, 8501, 8507, 8546, 8581, 12295, 12297,
+	12323, 12331, 12339, 12343, 12346, 12348, 12355, 12438, 12447, 12448, 12451,
+	12540, 12542, 12544, 12551, 12590, 12595, 12688, 12706, 12729, 13314, 13314,
+	19895, 19895, 19970, 19970, 40871, 40871, 40962, 42126, 44034, 44034, 55205,
+	55205, 63746, 64047, 64258, 64264, 64277, 64281, 64287, 64287, 64289, 64298,
+	64300, 64312, 64314, 64318, 64320, 64320, 64322, 64323, 64325, 64326, 64328,
+	64435, 64469, 64831, 64850, 64913, 64916, 64969, 65010, 65021, 65138, 65140,
+	65142, 65142, 65144, 65278, 65315, 65340, 65347, 65372, 65384, 65472, 65476,
+	65481, 65484, 65489, 65492, 65497, 65500, 65502, 4, 2, 11, 11, 34, 34,
+	4, 2, 12, 12, 15, 15, 2, 940, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7,
+	3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2,
+	15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2,
+	2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2,
+	2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2,
+	2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3,
+	2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53,
+	3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2,
+	61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2,
+	2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2,
+	2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2,
+	2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3,
+	2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99,
+	3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2,
+	2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2, 113, 3,
+	2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2, 2, 2, 2,
+	121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127, 3, 2,
+	2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 133, 3, 2, 2, 2, 2, 135,
+	3, 2, 2, 2, 2, 137, 3, 2, 2, 2, 2, 139, 3, 2, 2, 2, 2, 141, 3, 2, 2, 2,
+	2, 143, 3, 2, 2, 2, 2, 145, 3, 2, 2, 2, 2, 147, 3, 2, 2, 2, 2, 149, 3,
+	2, 2, 2, 2, 151, 3, 2, 2, 2, 2, 161, 3, 2, 2, 2, 2, 163, 3, 2, 2, 2, 2,
+	171, 3, 2, 2, 2, 2, 177, 3, 2, 2, 2, 2, 179, 3, 2, 2, 2, 2, 189, 3, 2,
+	2, 2, 2, 191, 3, 2, 2, 2, 2, 195, 3, 2, 2, 2, 2, 217, 3, 2, 2, 2, 2, 219,
+	3, 2, 2, 2, 2, 221, 3, 2, 2, 2, 2, 223, 3, 2, 2, 2, 2, 225, 3, 2, 2, 2,
+	3, 227, 3, 2, 2, 2, 5, 235, 3, 2, 2, 2, 7, 237, 3, 2, 2, 2, 9, 244, 3,
+	2, 2, 2, 11, 246, 3, 2, 2, 2, 13, 248, 3, 2, 2, 2, 15, 250, 3, 2, 2, 2,
+	17, 252, 3, 2, 2, 2, 19, 254, 3, 2, 2, 2, 21, 260, 3, 2, 2, 2, 23, 262,
+	3, 2, 2, 2, 25, 267, 3, 2, 2, 2, 27, 269, 3, 2, 2, 2, 29, 274, 3, 2, 2,
+	2, 31, 277, 3, 2, 2, 2, 33, 279, 3, 2, 2, 2, 35, 283, 3, 2, 2, 2, 37, 286,
+	3, 2, 2, 2, 39, 289, 3, 2, 2, 2, 41, 292, 3, 2, 2, 2, 43, 294, 3, 2, 2,
+	2, 45, 296, 3, 2, 2, 2, 47, 298, 3, 2, 2, 2, 49, 300, 3, 2, 2, 2, 51, 302,
+	3, 2, 2, 2, 53, 304, 3, 2, 2, 2, 55, 307, 3, 2, 2, 2, 57, 310, 3, 2, 2,
+	2, 59, 312, 3, 2, 2, 2, 61, 315, 3, 2, 2, 2, 63, 318, 3, 2, 2, 2, 65, 320,
+	3, 2, 2, 2, 67, 327, 3, 2, 2, 2, 69, 333, 3, 2, 2, 2, 71, 342, 3, 2, 2,
+	2, 73, 347, 3, 2, 2, 2, 75, 359, 3, 2, 2, 2, 77, 365, 3, 2, 2, 2, 79, 370,
+	3, 2, 2, 2, 81, 377, 3, 2, 2, 2, 83, 383, 3, 2, 2, 2, 85, 386, 3, 2, 2,
+	2, 87, 393, 3, 2, 2, 2, 89, 396, 3, 2, 2, 2, 91, 399, 3, 2, 2, 2, 93, 401,
+	3, 2, 2, 2, 95, 403, 3, 2, 2, 2, 97, 413, 3, 2, 2, 2, 99, 417, 3, 2, 2,
+	2, 101, 422, 3, 2, 2, 2, 103, 425, 3, 2, 2, 2, 105, 427, 3, 2, 2, 2, 107,
+	429, 3, 2, 2, 2, 109, 433, 3, 2, 2, 2, 111, 438, 3, 2, 2, 2, 113, 444,
+	3, 2, 2, 2, 115, 448, 3, 2, 2, 2, 117, 450, 3, 2, 2, 2, 119, 456, 3, 2,
+	2, 2, 121, 459, 3, 2, 2, 2, 123, 462, 3, 2, 2, 2, 125, 465, 3, 2, 2, 2,
+	127, 468, 3, 2, 2, 2, 129, 471, 3, 2, 2, 2, 131, 474, 3, 2, 2, 2, 133,
+	476, 3, 2, 2, 2, 135, 478, 3, 2, 2, 2, 137, 480, 3, 2, 2, 2, 139, 483,
+	3, 2, 2, 2, 141, 485, 3, 2, 2, 2, 143, 488, 3, 2, 2, 2, 145, 492, 3, 2,
+	2, 2, 147, 501, 3, 2, 2, 2, 149, 647, 3, 2, 2, 2, 151, 656, 3, 2, 2, 2,
+	153, 668, 3, 2, 2, 2, 155, 670, 3, 2, 2, 2, 157, 680, 3, 2, 2, 2, 159,
+	685, 3, 2, 2, 2, 161, 687, 3, 2, 2, 2, 163, 693, 3, 2, 2, 2, 165, 695,
+	3, 2, 2, 2, 167, 702, 3, 2, 2, 2, 169, 709, 3, 2, 2, 2, 171, 732, 3, 2,
+	2, 2, 173, 735, 3, 2, 2, 2, 175, 739, 3, 2, 2, 2, 177, 747, 3, 2, 2, 2,
+	179, 751, 3, 2, 2, 2, 181, 762, 3, 2, 2, 2, 183, 766, 3, 2, 2, 2, 185,
+	768, 3, 2, 2, 2, 187, 773, 3, 2, 2, 2, 189, 778, 3, 2, 2, 2, 191, 786,
+	3, 2, 2, 2, 193, 798, 3, 2, 2, 2, 195, 803, 3, 2, 2, 2, 197, 805, 3, 2,
+	2, 2, 199, 816, 3, 2, 2, 2, 201, 830, 3, 2, 2, 2, 203, 832, 3, 2, 2, 2,
+	205, 834, 3, 2, 2, 2, 207, 836, 3, 2, 2, 2, 209, 838, 3, 2, 2, 2, 211,
+	840, 3, 2, 2, 2, 213, 843, 3, 2, 2, 2, 215, 846, 3, 2, 2, 2, 217, 849,
+	3, 2, 2, 2, 219, 855, 3, 2, 2, 2, 221, 872, 3, 2, 2, 2, 223, 883, 3, 2,
+	2, 2, 225, 889, 3, 2, 2, 2, 227, 228, 7, 114, 2, 2, 228, 229, 7, 99, 2,
+	2, 229, 230, 7, 101, 2, 2, 230, 231, 7, 109, 2, 2, 231, 232, 7, 99, 2,
+	2, 232, 233, 7, 105, 2, 2, 233, 234, 7, 103, 2, 2, 234, 4, 3, 2, 2, 2,
+	235, 236, 7, 35, 2, 2, 236, 6, 3, 2, 2, 2, 237, 238, 7, 107, 2, 2, 238,
+	239, 7, 111, 2, 2, 239, 240, 7, 114, 2, 2, 240, 241, 7, 113, 2, 2, 241,
+	242, 7, 116, 2, 2, 242, 243, 7, 118, 2, 2, 243, 8, 3, 2, 2, 2, 244, 245,
+	7, 42, 2, 2, 245, 10, 3, 2, 2, 2, 246, 247, 7, 43, 2, 2, 247, 12, 3, 2,
+	2, 2, 248, 249, 7, 125, 2, 2, 249, 14, 3, 2, 2, 2, 250, 251, 7, 127, 2,
+	2, 251, 16, 3, 2, 2, 2, 252, 253, 7, 48, 2, 2, 253, 18, 3, 2, 2, 2, 254,
+	255, 7, 101, 2, 2, 255, 256, 7, 113, 2, 2, 256, 257, 7, 112, 2, 2, 257,
+	258, 7, 117, 2, 2, 258, 259, 7, 118, 2, 2, 259, 20, 3, 2, 2, 2, 260, 261,
+	7, 63, 2, 2, 261, 22, 3, 2, 2, 2, 262, 263, 7, 103, 2, 2, 263, 264, 7,
+	112, 2, 2, 264, 265, 7, 119, 2, 2, 265, 266, 7, 111, 2, 2, 266, 24, 3,
+	2, 2, 2, 267, 268, 7, 46, 2, 2, 268, 26, 3, 2, 2, 2, 269, 270, 7, 118,
+	2, 2, 270, 271, 7, 123, 2, 2, 271, 272, 7, 114, 2, 2, 272, 273, 7, 103,
+	2, 2, 273, 28, 3, 2, 2, 2, 274, 275, 7, 60, 2, 2, 275, 276, 7, 60, 2, 2,
+	276, 30, 3, 2, 2, 2, 277, 278, 7, 44, 2, 2, 278, 32, 3, 2, 2, 2, 279, 280,
+	7, 120, 2, 2, 280, 281, 7, 99, 2, 2, 281, 282, 7, 116, 2, 2, 282, 34, 3,
+	2, 2, 2, 283, 284, 7, 62, 2, 2, 284, 285, 7, 47, 2, 2, 285, 36, 3, 2, 2,
+	2, 286, 287, 7, 45, 2, 2, 287, 288, 7, 45, 2, 2, 288, 38, 3, 2, 2, 2, 289,
+	290, 7, 47, 2, 2, 290, 291, 7, 47, 2, 2, 291, 40, 3, 2, 2, 2, 292, 293,
+	7, 45, 2, 2, 293, 42, 3, 2, 2, 2, 294, 295, 7, 47, 2, 2, 295, 44, 3, 2,
+	2, 2, 296, 297, 7, 126, 2, 2, 297, 46, 3, 2, 2, 2, 298, 299, 7, 96, 2,
+	2, 299, 48, 3, 2, 2, 2, 300, 301, 7, 49, 2, 2, 301, 50, 3, 2, 2, 2, 302,
+	303, 7, 39, 2, 2, 303, 52, 3, 2, 2, 2, 304, 305, 7, 62, 2, 2, 305, 306,
+	7, 62, 2, 2, 306, 54, 3, 2, 2, 2, 307, 308, 7, 64, 2, 2, 308, 309, 7, 64,
+	2, 2, 309, 56, 3, 2, 2, 2, 310, 311, 7, 40, 2, 2, 311, 58, 3, 2, 2, 2,
+	312, 313, 7, 40, 2, 2, 313, 314, 7, 96, 2, 2, 314, 60, 3, 2, 2, 2, 315,
+	316, 7, 60, 2, 2, 316, 317, 7, 63, 2, 2, 317, 62, 3, 2, 2, 2, 318, 319,
+	7, 128, 2, 2, 319, 64, 3, 2, 2, 2, 320, 321, 7, 116, 2, 2, 321, 322, 7,
+	103, 2, 2, 322, 323, 7, 118, 2, 2, 323, 324, 7, 119, 2, 2, 324, 325, 7,
+	116, 2, 2, 325, 326, 7, 112, 2, 2, 326, 66, 3, 2, 2, 2, 327, 328, 7, 100,
+	2, 2, 328, 329, 7, 116, 2, 2, 329, 330, 7, 103, 2, 2, 330, 331, 7, 99,
+	2, 2, 331, 332, 7, 109, 2, 2, 332, 68, 3, 2, 2, 2, 333, 334, 7, 101, 2,
+	2, 334, 335, 7, 113, 2, 2, 335, 336, 7, 112, 2, 2, 336, 337, 7, 118, 2,
+	2, 337, 338, 7, 107, 2, 2, 338, 339, 7, 112, 2, 2, 339, 340, 7, 119, 2,
+	2, 340, 341, 7, 103, 2, 2, 341, 70, 3, 2, 2, 2, 342, 343, 7, 105, 2, 2,
+	343, 344, 7, 113, 2, 2, 344, 345, 7, 118, 2, 2, 345, 346, 7, 113, 2, 2,
+	346, 72, 3, 2, 2, 2, 347, 348, 7, 104, 2, 2, 348, 349, 7, 99, 2, 2, 349,
+	350, 7, 110, 2, 2, 350, 351, 7, 110, 2, 2, 351, 352, 7, 118, 2, 2, 352,
+	353, 7, 106, 2, 2, 353, 354, 7, 116, 2, 2, 354, 355, 7, 113, 2, 2, 355,
+	356, 7, 119, 2, 2, 356, 357, 7, 105, 2, 2, 357, 358, 7, 106, 2, 2, 358,
+	74, 3, 2, 2, 2, 359, 360, 7, 102, 2, 2, 360, 361, 7, 103, 2, 2, 361, 362,
+	7, 104, 2, 2, 362, 363, 7, 103, 2, 2, 363, 364, 7, 116, 2, 2, 364, 76,
+	3, 2, 2, 2, 365, 366, 7, 103, 2, 2, 366, 367, 7, 110, 2, 2, 367, 368, 7,
+	117, 2, 2, 368, 369, 7, 103, 2, 2, 369, 78, 3, 2, 2, 2, 370, 371, 7, 117,
+	2, 2, 371, 372, 7, 121, 2, 2, 372, 373, 7, 107, 2, 2, 373, 374, 7, 118,
+	2, 2, 374, 375, 7, 101, 2, 2, 375, 376, 7, 106, 2, 2, 376, 80, 3, 2, 2,
+	2, 377, 378, 7, 111, 2, 2, 378, 379, 7, 99, 2, 2, 379, 380, 7, 118, 2,
+	2, 380, 381, 7, 101, 2, 2, 381, 382, 7, 106, 2, 2, 382, 82, 3, 2, 2, 2,
+	383, 384, 7, 99, 2, 2, 384, 385, 7, 117, 2, 2, 385, 84, 3, 2, 2, 2, 386,
+	387, 7, 117, 2, 2, 387, 388, 7, 103, 2, 2, 388, 389, 7, 110, 2, 2, 389,
+	390, 7, 103, 2, 2, 390, 391, 7, 101, 2, 2, 391, 392, 7, 118, 2, 2, 392,
+	86, 3, 2, 2, 2, 393, 394, 7, 107, 2, 2, 394, 395, 7, 112, 2, 2, 395, 88,
+	3, 2, 2, 2, 396, 397, 7, 105, 2, 2, 397, 398, 7, 113, 2, 2, 398, 90, 3,
+	2, 2, 2, 399, 400, 7, 93, 2, 2, 400, 92, 3, 2, 2, 2, 401, 402, 7, 95, 2,
+	2, 402, 94, 3, 2, 2, 2, 403, 404, 7, 107, 2, 2, 404, 405, 7, 112, 2, 2,
+	405, 406, 7, 118, 2, 2, 406, 407, 7, 103, 2, 2, 407, 408, 7, 116, 2, 2,
+	408, 409, 7, 104, 2, 2, 409, 410, 7, 99, 2, 2, 410, 411, 7, 101, 2, 2,
+	411, 412, 7, 103, 2, 2, 412, 96, 3, 2, 2, 2, 413, 414, 7, 111, 2, 2, 414,
+	415, 7, 99, 2, 2, 415, 416, 7, 114, 2, 2, 416, 98, 3, 2, 2, 2, 417, 418,
+	7, 101, 2, 2, 418, 419, 7, 106, 2, 2, 419, 420, 7, 99, 2, 2, 420, 421,
+	7, 112, 2, 2, 421, 100, 3, 2, 2, 2, 422, 423, 7, 104, 2, 2, 423, 424, 7,
+	112, 2, 2, 424, 102, 3, 2, 2, 2, 425, 426, 7, 62, 2, 2, 426, 104, 3, 2,
+	2, 2, 427, 428, 7, 64, 2, 2, 428, 106, 3, 2, 2, 2, 429, 430, 7, 48, 2,
+	2, 430, 431, 7, 48, 2, 2, 431, 432, 7, 48, 2, 2, 432, 108, 3, 2, 2, 2,
+	433, 434, 7, 118, 2, 2, 434, 435, 7, 116, 2, 2, 435, 436, 7, 119, 2, 2,
+	436, 437, 7, 103, 2, 2, 437, 110, 3, 2, 2, 2, 438, 439, 7, 104, 2, 2, 439,
+	440, 7, 99, 2, 2, 440, 441, 7, 110, 2, 2, 441, 442, 7, 117, 2, 2, 442,
+	443, 7, 103, 2, 2, 443, 112, 3, 2, 2, 2, 444, 445, 7, 112, 2, 2, 445, 446,
+	7, 107, 2, 2, 446, 447, 7, 110, 2, 2, 447, 114, 3, 2, 2, 2, 448, 449, 7,
+	66, 2, 2, 449, 116, 3, 2, 2, 2, 450, 451, 7, 101, 2, 2, 451, 452, 7, 110,
+	2, 2, 452, 453, 7, 99, 2, 2, 453, 454, 7, 117, 2, 2, 454, 455, 7, 117,
+	2, 2, 455, 118, 3, 2, 2, 2, 456, 457, 7, 126, 2, 2, 457, 458, 7, 126, 2,
+	2, 458, 120, 3, 2, 2, 2, 459, 460, 7, 40, 2, 2, 460, 461, 7, 40, 2, 2,
+	461, 122, 3, 2, 2, 2, 462, 463, 7, 63, 2, 2, 463, 464, 7, 63, 2, 2, 464,
+	124, 3, 2, 2, 2, 465, 466, 7, 35, 2, 2, 466, 467, 7, 63, 2, 2, 467, 126,
+	3, 2, 2, 2, 468, 469, 7, 62, 2, 2, 469, 470, 7, 63, 2, 2, 470, 128, 3,
+	2, 2, 2, 471, 472, 7, 64, 2, 2, 472, 473, 7, 63, 2, 2, 473, 130, 3, 2,
+	2, 2, 474, 475, 7, 61, 2, 2, 475, 132, 3, 2, 2, 2, 476, 477, 7, 60, 2,
+	2, 477, 134, 3, 2, 2, 2, 478, 479, 7, 97, 2, 2, 479, 136, 3, 2, 2, 2, 480,
+	481, 7, 63, 2, 2, 481, 482, 7, 64, 2, 2, 482, 138, 3, 2, 2, 2, 483, 484,
+	7, 65, 2, 2, 484, 140, 3, 2, 2, 2, 485, 486, 7, 107, 2, 2, 486, 487, 7,
+	104, 2, 2, 487, 142, 3, 2, 2, 2, 488, 489, 7, 104, 2, 2, 489, 490, 7, 113,
+	2, 2, 490, 491, 7, 116, 2, 2, 491, 144, 3, 2, 2, 2, 492, 493, 7, 117, 2,
+	2, 493, 494, 7, 118, 2, 2, 494, 495, 7, 116, 2, 2, 495, 496, 7, 119, 2,
+	2, 496, 497, 7, 101, 2, 2, 497, 498, 7, 118, 2, 2, 498, 146, 3, 2, 2, 2,
+	499, 502, 7, 97, 2, 2, 500, 502, 5, 201, 101, 2, 501, 499, 3, 2, 2, 2,
+	501, 500, 3, 2, 2, 2, 502, 510, 3, 2, 2, 2, 503, 506, 7, 97, 2, 2, 504,
+	506, 5, 201, 101, 2, 505, 503, 3, 2, 2, 2, 505, 504, 3, 2, 2, 2, 506, 509,
+	3, 2, 2, 2, 507, 509, 5, 213, 107, 2, 508, 505, 3, 2, 2, 2, 508, 507, 3,
+	2, 2, 2, 509, 512, 3, 2, 2, 2, 510, 508, 3, 2, 2, 2, 510, 511, 3, 2, 2,
+	2, 511, 148, 3, 2, 2, 2, 512, 510, 3, 2, 2, 2, 513, 514, 7, 100, 2, 2,
+	514, 515, 7, 116, 2, 2, 515, 516, 7, 103, 2, 2, 516, 517, 7, 99, 2, 2,
+	517, 648, 7, 109, 2, 2, 518, 519, 7, 102, 2, 2, 519, 520, 7, 103, 2, 2,
+	520, 521, 7, 104, 2, 2, 521, 522, 7, 99, 2, 2, 522, 523, 7, 119, 2, 2,
+	523, 524, 7, 110, 2, 2, 524, 648, 7, 118, 2, 2, 525, 526, 7, 104, 2, 2,
+	526, 527, 7, 119, 2, 2, 527, 528, 7, 112, 2, 2, 528, 648, 7, 101, 2, 2,
+	529, 530, 7, 107, 2, 2, 530, 531, 7, 112, 2, 2, 531, 532, 7, 118, 2, 2,
+	532, 533, 7, 103, 2, 2, 533, 534, 7, 116, 2, 2, 534, 535, 7, 104, 2, 2,
+	535, 536, 7, 99, 2, 2, 536, 537, 7, 101, 2, 2, 537, 648, 7, 103, 2, 2,
+	538, 539, 7, 117, 2, 2, 539, 540, 7, 103, 2, 2, 540, 541, 7, 110, 2, 2,
+	541, 542, 7, 103, 2, 2, 542, 543, 7, 101, 2, 2, 543, 648, 7, 118, 2, 2,
+	544, 545, 7, 101, 2, 2, 545, 546, 7, 99, 2, 2, 546, 547, 7, 117, 2, 2,
+	547, 648, 7, 103, 2, 2, 548, 549, 7, 102, 2, 2, 549, 550, 7, 103, 2, 2,
+	550, 551, 7, 104, 2, 2, 551, 552, 7, 103, 2, 2, 552, 648, 7, 116, 2, 2,
+	553, 554, 7, 105, 2, 2, 554, 648, 7, 113, 2, 2, 555, 556, 7, 111, 2, 2,
+	556, 557, 7, 99, 2, 2, 557, 648, 7, 114, 2, 2, 558, 559, 7, 117, 2, 2,
+	559, 560, 7, 118, 2, 2, 560, 561, 7, 116, 2, 2, 561, 562, 7, 119, 2, 2,
+	562, 563, 7, 101, 2, 2, 563, 648, 7, 118, 2, 2, 564, 565, 7, 101, 2, 2,
+	565, 566, 7, 106, 2, 2, 566, 567, 7, 99, 2, 2, 567, 648, 7, 112, 2, 2,
+	568, 569, 7, 103, 2, 2, 569, 570, 7, 110, 2, 2, 570, 571, 7, 117, 2, 2,
+	571, 648, 7, 103, 2, 2, 572, 573, 7, 105, 2, 2, 573, 574, 7, 113, 2, 2,
+	574, 575, 7, 118, 2, 2, 575, 648, 7, 113, 2, 2, 576, 577, 7, 114, 2, 2,
+	577, 578, 7, 99, 2, 2, 578, 579, 7, 101, 2, 2, 579, 580, 7, 109, 2, 2,
+	580, 581, 7, 99, 2, 2, 581, 582, 7, 105, 2, 2, 582, 648, 7, 103, 2, 2,
+	583, 584, 7, 117, 2, 2, 584, 585, 7, 121, 2, 2, 585, 586, 7, 107, 2, 2,
+	586, 587, 7, 118, 2, 2, 587, 588, 7, 101, 2, 2, 588, 648, 7, 106, 2, 2,
+	589, 590, 7, 101, 2, 2, 590, 591, 7, 113, 2, 2, 591, 592, 7, 112, 2, 2,
+	592, 593, 7, 117, 2, 2, 593, 648, 7, 118, 2, 2, 594, 595, 7, 104, 2, 2,
+	595, 596, 7, 99, 2, 2, 596, 597, 7, 110, 2, 2, 597, 598, 7, 110, 2, 2,
+	598, 599, 7, 118, 2, 2, 599, 600, 7, 106, 2, 2, 600, 601, 7, 116, 2, 2,
+	601, 602, 7, 113, 2, 2, 602, 603, 7, 119, 2, 2, 603, 604, 7, 105, 2, 2,
+	604, 648, 7, 106, 2, 2, 605, 606, 7, 107, 2, 2, 606, 648, 7, 104, 2, 2,
+	607, 608, 7, 116, 2, 2, 608, 609, 7, 99, 2, 2, 609, 610, 7, 112, 2, 2,
+	610, 611, 7, 105, 2, 2, 611, 648, 7, 103, 2, 2, 612, 613, 7, 118, 2, 2,
+	613, 614, 7, 123, 2, 2, 614, 615, 7, 114, 2, 2, 615, 648, 7, 103, 2, 2,
+	616, 617, 7, 101, 2, 2, 617, 618, 7, 113, 2, 2, 618, 619, 7, 112, 2, 2,
+	619, 620, 7, 118, 2, 2, 620, 621, 7, 107, 2, 2, 621, 622, 7, 112, 2, 2,
+	622, 623, 7, 119, 2, 2, 623, 648, 7, 103, 2, 2, 624, 625, 7, 104, 2, 2,
+	625, 626, 7, 113, 2, 2, 626, 648, 7, 116, 2, 2, 627, 628, 7, 107, 2, 2,
+	628, 629, 7, 111, 2, 2, 629, 630, 7, 114, 2, 2, 630, 631, 7, 113, 2, 2,
+	631, 632, 7, 116, 2, 2, 632, 648, 7, 118, 2, 2, 633, 634, 7, 116, 2, 2,
+	634, 635, 7, 103, 2, 2, 635, 636, 7, 118, 2, 2, 636, 637, 7, 119, 2, 2,
+	637, 638, 7, 116, 2, 2, 638, 648, 7, 112, 2, 2, 639, 640, 7, 120, 2, 2,
+	640, 641, 7, 99, 2, 2, 641, 648, 7, 116, 2, 2, 642, 643, 7, 101, 2, 2,
+	643, 644, 7, 110, 2, 2, 644, 645, 7, 99, 2, 2, 645, 646, 7, 117, 2, 2,
+	646, 648, 7, 117, 2, 2, 647, 513, 3, 2, 2, 2, 647, 518, 3, 2, 2, 2, 647,
+	525, 3, 2, 2, 2, 647, 529, 3, 2, 2, 2, 647, 538, 3, 2, 2, 2, 647, 544,
+	3, 2, 2, 2, 647, 548, 3, 2, 2, 2, 647, 553, 3, 2, 2, 2, 647, 555, 3, 2,
+	2, 2, 647, 558, 3, 2, 2, 2, 647, 564, 3, 2, 2, 2, 647, 568, 3, 2, 2, 2,
+	647, 572, 3, 2, 2, 2, 647, 576, 3, 2, 2, 2, 647, 583, 3, 2, 2, 2, 647,
+	589, 3, 2, 2, 2, 647, 594, 3, 2, 2, 2, 647, 605, 3, 2, 2, 2, 647, 607,
+	3, 2, 2, 2, 647, 612, 3, 2, 2, 2, 647, 616, 3, 2, 2, 2, 647, 624, 3, 2,
+	2, 2, 647, 627, 3, 2, 2, 2, 647, 633, 3, 2, 2, 2, 647, 639, 3, 2, 2, 2,
+	647, 642, 3, 2, 2, 2, 648, 150, 3, 2, 2, 2, 649, 650, 7, 126, 2, 2, 650,
+	657, 7, 126, 2, 2, 651, 652, 7, 40, 2, 2, 652, 657, 7, 40, 2, 2, 653, 657,
+	5, 153, 77, 2, 654, 657, 5, 155, 78, 2, 655, 657, 5, 157, 79, 2, 656, 649,
+	3, 2, 2, 2, 656, 651, 3, 2, 2, 2, 656, 653, 3, 2, 2, 2, 656, 654, 3, 2,
+	2, 2, 656, 655, 3, 2, 2, 2, 657, 152, 3, 2, 2, 2, 658, 659, 7, 63, 2, 2,
+	659, 669, 7, 63, 2, 2, 660, 661, 7, 35, 2, 2, 661, 669, 7, 63, 2, 2, 662,
+	669, 7, 62, 2, 2, 663, 664, 7, 62, 2, 2, 664, 669, 7, 63, 2, 2, 665, 669,
+	7, 64, 2, 2, 666, 667, 7, 64, 2, 2, 667, 669, 7, 63, 2, 2, 668, 658, 3,
+	2, 2, 2, 668, 660, 3, 2, 2, 2, 668, 662, 3, 2, 2, 2, 668, 663, 3, 2, 2,
+	2, 668, 665, 3, 2, 2, 2, 668, 666, 3, 2, 2, 2, 669, 154, 3, 2, 2, 2, 670,
+	671, 9, 2, 2, 2, 671, 156, 3, 2, 2, 2, 672, 681, 9, 3, 2, 2, 673, 674,
+	7, 62, 2, 2, 674, 681, 7, 62, 2, 2, 675, 676, 7, 64, 2, 2, 676, 681, 7,
+	64, 2, 2, 677, 681, 7, 40, 2, 2, 678, 679, 7, 40, 2, 2, 679, 681, 7, 96,
+	2, 2, 680, 672, 3, 2, 2, 2, 680, 673, 3, 2, 2, 2, 680, 675, 3, 2, 2, 2,
+	680, 677, 3, 2, 2, 2, 680, 678, 3, 2, 2, 2, 681, 158, 3, 2, 2, 2, 682,
+	686, 9, 4, 2, 2, 683, 684, 7, 62, 2, 2, 684, 686, 7, 47, 2, 2, 685, 682,
+	3, 2, 2, 2, 685, 683, 3, 2, 2, 2, 686, 160, 3, 2, 2, 2, 687, 688, 7, 47,
+	2, 2, 688, 689, 7, 64, 2, 2, 689, 162, 3, 2, 2, 2, 690, 694, 5, 165, 83,
+	2, 691, 694, 5, 167, 84, 2, 692, 694, 5, 169, 85, 2, 693, 690, 3, 2, 2,
+	2, 693, 691, 3, 2, 2, 2, 693, 692, 3, 2, 2, 2, 694, 164, 3, 2, 2, 2, 695,
+	699, 9, 5, 2, 2, 696, 698, 5, 203, 102, 2, 697, 696, 3, 2, 2, 2, 698, 701,
+	3, 2, 2, 2, 699, 697, 3, 2, 2, 2, 699, 700, 3, 2, 2, 2, 700, 166, 3, 2,
+	2, 2, 701, 699, 3, 2, 2, 2, 702, 706, 7, 50, 2, 2, 703, 705, 5, 205, 103,
+	2, 704, 703, 3, 2, 2, 2, 705, 708, 3, 2, 2, 2, 706, 704, 3, 2, 2, 2, 706,
+	707, 3, 2, 2, 2, 707, 168, 3, 2, 2, 2, 708, 706, 3, 2, 2, 2, 709, 710,
+	7, 50, 2, 2, 710, 712, 9, 6, 2, 2, 711, 713, 5, 207, 104, 2, 712, 711,
+	3, 2, 2, 2, 713, 714, 3, 2, 2, 2, 714, 712, 3, 2, 2, 2, 714, 715, 3, 2,
+	2, 2, 715, 170, 3, 2, 2, 2, 716, 717, 5, 173, 87, 2, 717, 719, 7, 48, 2,
+	2, 718, 720, 5, 173, 87, 2, 719, 718, 3, 2, 2, 2, 719, 720, 3, 2, 2, 2,
+	720, 722, 3, 2, 2, 2, 721, 723, 5, 175, 88, 2, 722, 721, 3, 2, 2, 2, 722,
+	723, 3, 2, 2, 2, 723, 733, 3, 2, 2, 2, 724, 725, 5, 173, 87, 2, 725, 726,
+	5, 175, 88, 2, 726, 733, 3, 2, 2, 2, 727, 728, 7, 48, 2, 2, 728, 730, 5,
+	173, 87, 2, 729, 731, 5, 175, 88, 2, 730, 729, 3, 2, 2, 2, 730, 731, 3,
+	2, 2, 2, 731, 733, 3, 2, 2, 2, 732, 716, 3, 2, 2, 2, 732, 724, 3, 2, 2,
+	2, 732, 727, 3, 2, 2, 2, 733, 172, 3, 2, 2, 2, 734, 736, 5, 203, 102, 2,
+	735, 734, 3, 2, 2, 2, 736, 737, 3, 2, 2, 2, 737, 735, 3, 2, 2, 2, 737,
+	738, 3, 2, 2, 2, 738, 174, 3, 2, 2, 2, 739, 741, 9, 7, 2, 2, 740, 742,
+	9, 8, 2, 2, 741, 740, 3, 2, 2, 2, 741, 742, 3, 2, 2, 2, 742, 743, 3, 2,
+	2, 2, 743, 744, 5, 173, 87, 2, 744, 176, 3, 2, 2, 2, 745, 748, 5, 173,
+	87, 2, 746, 748, 5, 171, 86, 2, 747, 745, 3, 2, 2, 2, 747, 746, 3, 2, 2,
+	2, 748, 749, 3, 2, 2, 2, 749, 750, 7, 107, 2, 2, 750, 178, 3, 2, 2, 2,
+	751, 754, 7, 41, 2, 2, 752, 755, 5, 181, 91, 2, 753, 755, 5, 183, 92, 2,
+	754, 752, 3, 2, 2, 2, 754, 753, 3, 2, 2, 2, 755, 756, 3, 2, 2, 2, 756,
+	757, 7, 41, 2, 2, 757, 180, 3, 2, 2, 2, 758, 763, 5, 211, 106, 2, 759,
+	763, 5, 189, 95, 2, 760, 763, 5, 191, 96, 2, 761, 763, 5, 193, 97, 2, 762,
+	758, 3, 2, 2, 2, 762, 759, 3, 2, 2, 2, 762, 760, 3, 2, 2, 2, 762, 761,
+	3, 2, 2, 2, 763, 182, 3, 2, 2, 2, 764, 767, 5, 185, 93, 2, 765, 767, 5,
+	187, 94, 2, 766, 764, 3, 2, 2, 2, 766, 765, 3, 2, 2, 2, 767, 184, 3, 2,
+	2, 2, 768, 769, 7, 94, 2, 2, 769, 770, 5, 205, 103, 2, 770, 771, 5, 205,
+	103, 2, 771, 772, 5, 205, 103, 2, 772, 186, 3, 2, 2, 2, 773, 774, 7, 94,
+	2, 2, 774, 775, 7, 122, 2, 2, 775, 776, 5, 207, 104, 2, 776, 777, 5, 207,
+	104, 2, 777, 188, 3, 2, 2, 2, 778, 779, 7, 94, 2, 2, 779, 780, 7, 119,
+	2, 2, 780, 781, 3, 2, 2, 2, 781, 782, 5, 207, 104, 2, 782, 783, 5, 207,
+	104, 2, 783, 784, 5, 207, 104, 2, 784, 785, 5, 207, 104, 2, 785, 190, 3,
+	2, 2, 2, 786, 787, 7, 94, 2, 2, 787, 788, 7, 87, 2, 2, 788, 789, 3, 2,
+	2, 2, 789, 790, 5, 207, 104, 2, 790, 791, 5, 207, 104, 2, 791, 792, 5,
+	207, 104, 2, 792, 793, 5, 207, 104, 2, 793, 794, 5, 207, 104, 2, 794, 795,
+	5, 207, 104, 2, 795, 796, 5, 207, 104, 2, 796, 797, 5, 207, 104, 2, 797,
+	192, 3, 2, 2, 2, 798, 799, 7, 94, 2, 2, 799, 800, 9, 9, 2, 2, 800, 194,
+	3, 2, 2, 2, 801, 804, 5, 197, 99, 2, 802, 804, 5, 199, 100, 2, 803, 801,
+	3, 2, 2, 2, 803, 802, 3, 2, 2, 2, 804, 196, 3, 2, 2, 2, 805, 811, 7, 98,
+	2, 2, 806, 810, 5, 211, 106, 2, 807, 810, 5, 209, 105, 2, 808, 810, 9,
+	10, 2, 2, 809, 806, 3, 2, 2, 2, 809, 807, 3, 2, 2, 2, 809, 808, 3, 2, 2,
+	2, 810, 813, 3, 2, 2, 2, 811, 812, 3, 2, 2, 2, 811, 809, 3, 2, 2, 2, 812,
+	814, 3, 2, 2, 2, 813, 811, 3, 2, 2, 2, 814, 815, 7, 98, 2, 2, 815, 198,
+	3, 2, 2, 2, 816, 823, 7, 36, 2, 2, 817, 818, 7, 94, 2, 2, 818, 822, 7,
+	36, 2, 2, 819, 822, 5, 181, 91, 2, 820, 822, 5, 183, 92, 2, 821, 817, 3,
+	2, 2, 2, 821, 819, 3, 2, 2, 2, 821, 820, 3, 2, 2, 2, 822, 825, 3, 2, 2,
+	2, 823, 824, 3, 2, 2, 2, 823, 821, 3, 2, 2, 2, 824, 826, 3, 2, 2, 2, 825,
+	823, 3, 2, 2, 2, 826, 827, 7, 36, 2, 2, 827, 200, 3, 2, 2, 2, 828, 831,
+	7, 97, 2, 2, 829, 831, 5, 215, 108, 2, 830, 828, 3, 2, 2, 2, 830, 829,
+	3, 2, 2, 2, 831, 202, 3, 2, 2, 2, 832, 833, 9, 11, 2, 2, 833, 204, 3, 2,
+	2, 2, 834, 835, 9, 12, 2, 2, 835, 206, 3, 2, 2, 2, 836, 837, 9, 13, 2,
+	2, 837, 208, 3, 2, 2, 2, 838, 839, 9, 14, 2, 2, 839, 210, 3, 2, 2, 2, 840,
+	841, 10, 14, 2, 2, 841, 212, 3, 2, 2, 2, 842, 844, 9, 15, 2, 2, 843, 842,
+	3, 2, 2, 2, 844, 214, 3, 2, 2, 2, 845, 847, 9, 16, 2, 2, 846, 845, 3, 2,
+	2, 2, 847, 216, 3, 2, 2, 2, 848, 850, 9, 17, 2, 2, 849, 848, 3, 2, 2, 2,
+	850, 851, 3, 2, 2, 2, 851, 849, 3, 2, 2, 2, 851, 852, 3, 2, 2, 2, 852,
+	853, 3, 2, 2, 2, 853, 854, 8, 109, 2, 2, 854, 218, 3, 2, 2, 2, 855, 856,
+	7, 49, 2, 2, 856, 857, 7, 44, 2, 2, 857, 861, 3, 2, 2, 2, 858, 860, 11,
+	2, 2, 2, 859, 858, 3, 2, 2, 2, 860, 863, 3, 2, 2, 2, 861, 862, 3, 2, 2,
+	2, 861, 859, 3, 2, 2, 2, 862, 864, 3, 2, 2, 2, 863, 861, 3, 2, 2, 2, 864,
+	865, 7, 44, 2, 2, 865, 866, 7, 49, 2, 2, 866, 867, 3, 2, 2, 2, 867, 868,
+	8, 110, 2, 2, 868, 220, 3, 2, 2, 2, 869, 873, 7, 37, 2, 2, 870, 871, 7,
+	49, 2, 2, 871, 873, 7, 49, 2, 2, 872, 869, 3, 2, 2, 2, 872, 870, 3, 2,
+	2, 2, 873, 877, 3, 2, 2, 2, 874, 876, 10, 18, 2, 2, 875, 874, 3, 2, 2,
+	2, 876, 879, 3, 2, 2, 2, 877, 875, 3, 2, 2, 2, 877, 878, 3, 2, 2, 2, 878,
+	880, 3, 2, 2, 2, 879, 877, 3, 2, 2, 2, 880, 881, 8, 111, 3, 2, 881, 222,
+	3, 2, 2, 2, 882, 884, 9, 18, 2, 2, 883, 882, 3, 2, 2, 2, 884, 885, 3, 2,
+	2, 2, 885, 883, 3, 2, 2, 2, 885, 886, 3, 2, 2, 2, 886, 887, 3, 2, 2, 2,
+	887, 888, 8, 112, 2, 2, 888, 224, 3, 2, 2, 2, 889, 890, 11, 2, 2, 2, 890,
+	226, 3, 2, 2, 2, 39, 2, 501, 505, 508, 510, 647, 656, 668, 680, 685, 693,
+	699, 706, 714, 719, 722, 730, 732, 737, 741, 747, 754, 762, 766, 803, 809,
+	811, 821, 823, 830, 843, 846, 851, 861, 872, 877, 885, 4, 2, 3, 2, 8, 2,
 	2,
 }
 
@@ -470,25 +481,26 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "'package'", "'!'", "'import'", "'('", "')'", "'{'", "'}'", "':'",
-	"'.'", "'const'", "'='", "'_'", "','", "'type'", "'::'", "'*'", "'var'",
-	"'<-'", "'++'", "'--'", "'+'", "'-'", "'|'", "'^'", "'/'", "'%'", "'<<'",
-	"'>>'", "'&'", "'&^'", "':='", "';'", "'~'", "'return'", "'break'", "'continue'",
-	"'goto'", "'fallthrough'", "'defer'", "'if'", "'=>'", "'else'", "'switch'",
-	"'select'", "'for'", "'in'", "'go'", "'['", "']'", "'interface'", "'map'",
-	"'chan'", "'fn'", "'<'", "'>'", "'...'", "'true'", "'false'", "'nil'",
-	"'@'", "'struct'", "'class'", "'||'", "'&&'", "'=='", "'!='", "'<='", "'>='",
-	"", "", "", "'->'",
+	"", "'package'", "'!'", "'import'", "'('", "')'", "'{'", "'}'", "'.'",
+	"'const'", "'='", "'enum'", "','", "'type'", "'::'", "'*'", "'var'", "'<-'",
+	"'++'", "'--'", "'+'", "'-'", "'|'", "'^'", "'/'", "'%'", "'<<'", "'>>'",
+	"'&'", "'&^'", "':='", "'~'", "'return'", "'break'", "'continue'", "'goto'",
+	"'fallthrough'", "'defer'", "'else'", "'switch'", "'match'", "'as'", "'select'",
+	"'in'", "'go'", "'['", "']'", "'interface'", "'map'", "'chan'", "'fn'",
+	"'<'", "'>'", "'...'", "'true'", "'false'", "'nil'", "'@'", "'class'",
+	"'||'", "'&&'", "'=='", "'!='", "'<='", "'>='", "';'", "':'", "'_'", "'=>'",
+	"'?'", "'if'", "'for'", "'struct'", "", "", "", "'->'",
 }
 
 var lexerSymbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "IDENTIFIER",
-	"KEYWORD", "BINARY_OP", "FUNC", "INT_LIT", "FLOAT_LIT", "IMAGINARY_LIT",
-	"RUNE_LIT", "LITTLE_U_VALUE", "BIG_U_VALUE", "STRING_LIT", "WS", "COMMENT",
-	"LINE_COMMENT", "TERMINATOR", "ErrorChar",
+	"", "", "", "", "", "", "", "", "", "", "", "SEMI", "COLON", "BLANK", "ARROW",
+	"QUESTION", "IF", "FOR", "STRUCT", "IDENTIFIER", "KEYWORD", "BINARY_OP",
+	"FUNC", "INT_LIT", "FLOAT_LIT", "IMAGINARY_LIT", "RUNE_LIT", "LITTLE_U_VALUE",
+	"BIG_U_VALUE", "STRING_LIT", "WS", "COMMENT", "LINE_COMMENT", "TERMINATOR",
+	"ErrorChar",
 }
 
 var lexerRuleNames = []string{
@@ -499,12 +511,13 @@ var lexerRuleNames = []string{
 	"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
 	"T__41", "T__42", "T__43", "T__44", "T__45", "T__46", "T__47", "T__48",
 	"T__49", "T__50", "T__51", "T__52", "T__53", "T__54", "T__55", "T__56",
-	"T__57", "T__58", "T__59", "T__60", "T__61", "T__62", "T__63", "T__64",
-	"T__65", "T__66", "T__67", "IDENTIFIER", "KEYWORD", "BINARY_OP", "REL_OP",
-	"ADD_OP", "MUL_OP", "UNARY_OP", "FUNC", "INT_LIT", "DECIMAL_LIT", "OCTAL_LIT",
-	"HEX_LIT", "FLOAT_LIT", "DECIMALS", "EXPONENT", "IMAGINARY_LIT", "RUNE_LIT",
-	"UNICODE_VALUE", "BYTE_VALUE", "OCTAL_BYTE_VALUE", "HEX_BYTE_VALUE", "LITTLE_U_VALUE",
-	"BIG_U_VALUE", "ESCAPED_CHAR", "STRING_LIT", "RAW_STRING_LIT", "INTERPRETED_STRING_LIT",
+	"T__57", "T__58", "T__59", "T__60", "T__61", "T__62", "T__63", "SEMI",
+	"COLON", "BLANK", "ARROW", "QUESTION", "IF", "FOR", "STRUCT", "IDENTIFIER",
+	"KEYWORD", "BINARY_OP", "REL_OP", "ADD_OP", "MUL_OP", "UNARY_OP", "FUNC",
+	"INT_LIT", "DECIMAL_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT", "DECIMALS",
+	"EXPONENT", "IMAGINARY_LIT", "RUNE_LIT", "UNICODE_VALUE", "BYTE_VALUE",
+	"OCTAL_BYTE_VALUE", "HEX_BYTE_VALUE", "LITTLE_U_VALUE", "BIG_U_VALUE",
+	"ESCAPED_CHAR", "STRING_LIT", "RAW_STRING_LIT", "INTERPRETED_STRING_LIT",
 	"LETTER", "DECIMAL_DIGIT", "OCTAL_DIGIT", "HEX_DIGIT", "NEWLINE", "UNICODE_CHAR",
 	"UNICODE_DIGIT", "UNICODE_LETTER", "WS", "COMMENT", "LINE_COMMENT", "TERMINATOR",
 	"ErrorChar",
@@ -609,26 +622,30 @@ const (
 	OgLexerT__61          = 62
 	OgLexerT__62          = 63
 	OgLexerT__63          = 64
-	OgLexerT__64          = 65
-	OgLexerT__65          = 66
-	OgLexerT__66          = 67
-	OgLexerT__67          = 68
-	OgLexerIDENTIFIER     = 69
-	OgLexerKEYWORD        = 70
-	OgLexerBINARY_OP      = 71
-	OgLexerFUNC           = 72
-	OgLexerINT_LIT        = 73
-	OgLexerFLOAT_LIT      = 74
-	OgLexerIMAGINARY_LIT  = 75
-	OgLexerRUNE_LIT       = 76
-	OgLexerLITTLE_U_VALUE = 77
-	OgLexerBIG_U_VALUE    = 78
-	OgLexerSTRING_LIT     = 79
-	OgLexerWS             = 80
-	OgLexerCOMMENT        = 81
-	OgLexerLINE_COMMENT   = 82
-	OgLexerTERMINATOR     = 83
-	OgLexerErrorChar      = 84
+	OgLexerSEMI           = 65
+	OgLexerCOLON          = 66
+	OgLexerBLANK          = 67
+	OgLexerARROW          = 68
+	OgLexerQUESTION       = 69
+	OgLexerIF             = 70
+	OgLexerFOR            = 71
+	OgLexerSTRUCT         = 72
+	OgLexerIDENTIFIER     = 73
+	OgLexerKEYWORD        = 74
+	OgLexerBINARY_OP      = 75
+	OgLexerFUNC           = 76
+	OgLexerINT_LIT        = 77
+	OgLexerFLOAT_LIT      = 78
+	OgLexerIMAGINARY_LIT  = 79
+	OgLexerRUNE_LIT       = 80
+	OgLexerLITTLE_U_VALUE = 81
+	OgLexerBIG_U_VALUE    = 82
+	OgLexerSTRING_LIT     = 83
+	OgLexerWS             = 84
+	OgLexerCOMMENT        = 85
+	OgLexerLINE_COMMENT   = 86
+	OgLexerTERMINATOR     = 87
+	OgLexerErrorChar      = 88
 )
 
 // The most recently produced token.
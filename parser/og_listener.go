@@ -41,6 +41,12 @@ type OgListener interface {
 	// EnterConstSpec is called when entering the constSpec production.
 	EnterConstSpec(c *ConstSpecContext)
 
+	// EnterEnumDecl is called when entering the enumDecl production.
+	EnterEnumDecl(c *EnumDeclContext)
+
+	// EnterEnumSpec is called when entering the enumSpec production.
+	EnterEnumSpec(c *EnumSpecContext)
+
 	// EnterIdentifierList is called when entering the identifierList production.
 	EnterIdentifierList(c *IdentifierListContext)
 
@@ -50,6 +56,12 @@ type OgListener interface {
 	// EnterTypeDecl is called when entering the typeDecl production.
 	EnterTypeDecl(c *TypeDeclContext)
 
+	// EnterDataDecl is called when entering the dataDecl production.
+	EnterDataDecl(c *DataDeclContext)
+
+	// EnterVariant is called when entering the variant production.
+	EnterVariant(c *VariantContext)
+
 	// EnterTypeSpec is called when entering the typeSpec production.
 	EnterTypeSpec(c *TypeSpecContext)
 
@@ -137,6 +149,15 @@ type OgListener interface {
 	// EnterExprSwitchCase is called when entering the exprSwitchCase production.
 	EnterExprSwitchCase(c *ExprSwitchCaseContext)
 
+	// EnterMatchStmt is called when entering the matchStmt production.
+	EnterMatchStmt(c *MatchStmtContext)
+
+	// EnterMatchArm is called when entering the matchArm production.
+	EnterMatchArm(c *MatchArmContext)
+
+	// EnterPattern is called when entering the pattern production.
+	EnterPattern(c *PatternContext)
+
 	// EnterTypeSwitchStmt is called when entering the typeSwitchStmt production.
 	EnterTypeSwitchStmt(c *TypeSwitchStmtContext)
 
@@ -224,6 +245,15 @@ type OgListener interface {
 	// EnterTemplateSpec is called when entering the templateSpec production.
 	EnterTemplateSpec(c *TemplateSpecContext)
 
+	// EnterTemplateParam is called when entering the templateParam production.
+	EnterTemplateParam(c *TemplateParamContext)
+
+	// EnterConstraint is called when entering the constraint production.
+	EnterConstraint(c *ConstraintContext)
+
+	// EnterConstraintTerm is called when entering the constraintTerm production.
+	EnterConstraintTerm(c *ConstraintTermContext)
+
 	// EnterResult is called when entering the result production.
 	EnterResult(c *ResultContext)
 
@@ -365,6 +395,12 @@ type OgListener interface {
 	// ExitConstSpec is called when exiting the constSpec production.
 	ExitConstSpec(c *ConstSpecContext)
 
+	// ExitEnumDecl is called when exiting the enumDecl production.
+	ExitEnumDecl(c *EnumDeclContext)
+
+	// ExitEnumSpec is called when exiting the enumSpec production.
+	ExitEnumSpec(c *EnumSpecContext)
+
 	// ExitIdentifierList is called when exiting the identifierList production.
 	ExitIdentifierList(c *IdentifierListContext)
 
@@ -374,6 +410,12 @@ type OgListener interface {
 	// ExitTypeDecl is called when exiting the typeDecl production.
 	ExitTypeDecl(c *TypeDeclContext)
 
+	// ExitDataDecl is called when exiting the dataDecl production.
+	ExitDataDecl(c *DataDeclContext)
+
+	// ExitVariant is called when exiting the variant production.
+	ExitVariant(c *VariantContext)
+
 	// ExitTypeSpec is called when exiting the typeSpec production.
 	ExitTypeSpec(c *TypeSpecContext)
 
@@ -461,6 +503,15 @@ type OgListener interface {
 	// ExitExprSwitchCase is called when exiting the exprSwitchCase production.
 	ExitExprSwitchCase(c *ExprSwitchCaseContext)
 
+	// ExitMatchStmt is called when exiting the matchStmt production.
+	ExitMatchStmt(c *MatchStmtContext)
+
+	// ExitMatchArm is called when exiting the matchArm production.
+	ExitMatchArm(c *MatchArmContext)
+
+	// ExitPattern is called when exiting the pattern production.
+	ExitPattern(c *PatternContext)
+
 	// ExitTypeSwitchStmt is called when exiting the typeSwitchStmt production.
 	ExitTypeSwitchStmt(c *TypeSwitchStmtContext)
 
@@ -548,6 +599,15 @@ type OgListener interface {
 	// ExitTemplateSpec is called when exiting the templateSpec production.
 	ExitTemplateSpec(c *TemplateSpecContext)
 
+	// ExitTemplateParam is called when exiting the templateParam production.
+	ExitTemplateParam(c *TemplateParamContext)
+
+	// ExitConstraint is called when exiting the constraint production.
+	ExitConstraint(c *ConstraintContext)
+
+	// ExitConstraintTerm is called when exiting the constraintTerm production.
+	ExitConstraintTerm(c *ConstraintTermContext)
+
 	// ExitResult is called when exiting the result production.
 	ExitResult(c *ResultContext)
 
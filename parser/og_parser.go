@@ -17,7 +17,7 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 86, 1032,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 92, 1167,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
@@ -38,497 +38,571 @@ var parserATN = []uint16{
 	92, 9, 92, 4, 93, 9, 93, 4, 94, 9, 94, 4, 95, 9, 95, 4, 96, 9, 96, 4, 97,
 	9, 97, 4, 98, 9, 98, 4, 99, 9, 99, 4, 100, 9, 100, 4, 101, 9, 101, 4, 102,
 	9, 102, 4, 103, 9, 103, 4, 104, 9, 104, 4, 105, 9, 105, 4, 106, 9, 106,
-	4, 107, 9, 107, 4, 108, 9, 108, 4, 109, 9, 109, 3, 2, 3, 2, 3, 2, 3, 2,
-	3, 2, 7, 2, 224, 10, 2, 12, 2, 14, 2, 227, 11, 2, 3, 2, 3, 2, 3, 2, 7,
-	2, 232, 10, 2, 12, 2, 14, 2, 235, 11, 2, 3, 2, 3, 2, 3, 3, 3, 3, 5, 3,
-	241, 10, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
-	3, 5, 5, 5, 254, 10, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 7, 6, 261, 10, 6,
-	12, 6, 14, 6, 264, 11, 6, 3, 6, 5, 6, 267, 10, 6, 3, 7, 3, 7, 3, 7, 5,
-	7, 272, 10, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 5, 9, 279, 10, 9, 3, 10, 3,
-	10, 3, 10, 5, 10, 284, 10, 10, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11,
-	7, 11, 292, 10, 11, 12, 11, 14, 11, 295, 11, 11, 3, 11, 5, 11, 298, 10,
-	11, 3, 12, 3, 12, 5, 12, 302, 10, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13,
-	3, 13, 7, 13, 310, 10, 13, 12, 13, 14, 13, 313, 11, 13, 3, 14, 3, 14, 3,
-	14, 7, 14, 318, 10, 14, 12, 14, 14, 14, 321, 11, 14, 3, 15, 3, 15, 3, 15,
-	3, 15, 3, 15, 3, 15, 7, 15, 329, 10, 15, 12, 15, 14, 15, 332, 11, 15, 3,
-	15, 5, 15, 335, 10, 15, 3, 15, 3, 15, 5, 15, 339, 10, 15, 3, 16, 3, 16,
-	3, 16, 3, 17, 3, 17, 3, 17, 5, 17, 347, 10, 17, 3, 18, 3, 18, 3, 18, 3,
-	18, 5, 18, 353, 10, 18, 3, 19, 3, 19, 3, 19, 5, 19, 358, 10, 19, 3, 20,
-	3, 20, 3, 20, 5, 20, 363, 10, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3,
-	21, 3, 21, 3, 21, 7, 21, 373, 10, 21, 12, 21, 14, 21, 376, 11, 21, 3, 21,
-	5, 21, 379, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 385, 10, 22, 3,
-	22, 3, 22, 5, 22, 389, 10, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24,
-	3, 24, 7, 24, 398, 10, 24, 12, 24, 14, 24, 401, 11, 24, 3, 25, 3, 25, 3,
-	25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25,
-	3, 25, 3, 25, 5, 25, 418, 10, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3,
-	26, 5, 26, 426, 10, 26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28,
-	3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 5, 30, 440, 10, 30, 3, 30, 3, 30, 3,
-	31, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33,
-	3, 34, 3, 34, 5, 34, 457, 10, 34, 3, 35, 3, 35, 5, 35, 461, 10, 35, 3,
-	36, 3, 36, 5, 36, 465, 10, 36, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 39,
-	3, 39, 3, 39, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 479, 10, 40, 3, 40, 3,
-	40, 3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 488, 10, 40, 3, 40, 3, 40,
-	3, 40, 3, 40, 3, 40, 3, 40, 3, 40, 5, 40, 497, 10, 40, 5, 40, 499, 10,
-	40, 5, 40, 501, 10, 40, 3, 41, 3, 41, 5, 41, 505, 10, 41, 3, 42, 3, 42,
-	3, 42, 3, 42, 5, 42, 511, 10, 42, 3, 42, 5, 42, 514, 10, 42, 3, 42, 3,
-	42, 7, 42, 518, 10, 42, 12, 42, 14, 42, 521, 11, 42, 3, 42, 3, 42, 3, 43,
-	3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 5, 44, 531, 10, 44, 3, 45, 3, 45, 3,
-	45, 3, 45, 5, 45, 537, 10, 45, 3, 45, 3, 45, 3, 45, 7, 45, 542, 10, 45,
-	12, 45, 14, 45, 545, 11, 45, 3, 45, 3, 45, 3, 46, 3, 46, 5, 46, 551, 10,
-	46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47,
-	3, 48, 3, 48, 5, 48, 565, 10, 48, 3, 49, 3, 49, 3, 49, 7, 49, 570, 10,
-	49, 12, 49, 14, 49, 573, 11, 49, 3, 50, 3, 50, 3, 50, 7, 50, 578, 10, 50,
-	12, 50, 14, 50, 581, 11, 50, 3, 50, 3, 50, 3, 51, 3, 51, 3, 51, 3, 51,
-	5, 51, 589, 10, 51, 3, 52, 3, 52, 5, 52, 593, 10, 52, 3, 52, 5, 52, 596,
-	10, 52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 5, 53, 604, 10, 53, 3,
-	53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 5, 54, 612, 10, 54, 3, 54, 3, 54,
-	3, 54, 3, 55, 5, 55, 618, 10, 55, 3, 55, 3, 55, 5, 55, 622, 10, 55, 3,
-	55, 3, 55, 5, 55, 626, 10, 55, 3, 56, 3, 56, 5, 56, 630, 10, 56, 3, 56,
-	3, 56, 3, 56, 3, 57, 3, 57, 3, 57, 5, 57, 638, 10, 57, 3, 58, 3, 58, 3,
-	58, 3, 58, 3, 58, 3, 58, 5, 58, 646, 10, 58, 3, 59, 3, 59, 5, 59, 650,
-	10, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 5, 60,
-	660, 10, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 63, 3,
-	63, 3, 64, 3, 64, 3, 64, 3, 65, 3, 65, 5, 65, 676, 10, 65, 3, 65, 3, 65,
-	3, 65, 3, 65, 7, 65, 682, 10, 65, 12, 65, 14, 65, 685, 11, 65, 3, 65, 5,
-	65, 688, 10, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 67, 3, 67, 3, 67, 3, 67,
-	3, 67, 3, 67, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3, 69, 3, 69, 3, 69, 5,
-	69, 708, 10, 69, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70, 3, 70,
-	3, 70, 5, 70, 719, 10, 70, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 5, 72, 726,
-	10, 72, 3, 72, 3, 72, 3, 72, 3, 72, 3, 72, 5, 72, 733, 10, 72, 3, 72, 5,
-	72, 736, 10, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 7, 74,
-	745, 10, 74, 12, 74, 14, 74, 748, 11, 74, 3, 75, 3, 75, 3, 75, 5, 75, 753,
-	10, 75, 5, 75, 755, 10, 75, 3, 75, 5, 75, 758, 10, 75, 3, 76, 3, 76, 3,
-	76, 7, 76, 763, 10, 76, 12, 76, 14, 76, 766, 11, 76, 3, 77, 5, 77, 769,
-	10, 77, 3, 77, 5, 77, 772, 10, 77, 3, 77, 3, 77, 3, 78, 3, 78, 3, 79, 3,
-	79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 5, 79, 785, 10, 79, 3, 80, 3, 80,
-	3, 80, 5, 80, 790, 10, 80, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3,
-	81, 5, 81, 799, 10, 81, 3, 82, 3, 82, 3, 82, 5, 82, 804, 10, 82, 3, 83,
-	3, 83, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 3, 84, 5, 84, 814, 10, 84, 3,
-	85, 3, 85, 5, 85, 818, 10, 85, 3, 85, 3, 85, 3, 86, 3, 86, 3, 86, 3, 86,
-	3, 86, 3, 86, 3, 86, 3, 86, 3, 86, 5, 86, 831, 10, 86, 3, 87, 3, 87, 3,
-	87, 5, 87, 836, 10, 87, 5, 87, 838, 10, 87, 3, 87, 3, 87, 3, 88, 3, 88,
-	5, 88, 844, 10, 88, 3, 88, 7, 88, 847, 10, 88, 12, 88, 14, 88, 850, 11,
-	88, 3, 89, 3, 89, 3, 89, 5, 89, 855, 10, 89, 3, 89, 3, 89, 3, 90, 3, 90,
-	3, 90, 5, 90, 862, 10, 90, 3, 91, 3, 91, 5, 91, 866, 10, 91, 3, 92, 3,
-	92, 5, 92, 870, 10, 92, 3, 92, 5, 92, 873, 10, 92, 3, 92, 3, 92, 3, 92,
-	3, 92, 7, 92, 879, 10, 92, 12, 92, 14, 92, 882, 11, 92, 3, 92, 5, 92, 885,
-	10, 92, 3, 93, 3, 93, 3, 93, 3, 93, 3, 93, 5, 93, 892, 10, 93, 3, 93, 5,
-	93, 895, 10, 93, 3, 93, 5, 93, 898, 10, 93, 3, 94, 5, 94, 901, 10, 94,
-	3, 94, 3, 94, 3, 95, 5, 95, 906, 10, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3,
-	96, 3, 97, 3, 97, 3, 97, 5, 97, 916, 10, 97, 3, 97, 3, 97, 7, 97, 920,
-	10, 97, 12, 97, 14, 97, 923, 11, 97, 3, 98, 3, 98, 3, 98, 3, 98, 3, 98,
-	5, 98, 930, 10, 98, 3, 99, 3, 99, 3, 99, 3, 100, 3, 100, 3, 100, 3, 100,
-	3, 101, 3, 101, 5, 101, 941, 10, 101, 3, 101, 3, 101, 5, 101, 945, 10,
-	101, 3, 101, 5, 101, 948, 10, 101, 3, 101, 3, 101, 3, 101, 3, 101, 3, 101,
-	5, 101, 955, 10, 101, 3, 101, 3, 101, 3, 102, 3, 102, 3, 102, 3, 102, 3,
-	102, 3, 103, 5, 103, 965, 10, 103, 3, 103, 3, 103, 3, 103, 3, 103, 3, 103,
-	5, 103, 972, 10, 103, 5, 103, 974, 10, 103, 3, 103, 5, 103, 977, 10, 103,
-	3, 103, 5, 103, 980, 10, 103, 5, 103, 982, 10, 103, 3, 103, 3, 103, 3,
-	104, 3, 104, 3, 104, 3, 104, 3, 105, 3, 105, 3, 105, 3, 105, 3, 105, 3,
-	105, 3, 105, 3, 105, 3, 105, 3, 105, 5, 105, 1000, 10, 105, 3, 106, 3,
-	106, 3, 106, 3, 106, 3, 106, 3, 106, 7, 106, 1008, 10, 106, 12, 106, 14,
-	106, 1011, 11, 106, 3, 107, 3, 107, 3, 107, 5, 107, 1016, 10, 107, 3, 108,
-	3, 108, 3, 108, 3, 108, 5, 108, 1022, 10, 108, 3, 108, 3, 108, 3, 109,
-	3, 109, 3, 109, 3, 109, 5, 109, 1030, 10, 109, 3, 109, 2, 4, 192, 210,
-	110, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36,
-	38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72,
-	74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106,
-	108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136,
-	138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166,
-	168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 196,
-	198, 200, 202, 204, 206, 208, 210, 212, 214, 216, 2, 12, 3, 2, 3, 4, 4,
-	2, 11, 11, 71, 71, 4, 2, 71, 71, 81, 81, 4, 2, 14, 14, 71, 71, 3, 2, 21,
-	22, 4, 2, 18, 18, 23, 32, 3, 2, 59, 60, 3, 2, 63, 64, 6, 2, 18, 18, 23,
-	32, 56, 57, 65, 70, 8, 2, 4, 4, 18, 18, 20, 20, 23, 24, 26, 26, 31, 31,
-	2, 1092, 2, 218, 3, 2, 2, 2, 4, 240, 3, 2, 2, 2, 6, 244, 3, 2, 2, 2, 8,
-	247, 3, 2, 2, 2, 10, 266, 3, 2, 2, 2, 12, 268, 3, 2, 2, 2, 14, 273, 3,
-	2, 2, 2, 16, 278, 3, 2, 2, 2, 18, 283, 3, 2, 2, 2, 20, 285, 3, 2, 2, 2,
-	22, 299, 3, 2, 2, 2, 24, 306, 3, 2, 2, 2, 26, 314, 3, 2, 2, 2, 28, 338,
-	3, 2, 2, 2, 30, 340, 3, 2, 2, 2, 32, 343, 3, 2, 2, 2, 34, 348, 3, 2, 2,
-	2, 36, 354, 3, 2, 2, 2, 38, 359, 3, 2, 2, 2, 40, 366, 3, 2, 2, 2, 42, 380,
-	3, 2, 2, 2, 44, 390, 3, 2, 2, 2, 46, 399, 3, 2, 2, 2, 48, 417, 3, 2, 2,
-	2, 50, 425, 3, 2, 2, 2, 52, 427, 3, 2, 2, 2, 54, 431, 3, 2, 2, 2, 56, 434,
-	3, 2, 2, 2, 58, 439, 3, 2, 2, 2, 60, 443, 3, 2, 2, 2, 62, 447, 3, 2, 2,
-	2, 64, 449, 3, 2, 2, 2, 66, 454, 3, 2, 2, 2, 68, 458, 3, 2, 2, 2, 70, 462,
-	3, 2, 2, 2, 72, 466, 3, 2, 2, 2, 74, 469, 3, 2, 2, 2, 76, 471, 3, 2, 2,
-	2, 78, 474, 3, 2, 2, 2, 80, 504, 3, 2, 2, 2, 82, 506, 3, 2, 2, 2, 84, 524,
-	3, 2, 2, 2, 86, 530, 3, 2, 2, 2, 88, 532, 3, 2, 2, 2, 90, 550, 3, 2, 2,
-	2, 92, 558, 3, 2, 2, 2, 94, 564, 3, 2, 2, 2, 96, 566, 3, 2, 2, 2, 98, 574,
-	3, 2, 2, 2, 100, 584, 3, 2, 2, 2, 102, 595, 3, 2, 2, 2, 104, 603, 3, 2,
-	2, 2, 106, 607, 3, 2, 2, 2, 108, 617, 3, 2, 2, 2, 110, 629, 3, 2, 2, 2,
-	112, 634, 3, 2, 2, 2, 114, 645, 3, 2, 2, 2, 116, 649, 3, 2, 2, 2, 118,
-	659, 3, 2, 2, 2, 120, 661, 3, 2, 2, 2, 122, 666, 3, 2, 2, 2, 124, 668,
-	3, 2, 2, 2, 126, 670, 3, 2, 2, 2, 128, 673, 3, 2, 2, 2, 130, 689, 3, 2,
-	2, 2, 132, 693, 3, 2, 2, 2, 134, 699, 3, 2, 2, 2, 136, 707, 3, 2, 2, 2,
-	138, 718, 3, 2, 2, 2, 140, 720, 3, 2, 2, 2, 142, 735, 3, 2, 2, 2, 144,
-	737, 3, 2, 2, 2, 146, 741, 3, 2, 2, 2, 148, 757, 3, 2, 2, 2, 150, 759,
-	3, 2, 2, 2, 152, 768, 3, 2, 2, 2, 154, 775, 3, 2, 2, 2, 156, 784, 3, 2,
-	2, 2, 158, 789, 3, 2, 2, 2, 160, 798, 3, 2, 2, 2, 162, 803, 3, 2, 2, 2,
-	164, 805, 3, 2, 2, 2, 166, 813, 3, 2, 2, 2, 168, 815, 3, 2, 2, 2, 170,
-	830, 3, 2, 2, 2, 172, 832, 3, 2, 2, 2, 174, 841, 3, 2, 2, 2, 176, 854,
-	3, 2, 2, 2, 178, 861, 3, 2, 2, 2, 180, 865, 3, 2, 2, 2, 182, 867, 3, 2,
-	2, 2, 184, 897, 3, 2, 2, 2, 186, 900, 3, 2, 2, 2, 188, 905, 3, 2, 2, 2,
-	190, 909, 3, 2, 2, 2, 192, 915, 3, 2, 2, 2, 194, 929, 3, 2, 2, 2, 196,
-	931, 3, 2, 2, 2, 198, 934, 3, 2, 2, 2, 200, 938, 3, 2, 2, 2, 202, 958,
-	3, 2, 2, 2, 204, 964, 3, 2, 2, 2, 206, 985, 3, 2, 2, 2, 208, 999, 3, 2,
-	2, 2, 210, 1001, 3, 2, 2, 2, 212, 1015, 3, 2, 2, 2, 214, 1017, 3, 2, 2,
-	2, 216, 1029, 3, 2, 2, 2, 218, 219, 5, 6, 4, 2, 219, 225, 5, 216, 109,
-	2, 220, 221, 5, 8, 5, 2, 221, 222, 5, 216, 109, 2, 222, 224, 3, 2, 2, 2,
-	223, 220, 3, 2, 2, 2, 224, 227, 3, 2, 2, 2, 225, 223, 3, 2, 2, 2, 225,
-	226, 3, 2, 2, 2, 226, 233, 3, 2, 2, 2, 227, 225, 3, 2, 2, 2, 228, 229,
-	5, 16, 9, 2, 229, 230, 5, 216, 109, 2, 230, 232, 3, 2, 2, 2, 231, 228,
-	3, 2, 2, 2, 232, 235, 3, 2, 2, 2, 233, 231, 3, 2, 2, 2, 233, 234, 3, 2,
-	2, 2, 234, 236, 3, 2, 2, 2, 235, 233, 3, 2, 2, 2, 236, 237, 7, 2, 2, 3,
-	237, 3, 3, 2, 2, 2, 238, 241, 5, 16, 9, 2, 239, 241, 5, 48, 25, 2, 240,
-	238, 3, 2, 2, 2, 240, 239, 3, 2, 2, 2, 241, 242, 3, 2, 2, 2, 242, 243,
-	7, 2, 2, 3, 243, 5, 3, 2, 2, 2, 244, 245, 9, 2, 2, 2, 245, 246, 7, 71,
-	2, 2, 246, 7, 3, 2, 2, 2, 247, 253, 7, 5, 2, 2, 248, 254, 5, 10, 6, 2,
-	249, 250, 7, 6, 2, 2, 250, 251, 5, 10, 6, 2, 251, 252, 7, 7, 2, 2, 252,
-	254, 3, 2, 2, 2, 253, 248, 3, 2, 2, 2, 253, 249, 3, 2, 2, 2, 254, 9, 3,
-	2, 2, 2, 255, 267, 5, 12, 7, 2, 256, 262, 7, 8, 2, 2, 257, 258, 5, 12,
-	7, 2, 258, 259, 5, 216, 109, 2, 259, 261, 3, 2, 2, 2, 260, 257, 3, 2, 2,
-	2, 261, 264, 3, 2, 2, 2, 262, 260, 3, 2, 2, 2, 262, 263, 3, 2, 2, 2, 263,
-	265, 3, 2, 2, 2, 264, 262, 3, 2, 2, 2, 265, 267, 7, 9, 2, 2, 266, 255,
-	3, 2, 2, 2, 266, 256, 3, 2, 2, 2, 267, 11, 3, 2, 2, 2, 268, 271, 5, 14,
-	8, 2, 269, 270, 7, 10, 2, 2, 270, 272, 9, 3, 2, 2, 271, 269, 3, 2, 2, 2,
-	271, 272, 3, 2, 2, 2, 272, 13, 3, 2, 2, 2, 273, 274, 9, 4, 2, 2, 274, 15,
-	3, 2, 2, 2, 275, 279, 5, 18, 10, 2, 276, 279, 5, 32, 17, 2, 277, 279, 5,
-	36, 19, 2, 278, 275, 3, 2, 2, 2, 278, 276, 3, 2, 2, 2, 278, 277, 3, 2,
-	2, 2, 279, 17, 3, 2, 2, 2, 280, 284, 5, 20, 11, 2, 281, 284, 5, 28, 15,
-	2, 282, 284, 5, 40, 21, 2, 283, 280, 3, 2, 2, 2, 283, 281, 3, 2, 2, 2,
-	283, 282, 3, 2, 2, 2, 284, 19, 3, 2, 2, 2, 285, 297, 7, 12, 2, 2, 286,
-	298, 5, 22, 12, 2, 287, 293, 7, 6, 2, 2, 288, 289, 5, 22, 12, 2, 289, 290,
-	5, 216, 109, 2, 290, 292, 3, 2, 2, 2, 291, 288, 3, 2, 2, 2, 292, 295, 3,
-	2, 2, 2, 293, 291, 3, 2, 2, 2, 293, 294, 3, 2, 2, 2, 294, 296, 3, 2, 2,
-	2, 295, 293, 3, 2, 2, 2, 296, 298, 7, 7, 2, 2, 297, 286, 3, 2, 2, 2, 297,
-	287, 3, 2, 2, 2, 298, 21, 3, 2, 2, 2, 299, 301, 5, 24, 13, 2, 300, 302,
-	5, 114, 58, 2, 301, 300, 3, 2, 2, 2, 301, 302, 3, 2, 2, 2, 302, 303, 3,
-	2, 2, 2, 303, 304, 7, 13, 2, 2, 304, 305, 5, 26, 14, 2, 305, 23, 3, 2,
-	2, 2, 306, 311, 9, 5, 2, 2, 307, 308, 7, 15, 2, 2, 308, 310, 9, 5, 2, 2,
-	309, 307, 3, 2, 2, 2, 310, 313, 3, 2, 2, 2, 311, 309, 3, 2, 2, 2, 311,
-	312, 3, 2, 2, 2, 312, 25, 3, 2, 2, 2, 313, 311, 3, 2, 2, 2, 314, 319, 5,
-	210, 106, 2, 315, 316, 7, 15, 2, 2, 316, 318, 5, 210, 106, 2, 317, 315,
-	3, 2, 2, 2, 318, 321, 3, 2, 2, 2, 319, 317, 3, 2, 2, 2, 319, 320, 3, 2,
-	2, 2, 320, 27, 3, 2, 2, 2, 321, 319, 3, 2, 2, 2, 322, 334, 7, 16, 2, 2,
-	323, 335, 5, 30, 16, 2, 324, 330, 7, 6, 2, 2, 325, 326, 5, 30, 16, 2, 326,
-	327, 5, 216, 109, 2, 327, 329, 3, 2, 2, 2, 328, 325, 3, 2, 2, 2, 329, 332,
-	3, 2, 2, 2, 330, 328, 3, 2, 2, 2, 330, 331, 3, 2, 2, 2, 331, 333, 3, 2,
-	2, 2, 332, 330, 3, 2, 2, 2, 333, 335, 7, 7, 2, 2, 334, 323, 3, 2, 2, 2,
-	334, 324, 3, 2, 2, 2, 335, 339, 3, 2, 2, 2, 336, 339, 5, 182, 92, 2, 337,
-	339, 5, 128, 65, 2, 338, 322, 3, 2, 2, 2, 338, 336, 3, 2, 2, 2, 338, 337,
-	3, 2, 2, 2, 339, 29, 3, 2, 2, 2, 340, 341, 7, 71, 2, 2, 341, 342, 5, 114,
-	58, 2, 342, 31, 3, 2, 2, 2, 343, 346, 7, 71, 2, 2, 344, 347, 5, 34, 18,
-	2, 345, 347, 5, 142, 72, 2, 346, 344, 3, 2, 2, 2, 346, 345, 3, 2, 2, 2,
-	347, 33, 3, 2, 2, 2, 348, 349, 5, 142, 72, 2, 349, 352, 7, 74, 2, 2, 350,
-	353, 5, 44, 23, 2, 351, 353, 5, 48, 25, 2, 352, 350, 3, 2, 2, 2, 352, 351,
-	3, 2, 2, 2, 353, 35, 3, 2, 2, 2, 354, 357, 5, 38, 20, 2, 355, 358, 5, 34,
-	18, 2, 356, 358, 5, 142, 72, 2, 357, 355, 3, 2, 2, 2, 357, 356, 3, 2, 2,
-	2, 358, 37, 3, 2, 2, 2, 359, 360, 7, 71, 2, 2, 360, 362, 7, 17, 2, 2, 361,
-	363, 7, 18, 2, 2, 362, 361, 3, 2, 2, 2, 362, 363, 3, 2, 2, 2, 363, 364,
-	3, 2, 2, 2, 364, 365, 7, 71, 2, 2, 365, 39, 3, 2, 2, 2, 366, 378, 7, 19,
-	2, 2, 367, 379, 5, 42, 22, 2, 368, 374, 7, 6, 2, 2, 369, 370, 5, 42, 22,
-	2, 370, 371, 5, 216, 109, 2, 371, 373, 3, 2, 2, 2, 372, 369, 3, 2, 2, 2,
-	373, 376, 3, 2, 2, 2, 374, 372, 3, 2, 2, 2, 374, 375, 3, 2, 2, 2, 375,
-	377, 3, 2, 2, 2, 376, 374, 3, 2, 2, 2, 377, 379, 7, 7, 2, 2, 378, 367,
-	3, 2, 2, 2, 378, 368, 3, 2, 2, 2, 379, 41, 3, 2, 2, 2, 380, 388, 5, 24,
-	13, 2, 381, 384, 5, 114, 58, 2, 382, 383, 7, 13, 2, 2, 383, 385, 5, 48,
-	25, 2, 384, 382, 3, 2, 2, 2, 384, 385, 3, 2, 2, 2, 385, 389, 3, 2, 2, 2,
-	386, 387, 7, 13, 2, 2, 387, 389, 5, 26, 14, 2, 388, 381, 3, 2, 2, 2, 388,
-	386, 3, 2, 2, 2, 389, 43, 3, 2, 2, 2, 390, 391, 7, 8, 2, 2, 391, 392, 5,
-	46, 24, 2, 392, 393, 7, 9, 2, 2, 393, 45, 3, 2, 2, 2, 394, 395, 5, 48,
-	25, 2, 395, 396, 5, 216, 109, 2, 396, 398, 3, 2, 2, 2, 397, 394, 3, 2,
-	2, 2, 398, 401, 3, 2, 2, 2, 399, 397, 3, 2, 2, 2, 399, 400, 3, 2, 2, 2,
-	400, 47, 3, 2, 2, 2, 401, 399, 3, 2, 2, 2, 402, 418, 5, 106, 54, 2, 403,
-	418, 5, 50, 26, 2, 404, 418, 5, 112, 57, 2, 405, 418, 5, 66, 34, 2, 406,
-	418, 5, 68, 35, 2, 407, 418, 5, 70, 36, 2, 408, 418, 5, 72, 37, 2, 409,
-	418, 5, 74, 38, 2, 410, 418, 5, 78, 40, 2, 411, 418, 5, 80, 41, 2, 412,
-	418, 5, 98, 50, 2, 413, 418, 5, 76, 39, 2, 414, 418, 5, 64, 33, 2, 415,
-	418, 5, 44, 23, 2, 416, 418, 5, 18, 10, 2, 417, 402, 3, 2, 2, 2, 417, 403,
-	3, 2, 2, 2, 417, 404, 3, 2, 2, 2, 417, 405, 3, 2, 2, 2, 417, 406, 3, 2,
-	2, 2, 417, 407, 3, 2, 2, 2, 417, 408, 3, 2, 2, 2, 417, 409, 3, 2, 2, 2,
-	417, 410, 3, 2, 2, 2, 417, 411, 3, 2, 2, 2, 417, 412, 3, 2, 2, 2, 417,
-	413, 3, 2, 2, 2, 417, 414, 3, 2, 2, 2, 417, 415, 3, 2, 2, 2, 417, 416,
-	3, 2, 2, 2, 418, 49, 3, 2, 2, 2, 419, 426, 5, 52, 27, 2, 420, 426, 5, 54,
-	28, 2, 421, 426, 5, 60, 31, 2, 422, 426, 5, 56, 29, 2, 423, 426, 5, 210,
-	106, 2, 424, 426, 5, 62, 32, 2, 425, 419, 3, 2, 2, 2, 425, 420, 3, 2, 2,
-	2, 425, 421, 3, 2, 2, 2, 425, 422, 3, 2, 2, 2, 425, 423, 3, 2, 2, 2, 425,
-	424, 3, 2, 2, 2, 426, 51, 3, 2, 2, 2, 427, 428, 5, 210, 106, 2, 428, 429,
-	7, 20, 2, 2, 429, 430, 5, 210, 106, 2, 430, 53, 3, 2, 2, 2, 431, 432, 5,
-	210, 106, 2, 432, 433, 9, 6, 2, 2, 433, 55, 3, 2, 2, 2, 434, 435, 5, 26,
-	14, 2, 435, 436, 5, 58, 30, 2, 436, 437, 5, 26, 14, 2, 437, 57, 3, 2, 2,
-	2, 438, 440, 9, 7, 2, 2, 439, 438, 3, 2, 2, 2, 439, 440, 3, 2, 2, 2, 440,
-	441, 3, 2, 2, 2, 441, 442, 7, 13, 2, 2, 442, 59, 3, 2, 2, 2, 443, 444,
-	5, 24, 13, 2, 444, 445, 7, 33, 2, 2, 445, 446, 5, 26, 14, 2, 446, 61, 3,
-	2, 2, 2, 447, 448, 7, 34, 2, 2, 448, 63, 3, 2, 2, 2, 449, 450, 7, 35, 2,
-	2, 450, 451, 7, 71, 2, 2, 451, 452, 7, 10, 2, 2, 452, 453, 5, 48, 25, 2,
-	453, 65, 3, 2, 2, 2, 454, 456, 7, 36, 2, 2, 455, 457, 5, 26, 14, 2, 456,
-	455, 3, 2, 2, 2, 456, 457, 3, 2, 2, 2, 457, 67, 3, 2, 2, 2, 458, 460, 7,
-	37, 2, 2, 459, 461, 7, 71, 2, 2, 460, 459, 3, 2, 2, 2, 460, 461, 3, 2,
-	2, 2, 461, 69, 3, 2, 2, 2, 462, 464, 7, 38, 2, 2, 463, 465, 7, 71, 2, 2,
-	464, 463, 3, 2, 2, 2, 464, 465, 3, 2, 2, 2, 465, 71, 3, 2, 2, 2, 466, 467,
-	7, 39, 2, 2, 467, 468, 7, 71, 2, 2, 468, 73, 3, 2, 2, 2, 469, 470, 7, 40,
-	2, 2, 470, 75, 3, 2, 2, 2, 471, 472, 7, 41, 2, 2, 472, 473, 5, 210, 106,
-	2, 473, 77, 3, 2, 2, 2, 474, 478, 7, 42, 2, 2, 475, 476, 5, 50, 26, 2,
-	476, 477, 7, 34, 2, 2, 477, 479, 3, 2, 2, 2, 478, 475, 3, 2, 2, 2, 478,
-	479, 3, 2, 2, 2, 479, 480, 3, 2, 2, 2, 480, 487, 5, 210, 106, 2, 481, 482,
-	7, 43, 2, 2, 482, 483, 5, 48, 25, 2, 483, 484, 5, 216, 109, 2, 484, 488,
-	3, 2, 2, 2, 485, 486, 7, 34, 2, 2, 486, 488, 5, 44, 23, 2, 487, 481, 3,
-	2, 2, 2, 487, 485, 3, 2, 2, 2, 488, 500, 3, 2, 2, 2, 489, 498, 7, 44, 2,
-	2, 490, 499, 5, 78, 40, 2, 491, 492, 7, 43, 2, 2, 492, 493, 5, 48, 25,
-	2, 493, 494, 5, 216, 109, 2, 494, 497, 3, 2, 2, 2, 495, 497, 5, 44, 23,
-	2, 496, 491, 3, 2, 2, 2, 496, 495, 3, 2, 2, 2, 497, 499, 3, 2, 2, 2, 498,
-	490, 3, 2, 2, 2, 498, 496, 3, 2, 2, 2, 499, 501, 3, 2, 2, 2, 500, 489,
-	3, 2, 2, 2, 500, 501, 3, 2, 2, 2, 501, 79, 3, 2, 2, 2, 502, 505, 5, 82,
-	42, 2, 503, 505, 5, 88, 45, 2, 504, 502, 3, 2, 2, 2, 504, 503, 3, 2, 2,
-	2, 505, 81, 3, 2, 2, 2, 506, 510, 7, 45, 2, 2, 507, 508, 5, 50, 26, 2,
-	508, 509, 7, 34, 2, 2, 509, 511, 3, 2, 2, 2, 510, 507, 3, 2, 2, 2, 510,
-	511, 3, 2, 2, 2, 511, 513, 3, 2, 2, 2, 512, 514, 5, 210, 106, 2, 513, 512,
-	3, 2, 2, 2, 513, 514, 3, 2, 2, 2, 514, 515, 3, 2, 2, 2, 515, 519, 7, 8,
-	2, 2, 516, 518, 5, 84, 43, 2, 517, 516, 3, 2, 2, 2, 518, 521, 3, 2, 2,
-	2, 519, 517, 3, 2, 2, 2, 519, 520, 3, 2, 2, 2, 520, 522, 3, 2, 2, 2, 521,
-	519, 3, 2, 2, 2, 522, 523, 7, 9, 2, 2, 523, 83, 3, 2, 2, 2, 524, 525, 5,
-	86, 44, 2, 525, 526, 7, 43, 2, 2, 526, 527, 5, 46, 24, 2, 527, 85, 3, 2,
-	2, 2, 528, 531, 5, 26, 14, 2, 529, 531, 7, 14, 2, 2, 530, 528, 3, 2, 2,
-	2, 530, 529, 3, 2, 2, 2, 531, 87, 3, 2, 2, 2, 532, 536, 7, 45, 2, 2, 533,
-	534, 5, 50, 26, 2, 534, 535, 7, 34, 2, 2, 535, 537, 3, 2, 2, 2, 536, 533,
-	3, 2, 2, 2, 536, 537, 3, 2, 2, 2, 537, 538, 3, 2, 2, 2, 538, 539, 5, 90,
-	46, 2, 539, 543, 7, 8, 2, 2, 540, 542, 5, 92, 47, 2, 541, 540, 3, 2, 2,
-	2, 542, 545, 3, 2, 2, 2, 543, 541, 3, 2, 2, 2, 543, 544, 3, 2, 2, 2, 544,
-	546, 3, 2, 2, 2, 545, 543, 3, 2, 2, 2, 546, 547, 7, 9, 2, 2, 547, 89, 3,
-	2, 2, 2, 548, 549, 7, 71, 2, 2, 549, 551, 7, 33, 2, 2, 550, 548, 3, 2,
-	2, 2, 550, 551, 3, 2, 2, 2, 551, 552, 3, 2, 2, 2, 552, 553, 5, 192, 97,
-	2, 553, 554, 7, 11, 2, 2, 554, 555, 7, 6, 2, 2, 555, 556, 7, 16, 2, 2,
-	556, 557, 7, 7, 2, 2, 557, 91, 3, 2, 2, 2, 558, 559, 5, 94, 48, 2, 559,
-	560, 7, 43, 2, 2, 560, 561, 5, 46, 24, 2, 561, 93, 3, 2, 2, 2, 562, 565,
-	5, 96, 49, 2, 563, 565, 7, 14, 2, 2, 564, 562, 3, 2, 2, 2, 564, 563, 3,
-	2, 2, 2, 565, 95, 3, 2, 2, 2, 566, 571, 5, 114, 58, 2, 567, 568, 7, 15,
-	2, 2, 568, 570, 5, 114, 58, 2, 569, 567, 3, 2, 2, 2, 570, 573, 3, 2, 2,
-	2, 571, 569, 3, 2, 2, 2, 571, 572, 3, 2, 2, 2, 572, 97, 3, 2, 2, 2, 573,
-	571, 3, 2, 2, 2, 574, 575, 7, 46, 2, 2, 575, 579, 7, 8, 2, 2, 576, 578,
-	5, 100, 51, 2, 577, 576, 3, 2, 2, 2, 578, 581, 3, 2, 2, 2, 579, 577, 3,
-	2, 2, 2, 579, 580, 3, 2, 2, 2, 580, 582, 3, 2, 2, 2, 581, 579, 3, 2, 2,
-	2, 582, 583, 7, 9, 2, 2, 583, 99, 3, 2, 2, 2, 584, 585, 5, 102, 52, 2,
-	585, 588, 7, 43, 2, 2, 586, 589, 5, 44, 23, 2, 587, 589, 5, 48, 25, 2,
-	588, 586, 3, 2, 2, 2, 588, 587, 3, 2, 2, 2, 589, 101, 3, 2, 2, 2, 590,
-	593, 5, 52, 27, 2, 591, 593, 5, 104, 53, 2, 592, 590, 3, 2, 2, 2, 592,
-	591, 3, 2, 2, 2, 593, 596, 3, 2, 2, 2, 594, 596, 7, 14, 2, 2, 595, 592,
-	3, 2, 2, 2, 595, 594, 3, 2, 2, 2, 596, 103, 3, 2, 2, 2, 597, 598, 5, 26,
-	14, 2, 598, 599, 7, 13, 2, 2, 599, 604, 3, 2, 2, 2, 600, 601, 5, 24, 13,
-	2, 601, 602, 7, 33, 2, 2, 602, 604, 3, 2, 2, 2, 603, 597, 3, 2, 2, 2, 603,
-	600, 3, 2, 2, 2, 603, 604, 3, 2, 2, 2, 604, 605, 3, 2, 2, 2, 605, 606,
-	5, 210, 106, 2, 606, 105, 3, 2, 2, 2, 607, 611, 7, 47, 2, 2, 608, 612,
-	5, 210, 106, 2, 609, 612, 5, 110, 56, 2, 610, 612, 5, 108, 55, 2, 611,
-	608, 3, 2, 2, 2, 611, 609, 3, 2, 2, 2, 611, 610, 3, 2, 2, 2, 611, 612,
-	3, 2, 2, 2, 612, 613, 3, 2, 2, 2, 613, 614, 7, 34, 2, 2, 614, 615, 5, 44,
-	23, 2, 615, 107, 3, 2, 2, 2, 616, 618, 5, 50, 26, 2, 617, 616, 3, 2, 2,
-	2, 617, 618, 3, 2, 2, 2, 618, 619, 3, 2, 2, 2, 619, 621, 7, 34, 2, 2, 620,
-	622, 5, 210, 106, 2, 621, 620, 3, 2, 2, 2, 621, 622, 3, 2, 2, 2, 622, 623,
-	3, 2, 2, 2, 623, 625, 7, 34, 2, 2, 624, 626, 5, 50, 26, 2, 625, 624, 3,
-	2, 2, 2, 625, 626, 3, 2, 2, 2, 626, 109, 3, 2, 2, 2, 627, 630, 5, 24, 13,
-	2, 628, 630, 5, 26, 14, 2, 629, 627, 3, 2, 2, 2, 629, 628, 3, 2, 2, 2,
-	630, 631, 3, 2, 2, 2, 631, 632, 7, 48, 2, 2, 632, 633, 5, 210, 106, 2,
-	633, 111, 3, 2, 2, 2, 634, 637, 7, 49, 2, 2, 635, 638, 5, 34, 18, 2, 636,
-	638, 5, 210, 106, 2, 637, 635, 3, 2, 2, 2, 637, 636, 3, 2, 2, 2, 638, 113,
-	3, 2, 2, 2, 639, 646, 5, 116, 59, 2, 640, 646, 5, 118, 60, 2, 641, 642,
-	7, 6, 2, 2, 642, 643, 5, 114, 58, 2, 643, 644, 7, 7, 2, 2, 644, 646, 3,
-	2, 2, 2, 645, 639, 3, 2, 2, 2, 645, 640, 3, 2, 2, 2, 645, 641, 3, 2, 2,
-	2, 646, 115, 3, 2, 2, 2, 647, 650, 5, 166, 84, 2, 648, 650, 7, 71, 2, 2,
-	649, 647, 3, 2, 2, 2, 649, 648, 3, 2, 2, 2, 650, 117, 3, 2, 2, 2, 651,
-	660, 5, 120, 61, 2, 652, 660, 5, 182, 92, 2, 653, 660, 5, 126, 64, 2, 654,
-	660, 5, 140, 71, 2, 655, 660, 5, 128, 65, 2, 656, 660, 5, 130, 66, 2, 657,
-	660, 5, 132, 67, 2, 658, 660, 5, 134, 68, 2, 659, 651, 3, 2, 2, 2, 659,
-	652, 3, 2, 2, 2, 659, 653, 3, 2, 2, 2, 659, 654, 3, 2, 2, 2, 659, 655,
-	3, 2, 2, 2, 659, 656, 3, 2, 2, 2, 659, 657, 3, 2, 2, 2, 659, 658, 3, 2,
-	2, 2, 660, 119, 3, 2, 2, 2, 661, 662, 7, 50, 2, 2, 662, 663, 5, 122, 62,
-	2, 663, 664, 7, 51, 2, 2, 664, 665, 5, 124, 63, 2, 665, 121, 3, 2, 2, 2,
-	666, 667, 5, 210, 106, 2, 667, 123, 3, 2, 2, 2, 668, 669, 5, 114, 58, 2,
-	669, 125, 3, 2, 2, 2, 670, 671, 7, 18, 2, 2, 671, 672, 5, 114, 58, 2, 672,
-	127, 3, 2, 2, 2, 673, 675, 7, 52, 2, 2, 674, 676, 7, 71, 2, 2, 675, 674,
-	3, 2, 2, 2, 675, 676, 3, 2, 2, 2, 676, 687, 3, 2, 2, 2, 677, 683, 7, 8,
-	2, 2, 678, 679, 5, 138, 70, 2, 679, 680, 5, 216, 109, 2, 680, 682, 3, 2,
-	2, 2, 681, 678, 3, 2, 2, 2, 682, 685, 3, 2, 2, 2, 683, 681, 3, 2, 2, 2,
-	683, 684, 3, 2, 2, 2, 684, 686, 3, 2, 2, 2, 685, 683, 3, 2, 2, 2, 686,
-	688, 7, 9, 2, 2, 687, 677, 3, 2, 2, 2, 687, 688, 3, 2, 2, 2, 688, 129,
-	3, 2, 2, 2, 689, 690, 7, 50, 2, 2, 690, 691, 7, 51, 2, 2, 691, 692, 5,
-	124, 63, 2, 692, 131, 3, 2, 2, 2, 693, 694, 7, 53, 2, 2, 694, 695, 7, 50,
-	2, 2, 695, 696, 5, 114, 58, 2, 696, 697, 7, 51, 2, 2, 697, 698, 5, 124,
-	63, 2, 698, 133, 3, 2, 2, 2, 699, 700, 5, 136, 69, 2, 700, 701, 5, 124,
-	63, 2, 701, 135, 3, 2, 2, 2, 702, 708, 7, 54, 2, 2, 703, 704, 7, 54, 2,
-	2, 704, 708, 7, 20, 2, 2, 705, 706, 7, 20, 2, 2, 706, 708, 7, 54, 2, 2,
-	707, 702, 3, 2, 2, 2, 707, 703, 3, 2, 2, 2, 707, 705, 3, 2, 2, 2, 708,
-	137, 3, 2, 2, 2, 709, 710, 6, 70, 2, 2, 710, 711, 7, 71, 2, 2, 711, 712,
-	5, 148, 75, 2, 712, 713, 7, 10, 2, 2, 713, 714, 5, 146, 74, 2, 714, 719,
-	3, 2, 2, 2, 715, 719, 5, 116, 59, 2, 716, 717, 7, 71, 2, 2, 717, 719, 5,
-	148, 75, 2, 718, 709, 3, 2, 2, 2, 718, 715, 3, 2, 2, 2, 718, 716, 3, 2,
-	2, 2, 719, 139, 3, 2, 2, 2, 720, 721, 7, 55, 2, 2, 721, 722, 5, 142, 72,
-	2, 722, 141, 3, 2, 2, 2, 723, 725, 6, 72, 3, 2, 724, 726, 5, 144, 73, 2,
-	725, 724, 3, 2, 2, 2, 725, 726, 3, 2, 2, 2, 726, 727, 3, 2, 2, 2, 727,
-	728, 5, 148, 75, 2, 728, 729, 7, 10, 2, 2, 729, 730, 5, 146, 74, 2, 730,
-	736, 3, 2, 2, 2, 731, 733, 5, 144, 73, 2, 732, 731, 3, 2, 2, 2, 732, 733,
-	3, 2, 2, 2, 733, 734, 3, 2, 2, 2, 734, 736, 5, 148, 75, 2, 735, 723, 3,
-	2, 2, 2, 735, 732, 3, 2, 2, 2, 736, 143, 3, 2, 2, 2, 737, 738, 7, 56, 2,
-	2, 738, 739, 5, 146, 74, 2, 739, 740, 7, 57, 2, 2, 740, 145, 3, 2, 2, 2,
-	741, 746, 5, 114, 58, 2, 742, 743, 7, 15, 2, 2, 743, 745, 5, 114, 58, 2,
-	744, 742, 3, 2, 2, 2, 745, 748, 3, 2, 2, 2, 746, 744, 3, 2, 2, 2, 746,
-	747, 3, 2, 2, 2, 747, 147, 3, 2, 2, 2, 748, 746, 3, 2, 2, 2, 749, 754,
-	7, 6, 2, 2, 750, 752, 5, 150, 76, 2, 751, 753, 7, 15, 2, 2, 752, 751, 3,
-	2, 2, 2, 752, 753, 3, 2, 2, 2, 753, 755, 3, 2, 2, 2, 754, 750, 3, 2, 2,
-	2, 754, 755, 3, 2, 2, 2, 755, 756, 3, 2, 2, 2, 756, 758, 7, 7, 2, 2, 757,
-	749, 3, 2, 2, 2, 757, 758, 3, 2, 2, 2, 758, 149, 3, 2, 2, 2, 759, 764,
-	5, 152, 77, 2, 760, 761, 7, 15, 2, 2, 761, 763, 5, 152, 77, 2, 762, 760,
-	3, 2, 2, 2, 763, 766, 3, 2, 2, 2, 764, 762, 3, 2, 2, 2, 764, 765, 3, 2,
-	2, 2, 765, 151, 3, 2, 2, 2, 766, 764, 3, 2, 2, 2, 767, 769, 5, 24, 13,
-	2, 768, 767, 3, 2, 2, 2, 768, 769, 3, 2, 2, 2, 769, 771, 3, 2, 2, 2, 770,
-	772, 5, 154, 78, 2, 771, 770, 3, 2, 2, 2, 771, 772, 3, 2, 2, 2, 772, 773,
-	3, 2, 2, 2, 773, 774, 5, 114, 58, 2, 774, 153, 3, 2, 2, 2, 775, 776, 7,
-	58, 2, 2, 776, 155, 3, 2, 2, 2, 777, 785, 5, 158, 80, 2, 778, 785, 5, 162,
-	82, 2, 779, 785, 5, 206, 104, 2, 780, 781, 7, 6, 2, 2, 781, 782, 5, 210,
-	106, 2, 782, 783, 7, 7, 2, 2, 783, 785, 3, 2, 2, 2, 784, 777, 3, 2, 2,
-	2, 784, 778, 3, 2, 2, 2, 784, 779, 3, 2, 2, 2, 784, 780, 3, 2, 2, 2, 785,
-	157, 3, 2, 2, 2, 786, 790, 5, 160, 81, 2, 787, 790, 5, 168, 85, 2, 788,
-	790, 5, 190, 96, 2, 789, 786, 3, 2, 2, 2, 789, 787, 3, 2, 2, 2, 789, 788,
-	3, 2, 2, 2, 790, 159, 3, 2, 2, 2, 791, 799, 7, 75, 2, 2, 792, 799, 7, 76,
-	2, 2, 793, 799, 7, 77, 2, 2, 794, 799, 7, 78, 2, 2, 795, 799, 7, 81, 2,
-	2, 796, 799, 9, 8, 2, 2, 797, 799, 7, 61, 2, 2, 798, 791, 3, 2, 2, 2, 798,
-	792, 3, 2, 2, 2, 798, 793, 3, 2, 2, 2, 798, 794, 3, 2, 2, 2, 798, 795,
-	3, 2, 2, 2, 798, 796, 3, 2, 2, 2, 798, 797, 3, 2, 2, 2, 799, 161, 3, 2,
-	2, 2, 800, 804, 7, 71, 2, 2, 801, 804, 5, 166, 84, 2, 802, 804, 5, 164,
-	83, 2, 803, 800, 3, 2, 2, 2, 803, 801, 3, 2, 2, 2, 803, 802, 3, 2, 2, 2,
-	804, 163, 3, 2, 2, 2, 805, 806, 7, 62, 2, 2, 806, 165, 3, 2, 2, 2, 807,
-	808, 7, 71, 2, 2, 808, 809, 7, 11, 2, 2, 809, 814, 7, 71, 2, 2, 810, 811,
-	5, 164, 83, 2, 811, 812, 7, 71, 2, 2, 812, 814, 3, 2, 2, 2, 813, 807, 3,
-	2, 2, 2, 813, 810, 3, 2, 2, 2, 814, 167, 3, 2, 2, 2, 815, 817, 5, 170,
-	86, 2, 816, 818, 5, 144, 73, 2, 817, 816, 3, 2, 2, 2, 817, 818, 3, 2, 2,
-	2, 818, 819, 3, 2, 2, 2, 819, 820, 5, 172, 87, 2, 820, 169, 3, 2, 2, 2,
-	821, 831, 5, 182, 92, 2, 822, 831, 5, 120, 61, 2, 823, 824, 7, 50, 2, 2,
-	824, 825, 7, 58, 2, 2, 825, 826, 7, 51, 2, 2, 826, 831, 5, 124, 63, 2,
-	827, 831, 5, 130, 66, 2, 828, 831, 5, 132, 67, 2, 829, 831, 5, 116, 59,
-	2, 830, 821, 3, 2, 2, 2, 830, 822, 3, 2, 2, 2, 830, 823, 3, 2, 2, 2, 830,
-	827, 3, 2, 2, 2, 830, 828, 3, 2, 2, 2, 830, 829, 3, 2, 2, 2, 831, 171,
-	3, 2, 2, 2, 832, 837, 7, 8, 2, 2, 833, 835, 5, 174, 88, 2, 834, 836, 7,
-	15, 2, 2, 835, 834, 3, 2, 2, 2, 835, 836, 3, 2, 2, 2, 836, 838, 3, 2, 2,
-	2, 837, 833, 3, 2, 2, 2, 837, 838, 3, 2, 2, 2, 838, 839, 3, 2, 2, 2, 839,
-	840, 7, 9, 2, 2, 840, 173, 3, 2, 2, 2, 841, 848, 5, 176, 89, 2, 842, 844,
-	7, 15, 2, 2, 843, 842, 3, 2, 2, 2, 843, 844, 3, 2, 2, 2, 844, 845, 3, 2,
-	2, 2, 845, 847, 5, 176, 89, 2, 846, 843, 3, 2, 2, 2, 847, 850, 3, 2, 2,
-	2, 848, 846, 3, 2, 2, 2, 848, 849, 3, 2, 2, 2, 849, 175, 3, 2, 2, 2, 850,
-	848, 3, 2, 2, 2, 851, 852, 5, 178, 90, 2, 852, 853, 7, 10, 2, 2, 853, 855,
-	3, 2, 2, 2, 854, 851, 3, 2, 2, 2, 854, 855, 3, 2, 2, 2, 855, 856, 3, 2,
-	2, 2, 856, 857, 5, 180, 91, 2, 857, 177, 3, 2, 2, 2, 858, 862, 7, 71, 2,
-	2, 859, 862, 5, 210, 106, 2, 860, 862, 5, 172, 87, 2, 861, 858, 3, 2, 2,
-	2, 861, 859, 3, 2, 2, 2, 861, 860, 3, 2, 2, 2, 862, 179, 3, 2, 2, 2, 863,
-	866, 5, 210, 106, 2, 864, 866, 5, 172, 87, 2, 865, 863, 3, 2, 2, 2, 865,
-	864, 3, 2, 2, 2, 866, 181, 3, 2, 2, 2, 867, 869, 9, 9, 2, 2, 868, 870,
-	7, 71, 2, 2, 869, 868, 3, 2, 2, 2, 869, 870, 3, 2, 2, 2, 870, 872, 3, 2,
-	2, 2, 871, 873, 5, 144, 73, 2, 872, 871, 3, 2, 2, 2, 872, 873, 3, 2, 2,
-	2, 873, 884, 3, 2, 2, 2, 874, 880, 7, 8, 2, 2, 875, 876, 5, 184, 93, 2,
-	876, 877, 5, 216, 109, 2, 877, 879, 3, 2, 2, 2, 878, 875, 3, 2, 2, 2, 879,
-	882, 3, 2, 2, 2, 880, 878, 3, 2, 2, 2, 880, 881, 3, 2, 2, 2, 881, 883,
-	3, 2, 2, 2, 882, 880, 3, 2, 2, 2, 883, 885, 7, 9, 2, 2, 884, 874, 3, 2,
-	2, 2, 884, 885, 3, 2, 2, 2, 885, 183, 3, 2, 2, 2, 886, 887, 6, 93, 4, 2,
-	887, 888, 5, 24, 13, 2, 888, 889, 5, 114, 58, 2, 889, 892, 3, 2, 2, 2,
-	890, 892, 5, 188, 95, 2, 891, 886, 3, 2, 2, 2, 891, 890, 3, 2, 2, 2, 892,
-	894, 3, 2, 2, 2, 893, 895, 7, 81, 2, 2, 894, 893, 3, 2, 2, 2, 894, 895,
-	3, 2, 2, 2, 895, 898, 3, 2, 2, 2, 896, 898, 5, 186, 94, 2, 897, 891, 3,
-	2, 2, 2, 897, 896, 3, 2, 2, 2, 898, 185, 3, 2, 2, 2, 899, 901, 7, 18, 2,
-	2, 900, 899, 3, 2, 2, 2, 900, 901, 3, 2, 2, 2, 901, 902, 3, 2, 2, 2, 902,
-	903, 5, 32, 17, 2, 903, 187, 3, 2, 2, 2, 904, 906, 7, 18, 2, 2, 905, 904,
-	3, 2, 2, 2, 905, 906, 3, 2, 2, 2, 906, 907, 3, 2, 2, 2, 907, 908, 5, 116,
-	59, 2, 908, 189, 3, 2, 2, 2, 909, 910, 7, 55, 2, 2, 910, 911, 5, 34, 18,
-	2, 911, 191, 3, 2, 2, 2, 912, 913, 8, 97, 1, 2, 913, 916, 5, 156, 79, 2,
-	914, 916, 5, 214, 108, 2, 915, 912, 3, 2, 2, 2, 915, 914, 3, 2, 2, 2, 916,
-	921, 3, 2, 2, 2, 917, 918, 12, 3, 2, 2, 918, 920, 5, 194, 98, 2, 919, 917,
-	3, 2, 2, 2, 920, 923, 3, 2, 2, 2, 921, 919, 3, 2, 2, 2, 921, 922, 3, 2,
-	2, 2, 922, 193, 3, 2, 2, 2, 923, 921, 3, 2, 2, 2, 924, 930, 5, 196, 99,
-	2, 925, 930, 5, 198, 100, 2, 926, 930, 5, 200, 101, 2, 927, 930, 5, 202,
-	102, 2, 928, 930, 5, 204, 103, 2, 929, 924, 3, 2, 2, 2, 929, 925, 3, 2,
-	2, 2, 929, 926, 3, 2, 2, 2, 929, 927, 3, 2, 2, 2, 929, 928, 3, 2, 2, 2,
-	930, 195, 3, 2, 2, 2, 931, 932, 7, 11, 2, 2, 932, 933, 7, 71, 2, 2, 933,
-	197, 3, 2, 2, 2, 934, 935, 7, 50, 2, 2, 935, 936, 5, 210, 106, 2, 936,
-	937, 7, 51, 2, 2, 937, 199, 3, 2, 2, 2, 938, 954, 7, 50, 2, 2, 939, 941,
-	5, 210, 106, 2, 940, 939, 3, 2, 2, 2, 940, 941, 3, 2, 2, 2, 941, 942, 3,
-	2, 2, 2, 942, 944, 7, 10, 2, 2, 943, 945, 5, 210, 106, 2, 944, 943, 3,
-	2, 2, 2, 944, 945, 3, 2, 2, 2, 945, 955, 3, 2, 2, 2, 946, 948, 5, 210,
-	106, 2, 947, 946, 3, 2, 2, 2, 947, 948, 3, 2, 2, 2, 948, 949, 3, 2, 2,
-	2, 949, 950, 7, 10, 2, 2, 950, 951, 5, 210, 106, 2, 951, 952, 7, 10, 2,
-	2, 952, 953, 5, 210, 106, 2, 953, 955, 3, 2, 2, 2, 954, 940, 3, 2, 2, 2,
-	954, 947, 3, 2, 2, 2, 955, 956, 3, 2, 2, 2, 956, 957, 7, 51, 2, 2, 957,
-	201, 3, 2, 2, 2, 958, 959, 7, 11, 2, 2, 959, 960, 7, 6, 2, 2, 960, 961,
-	5, 114, 58, 2, 961, 962, 7, 7, 2, 2, 962, 203, 3, 2, 2, 2, 963, 965, 5,
-	144, 73, 2, 964, 963, 3, 2, 2, 2, 964, 965, 3, 2, 2, 2, 965, 966, 3, 2,
-	2, 2, 966, 981, 7, 6, 2, 2, 967, 974, 5, 26, 14, 2, 968, 971, 5, 114, 58,
-	2, 969, 970, 7, 15, 2, 2, 970, 972, 5, 26, 14, 2, 971, 969, 3, 2, 2, 2,
-	971, 972, 3, 2, 2, 2, 972, 974, 3, 2, 2, 2, 973, 967, 3, 2, 2, 2, 973,
-	968, 3, 2, 2, 2, 974, 976, 3, 2, 2, 2, 975, 977, 5, 154, 78, 2, 976, 975,
-	3, 2, 2, 2, 976, 977, 3, 2, 2, 2, 977, 979, 3, 2, 2, 2, 978, 980, 7, 15,
-	2, 2, 979, 978, 3, 2, 2, 2, 979, 980, 3, 2, 2, 2, 980, 982, 3, 2, 2, 2,
-	981, 973, 3, 2, 2, 2, 981, 982, 3, 2, 2, 2, 982, 983, 3, 2, 2, 2, 983,
-	984, 7, 7, 2, 2, 984, 205, 3, 2, 2, 2, 985, 986, 5, 208, 105, 2, 986, 987,
-	7, 11, 2, 2, 987, 988, 7, 71, 2, 2, 988, 207, 3, 2, 2, 2, 989, 1000, 5,
-	116, 59, 2, 990, 991, 7, 6, 2, 2, 991, 992, 7, 18, 2, 2, 992, 993, 5, 116,
-	59, 2, 993, 994, 7, 7, 2, 2, 994, 1000, 3, 2, 2, 2, 995, 996, 7, 6, 2,
-	2, 996, 997, 5, 208, 105, 2, 997, 998, 7, 7, 2, 2, 998, 1000, 3, 2, 2,
-	2, 999, 989, 3, 2, 2, 2, 999, 990, 3, 2, 2, 2, 999, 995, 3, 2, 2, 2, 1000,
-	209, 3, 2, 2, 2, 1001, 1002, 8, 106, 1, 2, 1002, 1003, 5, 212, 107, 2,
-	1003, 1009, 3, 2, 2, 2, 1004, 1005, 12, 4, 2, 2, 1005, 1006, 9, 10, 2,
-	2, 1006, 1008, 5, 210, 106, 5, 1007, 1004, 3, 2, 2, 2, 1008, 1011, 3, 2,
-	2, 2, 1009, 1007, 3, 2, 2, 2, 1009, 1010, 3, 2, 2, 2, 1010, 211, 3, 2,
-	2, 2, 1011, 1009, 3, 2, 2, 2, 1012, 1016, 5, 192, 97, 2, 1013, 1014, 9,
-	11, 2, 2, 1014, 1016, 5, 212, 107, 2, 1015, 1012, 3, 2, 2, 2, 1015, 1013,
-	3, 2, 2, 2, 1016, 213, 3, 2, 2, 2, 1017, 1018, 5, 114, 58, 2, 1018, 1019,
-	7, 6, 2, 2, 1019, 1021, 5, 210, 106, 2, 1020, 1022, 7, 15, 2, 2, 1021,
-	1020, 3, 2, 2, 2, 1021, 1022, 3, 2, 2, 2, 1022, 1023, 3, 2, 2, 2, 1023,
-	1024, 7, 7, 2, 2, 1024, 215, 3, 2, 2, 2, 1025, 1030, 7, 34, 2, 2, 1026,
-	1030, 7, 2, 2, 3, 1027, 1030, 6, 109, 7, 2, 1028, 1030, 6, 109, 8, 2, 1029,
-	1025, 3, 2, 2, 2, 1029, 1026, 3, 2, 2, 2, 1029, 1027, 3, 2, 2, 2, 1029,
-	1028, 3, 2, 2, 2, 1030, 217, 3, 2, 2, 2, 119, 225, 233, 240, 253, 262,
-	266, 271, 278, 283, 293, 297, 301, 311, 319, 330, 334, 338, 346, 352, 357,
-	362, 374, 378, 384, 388, 399, 417, 425, 439, 456, 460, 464, 478, 487, 496,
-	498, 500, 504, 510, 513, 519, 530, 536, 543, 550, 564, 571, 579, 588, 592,
-	595, 603, 611, 617, 621, 625, 629, 637, 645, 649, 659, 675, 683, 687, 707,
-	718, 725, 732, 735, 746, 752, 754, 757, 764, 768, 771, 784, 789, 798, 803,
-	813, 817, 830, 835, 837, 843, 848, 854, 861, 865, 869, 872, 880, 884, 891,
-	894, 897, 900, 905, 915, 921, 929, 940, 944, 947, 954, 964, 971, 973, 976,
-	979, 981, 999, 1009, 1015, 1021, 1029,
+	4, 107, 9, 107, 4, 108, 9, 108, 4, 109, 9, 109, 4, 110, 9, 110, 4, 111,
+	9, 111, 4, 112, 9, 112, 4, 113, 9, 113, 4, 114, 9, 114, 4, 115, 9, 115,
+	4, 116, 9, 116, 4, 117, 9, 117, 4, 118, 9, 118, 4, 119, 9, 119, 3, 2, 3,
+	2, 3, 2, 3, 2, 3, 2, 7, 2, 244, 10, 2, 12, 2, 14, 2, 247, 11, 2, 3, 2,
+	3, 2, 3, 2, 7, 2, 252, 10, 2, 12, 2, 14, 2, 255, 11, 2, 3, 2, 3, 2, 3,
+	3, 3, 3, 5, 3, 261, 10, 3, 3, 3, 3, 3, 3, 4, 3, 4, 3, 4, 3, 5, 3, 5, 3,
+	5, 3, 5, 3, 5, 3, 5, 5, 5, 274, 10, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 7,
+	6, 281, 10, 6, 12, 6, 14, 6, 284, 11, 6, 3, 6, 5, 6, 287, 10, 6, 3, 7,
+	3, 7, 3, 7, 5, 7, 292, 10, 7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 5, 9, 299,
+	10, 9, 3, 10, 3, 10, 3, 10, 3, 10, 5, 10, 305, 10, 10, 3, 11, 3, 11, 3,
+	11, 3, 11, 3, 11, 3, 11, 7, 11, 313, 10, 11, 12, 11, 14, 11, 316, 11, 11,
+	3, 11, 5, 11, 319, 10, 11, 3, 12, 3, 12, 5, 12, 323, 10, 12, 3, 12, 3,
+	12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 7, 13, 334, 10, 13,
+	12, 13, 14, 13, 337, 11, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 5, 14,
+	344, 10, 14, 3, 15, 3, 15, 3, 15, 7, 15, 349, 10, 15, 12, 15, 14, 15, 352,
+	11, 15, 3, 16, 3, 16, 3, 16, 7, 16, 357, 10, 16, 12, 16, 14, 16, 360, 11,
+	16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 7, 17, 368, 10, 17, 12, 17,
+	14, 17, 371, 11, 17, 3, 17, 5, 17, 374, 10, 17, 3, 17, 3, 17, 3, 17, 5,
+	17, 379, 10, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 7, 18,
+	388, 10, 18, 12, 18, 14, 18, 391, 11, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3,
+	19, 3, 19, 3, 19, 7, 19, 400, 10, 19, 12, 19, 14, 19, 403, 11, 19, 3, 19,
+	3, 19, 5, 19, 407, 10, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 5,
+	21, 415, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 421, 10, 22, 3, 23,
+	3, 23, 3, 23, 5, 23, 426, 10, 23, 3, 24, 3, 24, 3, 24, 5, 24, 431, 10,
+	24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 7, 25, 441,
+	10, 25, 12, 25, 14, 25, 444, 11, 25, 3, 25, 5, 25, 447, 10, 25, 3, 26,
+	3, 26, 3, 26, 3, 26, 5, 26, 453, 10, 26, 3, 26, 3, 26, 5, 26, 457, 10,
+	26, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 7, 28, 466, 10, 28,
+	12, 28, 14, 28, 469, 11, 28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29,
+	3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 5, 29, 486,
+	10, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 5, 30, 494, 10, 30, 3,
+	31, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33,
+	3, 34, 5, 34, 508, 10, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3,
+	35, 3, 35, 3, 35, 5, 35, 519, 10, 35, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37,
+	3, 37, 3, 37, 3, 38, 3, 38, 5, 38, 530, 10, 38, 3, 39, 3, 39, 5, 39, 534,
+	10, 39, 3, 40, 3, 40, 5, 40, 538, 10, 40, 3, 41, 3, 41, 3, 41, 3, 42, 3,
+	42, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44, 5, 44, 552, 10, 44,
+	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 5, 44, 561, 10, 44, 3,
+	44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 5, 44, 570, 10, 44, 5, 44,
+	572, 10, 44, 5, 44, 574, 10, 44, 3, 45, 3, 45, 3, 45, 5, 45, 579, 10, 45,
+	3, 46, 3, 46, 3, 46, 3, 46, 5, 46, 585, 10, 46, 3, 46, 5, 46, 588, 10,
+	46, 3, 46, 3, 46, 7, 46, 592, 10, 46, 12, 46, 14, 46, 595, 11, 46, 3, 46,
+	3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 5, 48, 605, 10, 48, 3,
+	49, 3, 49, 3, 49, 3, 49, 7, 49, 611, 10, 49, 12, 49, 14, 49, 614, 11, 49,
+	3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 7, 50, 621, 10, 50, 12, 50, 14, 50,
+	624, 11, 50, 3, 50, 3, 50, 5, 50, 628, 10, 50, 3, 50, 3, 50, 3, 50, 3,
+	51, 3, 51, 5, 51, 635, 10, 51, 3, 51, 3, 51, 5, 51, 639, 10, 51, 3, 52,
+	3, 52, 3, 52, 3, 52, 5, 52, 645, 10, 52, 3, 52, 3, 52, 3, 52, 7, 52, 650,
+	10, 52, 12, 52, 14, 52, 653, 11, 52, 3, 52, 3, 52, 3, 53, 3, 53, 5, 53,
+	659, 10, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 54, 3, 54, 3,
+	54, 3, 54, 3, 55, 3, 55, 5, 55, 673, 10, 55, 3, 56, 3, 56, 3, 56, 7, 56,
+	678, 10, 56, 12, 56, 14, 56, 681, 11, 56, 3, 57, 3, 57, 3, 57, 7, 57, 686,
+	10, 57, 12, 57, 14, 57, 689, 11, 57, 3, 57, 3, 57, 3, 58, 3, 58, 3, 58,
+	3, 58, 5, 58, 697, 10, 58, 3, 59, 3, 59, 5, 59, 701, 10, 59, 3, 59, 5,
+	59, 704, 10, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 5, 60, 712,
+	10, 60, 3, 60, 3, 60, 3, 61, 3, 61, 3, 61, 3, 61, 5, 61, 720, 10, 61, 3,
+	61, 3, 61, 3, 61, 3, 62, 5, 62, 726, 10, 62, 3, 62, 3, 62, 5, 62, 730,
+	10, 62, 3, 62, 3, 62, 5, 62, 734, 10, 62, 3, 63, 3, 63, 5, 63, 738, 10,
+	63, 3, 63, 3, 63, 3, 63, 3, 64, 3, 64, 3, 64, 5, 64, 746, 10, 64, 3, 65,
+	3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 5, 65, 754, 10, 65, 3, 66, 3, 66, 5,
+	66, 758, 10, 66, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67, 3, 67,
+	5, 67, 768, 10, 67, 3, 68, 3, 68, 3, 68, 3, 68, 3, 68, 3, 69, 3, 69, 3,
+	70, 3, 70, 3, 71, 3, 71, 3, 71, 3, 72, 3, 72, 5, 72, 784, 10, 72, 3, 72,
+	3, 72, 3, 72, 3, 72, 7, 72, 790, 10, 72, 12, 72, 14, 72, 793, 11, 72, 3,
+	72, 5, 72, 796, 10, 72, 3, 73, 3, 73, 3, 73, 3, 73, 3, 74, 3, 74, 3, 74,
+	3, 74, 3, 74, 3, 74, 3, 75, 3, 75, 3, 75, 3, 76, 3, 76, 3, 76, 3, 76, 3,
+	76, 5, 76, 816, 10, 76, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77, 3, 77,
+	3, 77, 3, 77, 5, 77, 827, 10, 77, 3, 78, 3, 78, 3, 78, 3, 79, 3, 79, 5,
+	79, 834, 10, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 5, 79, 841, 10, 79,
+	3, 79, 5, 79, 844, 10, 79, 3, 80, 3, 80, 3, 80, 3, 80, 7, 80, 850, 10,
+	80, 12, 80, 14, 80, 853, 11, 80, 3, 80, 3, 80, 3, 81, 3, 81, 3, 81, 5,
+	81, 860, 10, 81, 3, 82, 3, 82, 3, 82, 7, 82, 865, 10, 82, 12, 82, 14, 82,
+	868, 11, 82, 3, 83, 5, 83, 871, 10, 83, 3, 83, 3, 83, 3, 84, 3, 84, 3,
+	84, 7, 84, 878, 10, 84, 12, 84, 14, 84, 881, 11, 84, 3, 85, 3, 85, 3, 85,
+	5, 85, 886, 10, 85, 5, 85, 888, 10, 85, 3, 85, 5, 85, 891, 10, 85, 3, 86,
+	3, 86, 3, 86, 7, 86, 896, 10, 86, 12, 86, 14, 86, 899, 11, 86, 3, 87, 5,
+	87, 902, 10, 87, 3, 87, 5, 87, 905, 10, 87, 3, 87, 3, 87, 3, 88, 3, 88,
+	3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 3, 89, 5, 89, 918, 10, 89, 3,
+	90, 3, 90, 3, 90, 5, 90, 923, 10, 90, 3, 91, 3, 91, 3, 91, 3, 91, 3, 91,
+	3, 91, 3, 91, 5, 91, 932, 10, 91, 3, 92, 3, 92, 3, 92, 5, 92, 937, 10,
+	92, 3, 93, 3, 93, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 3, 94, 5, 94, 947,
+	10, 94, 3, 95, 3, 95, 5, 95, 951, 10, 95, 3, 95, 3, 95, 3, 96, 3, 96, 3,
+	96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 3, 96, 5, 96, 964, 10, 96, 3, 97,
+	3, 97, 3, 97, 5, 97, 969, 10, 97, 5, 97, 971, 10, 97, 3, 97, 3, 97, 3,
+	98, 3, 98, 5, 98, 977, 10, 98, 3, 98, 7, 98, 980, 10, 98, 12, 98, 14, 98,
+	983, 11, 98, 3, 99, 3, 99, 3, 99, 5, 99, 988, 10, 99, 3, 99, 3, 99, 3,
+	100, 3, 100, 3, 100, 5, 100, 995, 10, 100, 3, 101, 3, 101, 5, 101, 999,
+	10, 101, 3, 102, 3, 102, 5, 102, 1003, 10, 102, 3, 102, 5, 102, 1006, 10,
+	102, 3, 102, 3, 102, 3, 102, 3, 102, 7, 102, 1012, 10, 102, 12, 102, 14,
+	102, 1015, 11, 102, 3, 102, 5, 102, 1018, 10, 102, 3, 103, 3, 103, 3, 103,
+	3, 103, 3, 103, 5, 103, 1025, 10, 103, 3, 103, 5, 103, 1028, 10, 103, 3,
+	103, 5, 103, 1031, 10, 103, 3, 104, 5, 104, 1034, 10, 104, 3, 104, 3, 104,
+	3, 105, 5, 105, 1039, 10, 105, 3, 105, 3, 105, 3, 106, 3, 106, 3, 106,
+	3, 107, 3, 107, 3, 107, 5, 107, 1049, 10, 107, 3, 107, 3, 107, 7, 107,
+	1053, 10, 107, 12, 107, 14, 107, 1056, 11, 107, 3, 108, 3, 108, 3, 108,
+	3, 108, 3, 108, 3, 108, 5, 108, 1064, 10, 108, 3, 109, 3, 109, 3, 109,
+	3, 110, 3, 110, 3, 110, 3, 110, 3, 111, 3, 111, 5, 111, 1075, 10, 111,
+	3, 111, 3, 111, 5, 111, 1079, 10, 111, 3, 111, 5, 111, 1082, 10, 111, 3,
+	111, 3, 111, 3, 111, 3, 111, 3, 111, 5, 111, 1089, 10, 111, 3, 111, 3,
+	111, 3, 112, 3, 112, 3, 112, 3, 112, 3, 112, 3, 113, 5, 113, 1099, 10,
+	113, 3, 113, 3, 113, 3, 113, 3, 113, 3, 113, 5, 113, 1106, 10, 113, 5,
+	113, 1108, 10, 113, 3, 113, 5, 113, 1111, 10, 113, 3, 113, 5, 113, 1114,
+	10, 113, 5, 113, 1116, 10, 113, 3, 113, 3, 113, 3, 114, 3, 114, 3, 114,
+	3, 114, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115, 3, 115,
+	3, 115, 3, 115, 5, 115, 1134, 10, 115, 3, 116, 3, 116, 3, 116, 3, 116,
+	3, 116, 3, 116, 3, 116, 7, 116, 1143, 10, 116, 12, 116, 14, 116, 1146,
+	11, 116, 3, 117, 3, 117, 3, 117, 5, 117, 1151, 10, 117, 3, 118, 3, 118,
+	3, 118, 3, 118, 5, 118, 1157, 10, 118, 3, 118, 3, 118, 3, 119, 3, 119,
+	3, 119, 3, 119, 5, 119, 1165, 10, 119, 3, 119, 2, 4, 212, 230, 120, 2,
+	4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40,
+	42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76,
+	78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110,
+	112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136, 138, 140,
+	142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166, 168, 170,
+	172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 196, 198, 200,
+	202, 204, 206, 208, 210, 212, 214, 216, 218, 220, 222, 224, 226, 228, 230,
+	232, 234, 236, 2, 14, 3, 2, 3, 4, 4, 2, 8, 8, 91, 91, 4, 2, 9, 9, 92, 92,
+	4, 2, 10, 10, 75, 75, 4, 2, 75, 75, 85, 85, 4, 2, 69, 69, 75, 75, 3, 2,
+	20, 21, 4, 2, 17, 17, 22, 31, 3, 2, 56, 57, 4, 2, 60, 60, 74, 74, 6, 2,
+	17, 17, 22, 31, 53, 54, 61, 66, 8, 2, 4, 4, 17, 17, 19, 19, 22, 23, 25,
+	25, 30, 30, 2, 1239, 2, 238, 3, 2, 2, 2, 4, 260, 3, 2, 2, 2, 6, 264, 3,
+	2, 2, 2, 8, 267, 3, 2, 2, 2, 10, 286, 3, 2, 2, 2, 12, 288, 3, 2, 2, 2,
+	14, 293, 3, 2, 2, 2, 16, 298, 3, 2, 2, 2, 18, 304, 3, 2, 2, 2, 20, 306,
+	3, 2, 2, 2, 22, 320, 3, 2, 2, 2, 24, 327, 3, 2, 2, 2, 26, 340, 3, 2, 2,
+	2, 28, 345, 3, 2, 2, 2, 30, 353, 3, 2, 2, 2, 32, 378, 3, 2, 2, 2, 34, 380,
+	3, 2, 2, 2, 36, 406, 3, 2, 2, 2, 38, 408, 3, 2, 2, 2, 40, 411, 3, 2, 2,
+	2, 42, 416, 3, 2, 2, 2, 44, 422, 3, 2, 2, 2, 46, 427, 3, 2, 2, 2, 48, 434,
+	3, 2, 2, 2, 50, 448, 3, 2, 2, 2, 52, 458, 3, 2, 2, 2, 54, 467, 3, 2, 2,
+	2, 56, 485, 3, 2, 2, 2, 58, 493, 3, 2, 2, 2, 60, 495, 3, 2, 2, 2, 62, 499,
+	3, 2, 2, 2, 64, 502, 3, 2, 2, 2, 66, 507, 3, 2, 2, 2, 68, 511, 3, 2, 2,
+	2, 70, 520, 3, 2, 2, 2, 72, 522, 3, 2, 2, 2, 74, 527, 3, 2, 2, 2, 76, 531,
+	3, 2, 2, 2, 78, 535, 3, 2, 2, 2, 80, 539, 3, 2, 2, 2, 82, 542, 3, 2, 2,
+	2, 84, 544, 3, 2, 2, 2, 86, 547, 3, 2, 2, 2, 88, 578, 3, 2, 2, 2, 90, 580,
+	3, 2, 2, 2, 92, 598, 3, 2, 2, 2, 94, 604, 3, 2, 2, 2, 96, 606, 3, 2, 2,
+	2, 98, 617, 3, 2, 2, 2, 100, 634, 3, 2, 2, 2, 102, 640, 3, 2, 2, 2, 104,
+	658, 3, 2, 2, 2, 106, 666, 3, 2, 2, 2, 108, 672, 3, 2, 2, 2, 110, 674,
+	3, 2, 2, 2, 112, 682, 3, 2, 2, 2, 114, 692, 3, 2, 2, 2, 116, 703, 3, 2,
+	2, 2, 118, 711, 3, 2, 2, 2, 120, 715, 3, 2, 2, 2, 122, 725, 3, 2, 2, 2,
+	124, 737, 3, 2, 2, 2, 126, 742, 3, 2, 2, 2, 128, 753, 3, 2, 2, 2, 130,
+	757, 3, 2, 2, 2, 132, 767, 3, 2, 2, 2, 134, 769, 3, 2, 2, 2, 136, 774,
+	3, 2, 2, 2, 138, 776, 3, 2, 2, 2, 140, 778, 3, 2, 2, 2, 142, 781, 3, 2,
+	2, 2, 144, 797, 3, 2, 2, 2, 146, 801, 3, 2, 2, 2, 148, 807, 3, 2, 2, 2,
+	150, 815, 3, 2, 2, 2, 152, 826, 3, 2, 2, 2, 154, 828, 3, 2, 2, 2, 156,
+	843, 3, 2, 2, 2, 158, 845, 3, 2, 2, 2, 160, 856, 3, 2, 2, 2, 162, 861,
+	3, 2, 2, 2, 164, 870, 3, 2, 2, 2, 166, 874, 3, 2, 2, 2, 168, 890, 3, 2,
+	2, 2, 170, 892, 3, 2, 2, 2, 172, 901, 3, 2, 2, 2, 174, 908, 3, 2, 2, 2,
+	176, 917, 3, 2, 2, 2, 178, 922, 3, 2, 2, 2, 180, 931, 3, 2, 2, 2, 182,
+	936, 3, 2, 2, 2, 184, 938, 3, 2, 2, 2, 186, 946, 3, 2, 2, 2, 188, 948,
+	3, 2, 2, 2, 190, 963, 3, 2, 2, 2, 192, 965, 3, 2, 2, 2, 194, 974, 3, 2,
+	2, 2, 196, 987, 3, 2, 2, 2, 198, 994, 3, 2, 2, 2, 200, 998, 3, 2, 2, 2,
+	202, 1000, 3, 2, 2, 2, 204, 1030, 3, 2, 2, 2, 206, 1033, 3, 2, 2, 2, 208,
+	1038, 3, 2, 2, 2, 210, 1042, 3, 2, 2, 2, 212, 1048, 3, 2, 2, 2, 214, 1063,
+	3, 2, 2, 2, 216, 1065, 3, 2, 2, 2, 218, 1068, 3, 2, 2, 2, 220, 1072, 3,
+	2, 2, 2, 222, 1092, 3, 2, 2, 2, 224, 1098, 3, 2, 2, 2, 226, 1119, 3, 2,
+	2, 2, 228, 1133, 3, 2, 2, 2, 230, 1135, 3, 2, 2, 2, 232, 1150, 3, 2, 2,
+	2, 234, 1152, 3, 2, 2, 2, 236, 1164, 3, 2, 2, 2, 238, 239, 5, 6, 4, 2,
+	239, 245, 5, 236, 119, 2, 240, 241, 5, 8, 5, 2, 241, 242, 5, 236, 119,
+	2, 242, 244, 3, 2, 2, 2, 243, 240, 3, 2, 2, 2, 244, 247, 3, 2, 2, 2, 245,
+	243, 3, 2, 2, 2, 245, 246, 3, 2, 2, 2, 246, 253, 3, 2, 2, 2, 247, 245,
+	3, 2, 2, 2, 248, 249, 5, 16, 9, 2, 249, 250, 5, 236, 119, 2, 250, 252,
+	3, 2, 2, 2, 251, 248, 3, 2, 2, 2, 252, 255, 3, 2, 2, 2, 253, 251, 3, 2,
+	2, 2, 253, 254, 3, 2, 2, 2, 254, 256, 3, 2, 2, 2, 255, 253, 3, 2, 2, 2,
+	256, 257, 7, 2, 2, 3, 257, 3, 3, 2, 2, 2, 258, 261, 5, 16, 9, 2, 259, 261,
+	5, 56, 29, 2, 260, 258, 3, 2, 2, 2, 260, 259, 3, 2, 2, 2, 261, 262, 3,
+	2, 2, 2, 262, 263, 7, 2, 2, 3, 263, 5, 3, 2, 2, 2, 264, 265, 9, 2, 2, 2,
+	265, 266, 7, 75, 2, 2, 266, 7, 3, 2, 2, 2, 267, 273, 7, 5, 2, 2, 268, 274,
+	5, 10, 6, 2, 269, 270, 7, 6, 2, 2, 270, 271, 5, 10, 6, 2, 271, 272, 7,
+	7, 2, 2, 272, 274, 3, 2, 2, 2, 273, 268, 3, 2, 2, 2, 273, 269, 3, 2, 2,
+	2, 274, 9, 3, 2, 2, 2, 275, 287, 5, 12, 7, 2, 276, 282, 9, 3, 2, 2, 277,
+	278, 5, 12, 7, 2, 278, 279, 5, 236, 119, 2, 279, 281, 3, 2, 2, 2, 280,
+	277, 3, 2, 2, 2, 281, 284, 3, 2, 2, 2, 282, 280, 3, 2, 2, 2, 282, 283,
+	3, 2, 2, 2, 283, 285, 3, 2, 2, 2, 284, 282, 3, 2, 2, 2, 285, 287, 9, 4,
+	2, 2, 286, 275, 3, 2, 2, 2, 286, 276, 3, 2, 2, 2, 287, 11, 3, 2, 2, 2,
+	288, 291, 5, 14, 8, 2, 289, 290, 7, 68, 2, 2, 290, 292, 9, 5, 2, 2, 291,
+	289, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2, 292, 13, 3, 2, 2, 2, 293, 294, 9,
+	6, 2, 2, 294, 15, 3, 2, 2, 2, 295, 299, 5, 18, 10, 2, 296, 299, 5, 40,
+	21, 2, 297, 299, 5, 44, 23, 2, 298, 295, 3, 2, 2, 2, 298, 296, 3, 2, 2,
+	2, 298, 297, 3, 2, 2, 2, 299, 17, 3, 2, 2, 2, 300, 305, 5, 20, 11, 2, 301,
+	305, 5, 32, 17, 2, 302, 305, 5, 48, 25, 2, 303, 305, 5, 24, 13, 2, 304,
+	300, 3, 2, 2, 2, 304, 301, 3, 2, 2, 2, 304, 302, 3, 2, 2, 2, 304, 303,
+	3, 2, 2, 2, 305, 19, 3, 2, 2, 2, 306, 318, 7, 11, 2, 2, 307, 319, 5, 22,
+	12, 2, 308, 314, 7, 6, 2, 2, 309, 310, 5, 22, 12, 2, 310, 311, 5, 236,
+	119, 2, 311, 313, 3, 2, 2, 2, 312, 309, 3, 2, 2, 2, 313, 316, 3, 2, 2,
+	2, 314, 312, 3, 2, 2, 2, 314, 315, 3, 2, 2, 2, 315, 317, 3, 2, 2, 2, 316,
+	314, 3, 2, 2, 2, 317, 319, 7, 7, 2, 2, 318, 307, 3, 2, 2, 2, 318, 308,
+	3, 2, 2, 2, 319, 21, 3, 2, 2, 2, 320, 322, 5, 28, 15, 2, 321, 323, 5, 128,
+	65, 2, 322, 321, 3, 2, 2, 2, 322, 323, 3, 2, 2, 2, 323, 324, 3, 2, 2, 2,
+	324, 325, 7, 12, 2, 2, 325, 326, 5, 30, 16, 2, 326, 23, 3, 2, 2, 2, 327,
+	328, 7, 13, 2, 2, 328, 329, 7, 75, 2, 2, 329, 335, 9, 3, 2, 2, 330, 331,
+	5, 26, 14, 2, 331, 332, 5, 236, 119, 2, 332, 334, 3, 2, 2, 2, 333, 330,
+	3, 2, 2, 2, 334, 337, 3, 2, 2, 2, 335, 333, 3, 2, 2, 2, 335, 336, 3, 2,
+	2, 2, 336, 338, 3, 2, 2, 2, 337, 335, 3, 2, 2, 2, 338, 339, 9, 4, 2, 2,
+	339, 25, 3, 2, 2, 2, 340, 343, 5, 28, 15, 2, 341, 342, 7, 12, 2, 2, 342,
+	344, 5, 30, 16, 2, 343, 341, 3, 2, 2, 2, 343, 344, 3, 2, 2, 2, 344, 27,
+	3, 2, 2, 2, 345, 350, 9, 7, 2, 2, 346, 347, 7, 14, 2, 2, 347, 349, 9, 7,
+	2, 2, 348, 346, 3, 2, 2, 2, 349, 352, 3, 2, 2, 2, 350, 348, 3, 2, 2, 2,
+	350, 351, 3, 2, 2, 2, 351, 29, 3, 2, 2, 2, 352, 350, 3, 2, 2, 2, 353, 358,
+	5, 230, 116, 2, 354, 355, 7, 14, 2, 2, 355, 357, 5, 230, 116, 2, 356, 354,
+	3, 2, 2, 2, 357, 360, 3, 2, 2, 2, 358, 356, 3, 2, 2, 2, 358, 359, 3, 2,
+	2, 2, 359, 31, 3, 2, 2, 2, 360, 358, 3, 2, 2, 2, 361, 373, 7, 15, 2, 2,
+	362, 374, 5, 38, 20, 2, 363, 369, 7, 6, 2, 2, 364, 365, 5, 38, 20, 2, 365,
+	366, 5, 236, 119, 2, 366, 368, 3, 2, 2, 2, 367, 364, 3, 2, 2, 2, 368, 371,
+	3, 2, 2, 2, 369, 367, 3, 2, 2, 2, 369, 370, 3, 2, 2, 2, 370, 372, 3, 2,
+	2, 2, 371, 369, 3, 2, 2, 2, 372, 374, 7, 7, 2, 2, 373, 362, 3, 2, 2, 2,
+	373, 363, 3, 2, 2, 2, 374, 379, 3, 2, 2, 2, 375, 379, 5, 202, 102, 2, 376,
+	379, 5, 142, 72, 2, 377, 379, 5, 34, 18, 2, 378, 361, 3, 2, 2, 2, 378,
+	375, 3, 2, 2, 2, 378, 376, 3, 2, 2, 2, 378, 377, 3, 2, 2, 2, 379, 33, 3,
+	2, 2, 2, 380, 381, 6, 18, 2, 2, 381, 382, 7, 75, 2, 2, 382, 383, 7, 75,
+	2, 2, 383, 389, 9, 3, 2, 2, 384, 385, 5, 36, 19, 2, 385, 386, 5, 236, 119,
+	2, 386, 388, 3, 2, 2, 2, 387, 384, 3, 2, 2, 2, 388, 391, 3, 2, 2, 2, 389,
+	387, 3, 2, 2, 2, 389, 390, 3, 2, 2, 2, 390, 392, 3, 2, 2, 2, 391, 389,
+	3, 2, 2, 2, 392, 393, 9, 4, 2, 2, 393, 35, 3, 2, 2, 2, 394, 395, 7, 75,
+	2, 2, 395, 401, 9, 3, 2, 2, 396, 397, 5, 204, 103, 2, 397, 398, 5, 236,
+	119, 2, 398, 400, 3, 2, 2, 2, 399, 396, 3, 2, 2, 2, 400, 403, 3, 2, 2,
+	2, 401, 399, 3, 2, 2, 2, 401, 402, 3, 2, 2, 2, 402, 404, 3, 2, 2, 2, 403,
+	401, 3, 2, 2, 2, 404, 407, 9, 4, 2, 2, 405, 407, 5, 204, 103, 2, 406, 394,
+	3, 2, 2, 2, 406, 405, 3, 2, 2, 2, 407, 37, 3, 2, 2, 2, 408, 409, 7, 75,
+	2, 2, 409, 410, 5, 128, 65, 2, 410, 39, 3, 2, 2, 2, 411, 414, 7, 75, 2,
+	2, 412, 415, 5, 42, 22, 2, 413, 415, 5, 156, 79, 2, 414, 412, 3, 2, 2,
+	2, 414, 413, 3, 2, 2, 2, 415, 41, 3, 2, 2, 2, 416, 417, 5, 156, 79, 2,
+	417, 420, 7, 78, 2, 2, 418, 421, 5, 52, 27, 2, 419, 421, 5, 56, 29, 2,
+	420, 418, 3, 2, 2, 2, 420, 419, 3, 2, 2, 2, 421, 43, 3, 2, 2, 2, 422, 425,
+	5, 46, 24, 2, 423, 426, 5, 42, 22, 2, 424, 426, 5, 156, 79, 2, 425, 423,
+	3, 2, 2, 2, 425, 424, 3, 2, 2, 2, 426, 45, 3, 2, 2, 2, 427, 428, 7, 75,
+	2, 2, 428, 430, 7, 16, 2, 2, 429, 431, 7, 17, 2, 2, 430, 429, 3, 2, 2,
+	2, 430, 431, 3, 2, 2, 2, 431, 432, 3, 2, 2, 2, 432, 433, 7, 75, 2, 2, 433,
+	47, 3, 2, 2, 2, 434, 446, 7, 18, 2, 2, 435, 447, 5, 50, 26, 2, 436, 442,
+	7, 6, 2, 2, 437, 438, 5, 50, 26, 2, 438, 439, 5, 236, 119, 2, 439, 441,
+	3, 2, 2, 2, 440, 437, 3, 2, 2, 2, 441, 444, 3, 2, 2, 2, 442, 440, 3, 2,
+	2, 2, 442, 443, 3, 2, 2, 2, 443, 445, 3, 2, 2, 2, 444, 442, 3, 2, 2, 2,
+	445, 447, 7, 7, 2, 2, 446, 435, 3, 2, 2, 2, 446, 436, 3, 2, 2, 2, 447,
+	49, 3, 2, 2, 2, 448, 456, 5, 28, 15, 2, 449, 452, 5, 128, 65, 2, 450, 451,
+	7, 12, 2, 2, 451, 453, 5, 56, 29, 2, 452, 450, 3, 2, 2, 2, 452, 453, 3,
+	2, 2, 2, 453, 457, 3, 2, 2, 2, 454, 455, 7, 12, 2, 2, 455, 457, 5, 30,
+	16, 2, 456, 449, 3, 2, 2, 2, 456, 454, 3, 2, 2, 2, 457, 51, 3, 2, 2, 2,
+	458, 459, 9, 3, 2, 2, 459, 460, 5, 54, 28, 2, 460, 461, 9, 4, 2, 2, 461,
+	53, 3, 2, 2, 2, 462, 463, 5, 56, 29, 2, 463, 464, 5, 236, 119, 2, 464,
+	466, 3, 2, 2, 2, 465, 462, 3, 2, 2, 2, 466, 469, 3, 2, 2, 2, 467, 465,
+	3, 2, 2, 2, 467, 468, 3, 2, 2, 2, 468, 55, 3, 2, 2, 2, 469, 467, 3, 2,
+	2, 2, 470, 486, 5, 120, 61, 2, 471, 486, 5, 58, 30, 2, 472, 486, 5, 126,
+	64, 2, 473, 486, 5, 74, 38, 2, 474, 486, 5, 76, 39, 2, 475, 486, 5, 78,
+	40, 2, 476, 486, 5, 80, 41, 2, 477, 486, 5, 82, 42, 2, 478, 486, 5, 86,
+	44, 2, 479, 486, 5, 88, 45, 2, 480, 486, 5, 112, 57, 2, 481, 486, 5, 84,
+	43, 2, 482, 486, 5, 72, 37, 2, 483, 486, 5, 52, 27, 2, 484, 486, 5, 18,
+	10, 2, 485, 470, 3, 2, 2, 2, 485, 471, 3, 2, 2, 2, 485, 472, 3, 2, 2, 2,
+	485, 473, 3, 2, 2, 2, 485, 474, 3, 2, 2, 2, 485, 475, 3, 2, 2, 2, 485,
+	476, 3, 2, 2, 2, 485, 477, 3, 2, 2, 2, 485, 478, 3, 2, 2, 2, 485, 479,
+	3, 2, 2, 2, 485, 480, 3, 2, 2, 2, 485, 481, 3, 2, 2, 2, 485, 482, 3, 2,
+	2, 2, 485, 483, 3, 2, 2, 2, 485, 484, 3, 2, 2, 2, 486, 57, 3, 2, 2, 2,
+	487, 494, 5, 60, 31, 2, 488, 494, 5, 62, 32, 2, 489, 494, 5, 68, 35, 2,
+	490, 494, 5, 64, 33, 2, 491, 494, 5, 230, 116, 2, 492, 494, 5, 70, 36,
+	2, 493, 487, 3, 2, 2, 2, 493, 488, 3, 2, 2, 2, 493, 489, 3, 2, 2, 2, 493,
+	490, 3, 2, 2, 2, 493, 491, 3, 2, 2, 2, 493, 492, 3, 2, 2, 2, 494, 59, 3,
+	2, 2, 2, 495, 496, 5, 230, 116, 2, 496, 497, 7, 19, 2, 2, 497, 498, 5,
+	230, 116, 2, 498, 61, 3, 2, 2, 2, 499, 500, 5, 230, 116, 2, 500, 501, 9,
+	8, 2, 2, 501, 63, 3, 2, 2, 2, 502, 503, 5, 30, 16, 2, 503, 504, 5, 66,
+	34, 2, 504, 505, 5, 30, 16, 2, 505, 65, 3, 2, 2, 2, 506, 508, 9, 9, 2,
+	2, 507, 506, 3, 2, 2, 2, 507, 508, 3, 2, 2, 2, 508, 509, 3, 2, 2, 2, 509,
+	510, 7, 12, 2, 2, 510, 67, 3, 2, 2, 2, 511, 512, 5, 28, 15, 2, 512, 518,
+	7, 32, 2, 2, 513, 519, 5, 30, 16, 2, 514, 519, 5, 86, 44, 2, 515, 519,
+	5, 88, 45, 2, 516, 519, 5, 112, 57, 2, 517, 519, 5, 120, 61, 2, 518, 513,
+	3, 2, 2, 2, 518, 514, 3, 2, 2, 2, 518, 515, 3, 2, 2, 2, 518, 516, 3, 2,
+	2, 2, 518, 517, 3, 2, 2, 2, 519, 69, 3, 2, 2, 2, 520, 521, 7, 67, 2, 2,
+	521, 71, 3, 2, 2, 2, 522, 523, 7, 33, 2, 2, 523, 524, 7, 75, 2, 2, 524,
+	525, 7, 68, 2, 2, 525, 526, 5, 56, 29, 2, 526, 73, 3, 2, 2, 2, 527, 529,
+	7, 34, 2, 2, 528, 530, 5, 30, 16, 2, 529, 528, 3, 2, 2, 2, 529, 530, 3,
+	2, 2, 2, 530, 75, 3, 2, 2, 2, 531, 533, 7, 35, 2, 2, 532, 534, 7, 75, 2,
+	2, 533, 532, 3, 2, 2, 2, 533, 534, 3, 2, 2, 2, 534, 77, 3, 2, 2, 2, 535,
+	537, 7, 36, 2, 2, 536, 538, 7, 75, 2, 2, 537, 536, 3, 2, 2, 2, 537, 538,
+	3, 2, 2, 2, 538, 79, 3, 2, 2, 2, 539, 540, 7, 37, 2, 2, 540, 541, 7, 75,
+	2, 2, 541, 81, 3, 2, 2, 2, 542, 543, 7, 38, 2, 2, 543, 83, 3, 2, 2, 2,
+	544, 545, 7, 39, 2, 2, 545, 546, 5, 230, 116, 2, 546, 85, 3, 2, 2, 2, 547,
+	551, 7, 72, 2, 2, 548, 549, 5, 58, 30, 2, 549, 550, 7, 67, 2, 2, 550, 552,
+	3, 2, 2, 2, 551, 548, 3, 2, 2, 2, 551, 552, 3, 2, 2, 2, 552, 553, 3, 2,
+	2, 2, 553, 560, 5, 230, 116, 2, 554, 555, 7, 70, 2, 2, 555, 556, 5, 56,
+	29, 2, 556, 557, 5, 236, 119, 2, 557, 561, 3, 2, 2, 2, 558, 559, 7, 67,
+	2, 2, 559, 561, 5, 52, 27, 2, 560, 554, 3, 2, 2, 2, 560, 558, 3, 2, 2,
+	2, 561, 573, 3, 2, 2, 2, 562, 571, 7, 40, 2, 2, 563, 572, 5, 86, 44, 2,
+	564, 565, 7, 70, 2, 2, 565, 566, 5, 56, 29, 2, 566, 567, 5, 236, 119, 2,
+	567, 570, 3, 2, 2, 2, 568, 570, 5, 52, 27, 2, 569, 564, 3, 2, 2, 2, 569,
+	568, 3, 2, 2, 2, 570, 572, 3, 2, 2, 2, 571, 563, 3, 2, 2, 2, 571, 569,
+	3, 2, 2, 2, 572, 574, 3, 2, 2, 2, 573, 562, 3, 2, 2, 2, 573, 574, 3, 2,
+	2, 2, 574, 87, 3, 2, 2, 2, 575, 579, 5, 90, 46, 2, 576, 579, 5, 102, 52,
+	2, 577, 579, 5, 96, 49, 2, 578, 575, 3, 2, 2, 2, 578, 576, 3, 2, 2, 2,
+	578, 577, 3, 2, 2, 2, 579, 89, 3, 2, 2, 2, 580, 584, 7, 41, 2, 2, 581,
+	582, 5, 58, 30, 2, 582, 583, 7, 67, 2, 2, 583, 585, 3, 2, 2, 2, 584, 581,
+	3, 2, 2, 2, 584, 585, 3, 2, 2, 2, 585, 587, 3, 2, 2, 2, 586, 588, 5, 230,
+	116, 2, 587, 586, 3, 2, 2, 2, 587, 588, 3, 2, 2, 2, 588, 589, 3, 2, 2,
+	2, 589, 593, 9, 3, 2, 2, 590, 592, 5, 92, 47, 2, 591, 590, 3, 2, 2, 2,
+	592, 595, 3, 2, 2, 2, 593, 591, 3, 2, 2, 2, 593, 594, 3, 2, 2, 2, 594,
+	596, 3, 2, 2, 2, 595, 593, 3, 2, 2, 2, 596, 597, 9, 4, 2, 2, 597, 91, 3,
+	2, 2, 2, 598, 599, 5, 94, 48, 2, 599, 600, 7, 70, 2, 2, 600, 601, 5, 54,
+	28, 2, 601, 93, 3, 2, 2, 2, 602, 605, 5, 30, 16, 2, 603, 605, 7, 69, 2,
+	2, 604, 602, 3, 2, 2, 2, 604, 603, 3, 2, 2, 2, 605, 95, 3, 2, 2, 2, 606,
+	607, 7, 42, 2, 2, 607, 608, 5, 230, 116, 2, 608, 612, 9, 3, 2, 2, 609,
+	611, 5, 98, 50, 2, 610, 609, 3, 2, 2, 2, 611, 614, 3, 2, 2, 2, 612, 610,
+	3, 2, 2, 2, 612, 613, 3, 2, 2, 2, 613, 615, 3, 2, 2, 2, 614, 612, 3, 2,
+	2, 2, 615, 616, 9, 4, 2, 2, 616, 97, 3, 2, 2, 2, 617, 622, 5, 100, 51,
+	2, 618, 619, 7, 14, 2, 2, 619, 621, 5, 100, 51, 2, 620, 618, 3, 2, 2, 2,
+	621, 624, 3, 2, 2, 2, 622, 620, 3, 2, 2, 2, 622, 623, 3, 2, 2, 2, 623,
+	627, 3, 2, 2, 2, 624, 622, 3, 2, 2, 2, 625, 626, 7, 72, 2, 2, 626, 628,
+	5, 230, 116, 2, 627, 625, 3, 2, 2, 2, 627, 628, 3, 2, 2, 2, 628, 629, 3,
+	2, 2, 2, 629, 630, 7, 70, 2, 2, 630, 631, 5, 54, 28, 2, 631, 99, 3, 2,
+	2, 2, 632, 635, 7, 69, 2, 2, 633, 635, 5, 230, 116, 2, 634, 632, 3, 2,
+	2, 2, 634, 633, 3, 2, 2, 2, 635, 638, 3, 2, 2, 2, 636, 637, 7, 43, 2, 2,
+	637, 639, 7, 75, 2, 2, 638, 636, 3, 2, 2, 2, 638, 639, 3, 2, 2, 2, 639,
+	101, 3, 2, 2, 2, 640, 644, 7, 41, 2, 2, 641, 642, 5, 58, 30, 2, 642, 643,
+	7, 67, 2, 2, 643, 645, 3, 2, 2, 2, 644, 641, 3, 2, 2, 2, 644, 645, 3, 2,
+	2, 2, 645, 646, 3, 2, 2, 2, 646, 647, 5, 104, 53, 2, 647, 651, 9, 3, 2,
+	2, 648, 650, 5, 106, 54, 2, 649, 648, 3, 2, 2, 2, 650, 653, 3, 2, 2, 2,
+	651, 649, 3, 2, 2, 2, 651, 652, 3, 2, 2, 2, 652, 654, 3, 2, 2, 2, 653,
+	651, 3, 2, 2, 2, 654, 655, 9, 4, 2, 2, 655, 103, 3, 2, 2, 2, 656, 657,
+	7, 75, 2, 2, 657, 659, 7, 32, 2, 2, 658, 656, 3, 2, 2, 2, 658, 659, 3,
+	2, 2, 2, 659, 660, 3, 2, 2, 2, 660, 661, 5, 212, 107, 2, 661, 662, 7, 10,
+	2, 2, 662, 663, 7, 6, 2, 2, 663, 664, 7, 15, 2, 2, 664, 665, 7, 7, 2, 2,
+	665, 105, 3, 2, 2, 2, 666, 667, 5, 108, 55, 2, 667, 668, 7, 70, 2, 2, 668,
+	669, 5, 54, 28, 2, 669, 107, 3, 2, 2, 2, 670, 673, 5, 110, 56, 2, 671,
+	673, 7, 69, 2, 2, 672, 670, 3, 2, 2, 2, 672, 671, 3, 2, 2, 2, 673, 109,
+	3, 2, 2, 2, 674, 679, 5, 128, 65, 2, 675, 676, 7, 14, 2, 2, 676, 678, 5,
+	128, 65, 2, 677, 675, 3, 2, 2, 2, 678, 681, 3, 2, 2, 2, 679, 677, 3, 2,
+	2, 2, 679, 680, 3, 2, 2, 2, 680, 111, 3, 2, 2, 2, 681, 679, 3, 2, 2, 2,
+	682, 683, 7, 44, 2, 2, 683, 687, 9, 3, 2, 2, 684, 686, 5, 114, 58, 2, 685,
+	684, 3, 2, 2, 2, 686, 689, 3, 2, 2, 2, 687, 685, 3, 2, 2, 2, 687, 688,
+	3, 2, 2, 2, 688, 690, 3, 2, 2, 2, 689, 687, 3, 2, 2, 2, 690, 691, 9, 4,
+	2, 2, 691, 113, 3, 2, 2, 2, 692, 693, 5, 116, 59, 2, 693, 696, 7, 70, 2,
+	2, 694, 697, 5, 52, 27, 2, 695, 697, 5, 56, 29, 2, 696, 694, 3, 2, 2, 2,
+	696, 695, 3, 2, 2, 2, 697, 115, 3, 2, 2, 2, 698, 701, 5, 60, 31, 2, 699,
+	701, 5, 118, 60, 2, 700, 698, 3, 2, 2, 2, 700, 699, 3, 2, 2, 2, 701, 704,
+	3, 2, 2, 2, 702, 704, 7, 69, 2, 2, 703, 700, 3, 2, 2, 2, 703, 702, 3, 2,
+	2, 2, 704, 117, 3, 2, 2, 2, 705, 706, 5, 30, 16, 2, 706, 707, 7, 12, 2,
+	2, 707, 712, 3, 2, 2, 2, 708, 709, 5, 28, 15, 2, 709, 710, 7, 32, 2, 2,
+	710, 712, 3, 2, 2, 2, 711, 705, 3, 2, 2, 2, 711, 708, 3, 2, 2, 2, 711,
+	712, 3, 2, 2, 2, 712, 713, 3, 2, 2, 2, 713, 714, 5, 230, 116, 2, 714, 119,
+	3, 2, 2, 2, 715, 719, 7, 73, 2, 2, 716, 720, 5, 230, 116, 2, 717, 720,
+	5, 124, 63, 2, 718, 720, 5, 122, 62, 2, 719, 716, 3, 2, 2, 2, 719, 717,
+	3, 2, 2, 2, 719, 718, 3, 2, 2, 2, 719, 720, 3, 2, 2, 2, 720, 721, 3, 2,
+	2, 2, 721, 722, 7, 67, 2, 2, 722, 723, 5, 52, 27, 2, 723, 121, 3, 2, 2,
+	2, 724, 726, 5, 58, 30, 2, 725, 724, 3, 2, 2, 2, 725, 726, 3, 2, 2, 2,
+	726, 727, 3, 2, 2, 2, 727, 729, 7, 67, 2, 2, 728, 730, 5, 230, 116, 2,
+	729, 728, 3, 2, 2, 2, 729, 730, 3, 2, 2, 2, 730, 731, 3, 2, 2, 2, 731,
+	733, 7, 67, 2, 2, 732, 734, 5, 58, 30, 2, 733, 732, 3, 2, 2, 2, 733, 734,
+	3, 2, 2, 2, 734, 123, 3, 2, 2, 2, 735, 738, 5, 28, 15, 2, 736, 738, 5,
+	30, 16, 2, 737, 735, 3, 2, 2, 2, 737, 736, 3, 2, 2, 2, 738, 739, 3, 2,
+	2, 2, 739, 740, 7, 45, 2, 2, 740, 741, 5, 230, 116, 2, 741, 125, 3, 2,
+	2, 2, 742, 745, 7, 46, 2, 2, 743, 746, 5, 42, 22, 2, 744, 746, 5, 230,
+	116, 2, 745, 743, 3, 2, 2, 2, 745, 744, 3, 2, 2, 2, 746, 127, 3, 2, 2,
+	2, 747, 754, 5, 130, 66, 2, 748, 754, 5, 132, 67, 2, 749, 750, 7, 6, 2,
+	2, 750, 751, 5, 128, 65, 2, 751, 752, 7, 7, 2, 2, 752, 754, 3, 2, 2, 2,
+	753, 747, 3, 2, 2, 2, 753, 748, 3, 2, 2, 2, 753, 749, 3, 2, 2, 2, 754,
+	129, 3, 2, 2, 2, 755, 758, 5, 186, 94, 2, 756, 758, 7, 75, 2, 2, 757, 755,
+	3, 2, 2, 2, 757, 756, 3, 2, 2, 2, 758, 131, 3, 2, 2, 2, 759, 768, 5, 134,
+	68, 2, 760, 768, 5, 202, 102, 2, 761, 768, 5, 140, 71, 2, 762, 768, 5,
+	154, 78, 2, 763, 768, 5, 142, 72, 2, 764, 768, 5, 144, 73, 2, 765, 768,
+	5, 146, 74, 2, 766, 768, 5, 148, 75, 2, 767, 759, 3, 2, 2, 2, 767, 760,
+	3, 2, 2, 2, 767, 761, 3, 2, 2, 2, 767, 762, 3, 2, 2, 2, 767, 763, 3, 2,
+	2, 2, 767, 764, 3, 2, 2, 2, 767, 765, 3, 2, 2, 2, 767, 766, 3, 2, 2, 2,
+	768, 133, 3, 2, 2, 2, 769, 770, 7, 47, 2, 2, 770, 771, 5, 136, 69, 2, 771,
+	772, 7, 48, 2, 2, 772, 773, 5, 138, 70, 2, 773, 135, 3, 2, 2, 2, 774, 775,
+	5, 230, 116, 2, 775, 137, 3, 2, 2, 2, 776, 777, 5, 128, 65, 2, 777, 139,
+	3, 2, 2, 2, 778, 779, 7, 17, 2, 2, 779, 780, 5, 128, 65, 2, 780, 141, 3,
+	2, 2, 2, 781, 783, 7, 49, 2, 2, 782, 784, 7, 75, 2, 2, 783, 782, 3, 2,
+	2, 2, 783, 784, 3, 2, 2, 2, 784, 795, 3, 2, 2, 2, 785, 791, 9, 3, 2, 2,
+	786, 787, 5, 152, 77, 2, 787, 788, 5, 236, 119, 2, 788, 790, 3, 2, 2, 2,
+	789, 786, 3, 2, 2, 2, 790, 793, 3, 2, 2, 2, 791, 789, 3, 2, 2, 2, 791,
+	792, 3, 2, 2, 2, 792, 794, 3, 2, 2, 2, 793, 791, 3, 2, 2, 2, 794, 796,
+	9, 4, 2, 2, 795, 785, 3, 2, 2, 2, 795, 796, 3, 2, 2, 2, 796, 143, 3, 2,
+	2, 2, 797, 798, 7, 47, 2, 2, 798, 799, 7, 48, 2, 2, 799, 800, 5, 138, 70,
+	2, 800, 145, 3, 2, 2, 2, 801, 802, 7, 50, 2, 2, 802, 803, 7, 47, 2, 2,
+	803, 804, 5, 128, 65, 2, 804, 805, 7, 48, 2, 2, 805, 806, 5, 138, 70, 2,
+	806, 147, 3, 2, 2, 2, 807, 808, 5, 150, 76, 2, 808, 809, 5, 138, 70, 2,
+	809, 149, 3, 2, 2, 2, 810, 816, 7, 51, 2, 2, 811, 812, 7, 51, 2, 2, 812,
+	816, 7, 19, 2, 2, 813, 814, 7, 19, 2, 2, 814, 816, 7, 51, 2, 2, 815, 810,
+	3, 2, 2, 2, 815, 811, 3, 2, 2, 2, 815, 813, 3, 2, 2, 2, 816, 151, 3, 2,
+	2, 2, 817, 818, 6, 77, 3, 2, 818, 819, 7, 75, 2, 2, 819, 820, 5, 168, 85,
+	2, 820, 821, 7, 68, 2, 2, 821, 822, 5, 166, 84, 2, 822, 827, 3, 2, 2, 2,
+	823, 827, 5, 130, 66, 2, 824, 825, 7, 75, 2, 2, 825, 827, 5, 168, 85, 2,
+	826, 817, 3, 2, 2, 2, 826, 823, 3, 2, 2, 2, 826, 824, 3, 2, 2, 2, 827,
+	153, 3, 2, 2, 2, 828, 829, 7, 52, 2, 2, 829, 830, 5, 156, 79, 2, 830, 155,
+	3, 2, 2, 2, 831, 833, 6, 79, 4, 2, 832, 834, 5, 158, 80, 2, 833, 832, 3,
+	2, 2, 2, 833, 834, 3, 2, 2, 2, 834, 835, 3, 2, 2, 2, 835, 836, 5, 168,
+	85, 2, 836, 837, 7, 68, 2, 2, 837, 838, 5, 166, 84, 2, 838, 844, 3, 2,
+	2, 2, 839, 841, 5, 158, 80, 2, 840, 839, 3, 2, 2, 2, 840, 841, 3, 2, 2,
+	2, 841, 842, 3, 2, 2, 2, 842, 844, 5, 168, 85, 2, 843, 831, 3, 2, 2, 2,
+	843, 840, 3, 2, 2, 2, 844, 157, 3, 2, 2, 2, 845, 846, 7, 53, 2, 2, 846,
+	851, 5, 160, 81, 2, 847, 848, 7, 14, 2, 2, 848, 850, 5, 160, 81, 2, 849,
+	847, 3, 2, 2, 2, 850, 853, 3, 2, 2, 2, 851, 849, 3, 2, 2, 2, 851, 852,
+	3, 2, 2, 2, 852, 854, 3, 2, 2, 2, 853, 851, 3, 2, 2, 2, 854, 855, 7, 54,
+	2, 2, 855, 159, 3, 2, 2, 2, 856, 859, 5, 128, 65, 2, 857, 858, 7, 68, 2,
+	2, 858, 860, 5, 162, 82, 2, 859, 857, 3, 2, 2, 2, 859, 860, 3, 2, 2, 2,
+	860, 161, 3, 2, 2, 2, 861, 866, 5, 164, 83, 2, 862, 863, 7, 24, 2, 2, 863,
+	865, 5, 164, 83, 2, 864, 862, 3, 2, 2, 2, 865, 868, 3, 2, 2, 2, 866, 864,
+	3, 2, 2, 2, 866, 867, 3, 2, 2, 2, 867, 163, 3, 2, 2, 2, 868, 866, 3, 2,
+	2, 2, 869, 871, 7, 33, 2, 2, 870, 869, 3, 2, 2, 2, 870, 871, 3, 2, 2, 2,
+	871, 872, 3, 2, 2, 2, 872, 873, 5, 128, 65, 2, 873, 165, 3, 2, 2, 2, 874,
+	879, 5, 128, 65, 2, 875, 876, 7, 14, 2, 2, 876, 878, 5, 128, 65, 2, 877,
+	875, 3, 2, 2, 2, 878, 881, 3, 2, 2, 2, 879, 877, 3, 2, 2, 2, 879, 880,
+	3, 2, 2, 2, 880, 167, 3, 2, 2, 2, 881, 879, 3, 2, 2, 2, 882, 887, 7, 6,
+	2, 2, 883, 885, 5, 170, 86, 2, 884, 886, 7, 14, 2, 2, 885, 884, 3, 2, 2,
+	2, 885, 886, 3, 2, 2, 2, 886, 888, 3, 2, 2, 2, 887, 883, 3, 2, 2, 2, 887,
+	888, 3, 2, 2, 2, 888, 889, 3, 2, 2, 2, 889, 891, 7, 7, 2, 2, 890, 882,
+	3, 2, 2, 2, 890, 891, 3, 2, 2, 2, 891, 169, 3, 2, 2, 2, 892, 897, 5, 172,
+	87, 2, 893, 894, 7, 14, 2, 2, 894, 896, 5, 172, 87, 2, 895, 893, 3, 2,
+	2, 2, 896, 899, 3, 2, 2, 2, 897, 895, 3, 2, 2, 2, 897, 898, 3, 2, 2, 2,
+	898, 171, 3, 2, 2, 2, 899, 897, 3, 2, 2, 2, 900, 902, 5, 28, 15, 2, 901,
+	900, 3, 2, 2, 2, 901, 902, 3, 2, 2, 2, 902, 904, 3, 2, 2, 2, 903, 905,
+	5, 174, 88, 2, 904, 903, 3, 2, 2, 2, 904, 905, 3, 2, 2, 2, 905, 906, 3,
+	2, 2, 2, 906, 907, 5, 128, 65, 2, 907, 173, 3, 2, 2, 2, 908, 909, 7, 55,
+	2, 2, 909, 175, 3, 2, 2, 2, 910, 918, 5, 178, 90, 2, 911, 918, 5, 182,
+	92, 2, 912, 918, 5, 226, 114, 2, 913, 914, 7, 6, 2, 2, 914, 915, 5, 230,
+	116, 2, 915, 916, 7, 7, 2, 2, 916, 918, 3, 2, 2, 2, 917, 910, 3, 2, 2,
+	2, 917, 911, 3, 2, 2, 2, 917, 912, 3, 2, 2, 2, 917, 913, 3, 2, 2, 2, 918,
+	177, 3, 2, 2, 2, 919, 923, 5, 180, 91, 2, 920, 923, 5, 188, 95, 2, 921,
+	923, 5, 210, 106, 2, 922, 919, 3, 2, 2, 2, 922, 920, 3, 2, 2, 2, 922, 921,
+	3, 2, 2, 2, 923, 179, 3, 2, 2, 2, 924, 932, 7, 79, 2, 2, 925, 932, 7, 80,
+	2, 2, 926, 932, 7, 81, 2, 2, 927, 932, 7, 82, 2, 2, 928, 932, 7, 85, 2,
+	2, 929, 932, 9, 10, 2, 2, 930, 932, 7, 58, 2, 2, 931, 924, 3, 2, 2, 2,
+	931, 925, 3, 2, 2, 2, 931, 926, 3, 2, 2, 2, 931, 927, 3, 2, 2, 2, 931,
+	928, 3, 2, 2, 2, 931, 929, 3, 2, 2, 2, 931, 930, 3, 2, 2, 2, 932, 181,
+	3, 2, 2, 2, 933, 937, 7, 75, 2, 2, 934, 937, 5, 186, 94, 2, 935, 937, 5,
+	184, 93, 2, 936, 933, 3, 2, 2, 2, 936, 934, 3, 2, 2, 2, 936, 935, 3, 2,
+	2, 2, 937, 183, 3, 2, 2, 2, 938, 939, 7, 59, 2, 2, 939, 185, 3, 2, 2, 2,
+	940, 941, 7, 75, 2, 2, 941, 942, 7, 10, 2, 2, 942, 947, 7, 75, 2, 2, 943,
+	944, 5, 184, 93, 2, 944, 945, 7, 75, 2, 2, 945, 947, 3, 2, 2, 2, 946, 940,
+	3, 2, 2, 2, 946, 943, 3, 2, 2, 2, 947, 187, 3, 2, 2, 2, 948, 950, 5, 190,
+	96, 2, 949, 951, 5, 158, 80, 2, 950, 949, 3, 2, 2, 2, 950, 951, 3, 2, 2,
+	2, 951, 952, 3, 2, 2, 2, 952, 953, 5, 192, 97, 2, 953, 189, 3, 2, 2, 2,
+	954, 964, 5, 202, 102, 2, 955, 964, 5, 134, 68, 2, 956, 957, 7, 47, 2,
+	2, 957, 958, 7, 55, 2, 2, 958, 959, 7, 48, 2, 2, 959, 964, 5, 138, 70,
+	2, 960, 964, 5, 144, 73, 2, 961, 964, 5, 146, 74, 2, 962, 964, 5, 130,
+	66, 2, 963, 954, 3, 2, 2, 2, 963, 955, 3, 2, 2, 2, 963, 956, 3, 2, 2, 2,
+	963, 960, 3, 2, 2, 2, 963, 961, 3, 2, 2, 2, 963, 962, 3, 2, 2, 2, 964,
+	191, 3, 2, 2, 2, 965, 970, 9, 3, 2, 2, 966, 968, 5, 194, 98, 2, 967, 969,
+	7, 14, 2, 2, 968, 967, 3, 2, 2, 2, 968, 969, 3, 2, 2, 2, 969, 971, 3, 2,
+	2, 2, 970, 966, 3, 2, 2, 2, 970, 971, 3, 2, 2, 2, 971, 972, 3, 2, 2, 2,
+	972, 973, 9, 4, 2, 2, 973, 193, 3, 2, 2, 2, 974, 981, 5, 196, 99, 2, 975,
+	977, 7, 14, 2, 2, 976, 975, 3, 2, 2, 2, 976, 977, 3, 2, 2, 2, 977, 978,
+	3, 2, 2, 2, 978, 980, 5, 196, 99, 2, 979, 976, 3, 2, 2, 2, 980, 983, 3,
+	2, 2, 2, 981, 979, 3, 2, 2, 2, 981, 982, 3, 2, 2, 2, 982, 195, 3, 2, 2,
+	2, 983, 981, 3, 2, 2, 2, 984, 985, 5, 198, 100, 2, 985, 986, 7, 68, 2,
+	2, 986, 988, 3, 2, 2, 2, 987, 984, 3, 2, 2, 2, 987, 988, 3, 2, 2, 2, 988,
+	989, 3, 2, 2, 2, 989, 990, 5, 200, 101, 2, 990, 197, 3, 2, 2, 2, 991, 995,
+	7, 75, 2, 2, 992, 995, 5, 230, 116, 2, 993, 995, 5, 192, 97, 2, 994, 991,
+	3, 2, 2, 2, 994, 992, 3, 2, 2, 2, 994, 993, 3, 2, 2, 2, 995, 199, 3, 2,
+	2, 2, 996, 999, 5, 230, 116, 2, 997, 999, 5, 192, 97, 2, 998, 996, 3, 2,
+	2, 2, 998, 997, 3, 2, 2, 2, 999, 201, 3, 2, 2, 2, 1000, 1002, 9, 11, 2,
+	2, 1001, 1003, 7, 75, 2, 2, 1002, 1001, 3, 2, 2, 2, 1002, 1003, 3, 2, 2,
+	2, 1003, 1005, 3, 2, 2, 2, 1004, 1006, 5, 158, 80, 2, 1005, 1004, 3, 2,
+	2, 2, 1005, 1006, 3, 2, 2, 2, 1006, 1017, 3, 2, 2, 2, 1007, 1013, 9, 3,
+	2, 2, 1008, 1009, 5, 204, 103, 2, 1009, 1010, 5, 236, 119, 2, 1010, 1012,
+	3, 2, 2, 2, 1011, 1008, 3, 2, 2, 2, 1012, 1015, 3, 2, 2, 2, 1013, 1011,
+	3, 2, 2, 2, 1013, 1014, 3, 2, 2, 2, 1014, 1016, 3, 2, 2, 2, 1015, 1013,
+	3, 2, 2, 2, 1016, 1018, 9, 4, 2, 2, 1017, 1007, 3, 2, 2, 2, 1017, 1018,
+	3, 2, 2, 2, 1018, 203, 3, 2, 2, 2, 1019, 1020, 6, 103, 5, 2, 1020, 1021,
+	5, 28, 15, 2, 1021, 1022, 5, 128, 65, 2, 1022, 1025, 3, 2, 2, 2, 1023,
+	1025, 5, 208, 105, 2, 1024, 1019, 3, 2, 2, 2, 1024, 1023, 3, 2, 2, 2, 1025,
+	1027, 3, 2, 2, 2, 1026, 1028, 7, 85, 2, 2, 1027, 1026, 3, 2, 2, 2, 1027,
+	1028, 3, 2, 2, 2, 1028, 1031, 3, 2, 2, 2, 1029, 1031, 5, 206, 104, 2, 1030,
+	1024, 3, 2, 2, 2, 1030, 1029, 3, 2, 2, 2, 1031, 205, 3, 2, 2, 2, 1032,
+	1034, 7, 17, 2, 2, 1033, 1032, 3, 2, 2, 2, 1033, 1034, 3, 2, 2, 2, 1034,
+	1035, 3, 2, 2, 2, 1035, 1036, 5, 40, 21, 2, 1036, 207, 3, 2, 2, 2, 1037,
+	1039, 7, 17, 2, 2, 1038, 1037, 3, 2, 2, 2, 1038, 1039, 3, 2, 2, 2, 1039,
+	1040, 3, 2, 2, 2, 1040, 1041, 5, 130, 66, 2, 1041, 209, 3, 2, 2, 2, 1042,
+	1043, 7, 52, 2, 2, 1043, 1044, 5, 42, 22, 2, 1044, 211, 3, 2, 2, 2, 1045,
+	1046, 8, 107, 1, 2, 1046, 1049, 5, 176, 89, 2, 1047, 1049, 5, 234, 118,
+	2, 1048, 1045, 3, 2, 2, 2, 1048, 1047, 3, 2, 2, 2, 1049, 1054, 3, 2, 2,
+	2, 1050, 1051, 12, 3, 2, 2, 1051, 1053, 5, 214, 108, 2, 1052, 1050, 3,
+	2, 2, 2, 1053, 1056, 3, 2, 2, 2, 1054, 1052, 3, 2, 2, 2, 1054, 1055, 3,
+	2, 2, 2, 1055, 213, 3, 2, 2, 2, 1056, 1054, 3, 2, 2, 2, 1057, 1064, 5,
+	216, 109, 2, 1058, 1064, 5, 218, 110, 2, 1059, 1064, 5, 220, 111, 2, 1060,
+	1064, 5, 222, 112, 2, 1061, 1064, 5, 224, 113, 2, 1062, 1064, 7, 71, 2,
+	2, 1063, 1057, 3, 2, 2, 2, 1063, 1058, 3, 2, 2, 2, 1063, 1059, 3, 2, 2,
+	2, 1063, 1060, 3, 2, 2, 2, 1063, 1061, 3, 2, 2, 2, 1063, 1062, 3, 2, 2,
+	2, 1064, 215, 3, 2, 2, 2, 1065, 1066, 7, 10, 2, 2, 1066, 1067, 7, 75, 2,
+	2, 1067, 217, 3, 2, 2, 2, 1068, 1069, 7, 47, 2, 2, 1069, 1070, 5, 230,
+	116, 2, 1070, 1071, 7, 48, 2, 2, 1071, 219, 3, 2, 2, 2, 1072, 1088, 7,
+	47, 2, 2, 1073, 1075, 5, 230, 116, 2, 1074, 1073, 3, 2, 2, 2, 1074, 1075,
+	3, 2, 2, 2, 1075, 1076, 3, 2, 2, 2, 1076, 1078, 7, 68, 2, 2, 1077, 1079,
+	5, 230, 116, 2, 1078, 1077, 3, 2, 2, 2, 1078, 1079, 3, 2, 2, 2, 1079, 1089,
+	3, 2, 2, 2, 1080, 1082, 5, 230, 116, 2, 1081, 1080, 3, 2, 2, 2, 1081, 1082,
+	3, 2, 2, 2, 1082, 1083, 3, 2, 2, 2, 1083, 1084, 7, 68, 2, 2, 1084, 1085,
+	5, 230, 116, 2, 1085, 1086, 7, 68, 2, 2, 1086, 1087, 5, 230, 116, 2, 1087,
+	1089, 3, 2, 2, 2, 1088, 1074, 3, 2, 2, 2, 1088, 1081, 3, 2, 2, 2, 1089,
+	1090, 3, 2, 2, 2, 1090, 1091, 7, 48, 2, 2, 1091, 221, 3, 2, 2, 2, 1092,
+	1093, 7, 10, 2, 2, 1093, 1094, 7, 6, 2, 2, 1094, 1095, 5, 128, 65, 2, 1095,
+	1096, 7, 7, 2, 2, 1096, 223, 3, 2, 2, 2, 1097, 1099, 5, 158, 80, 2, 1098,
+	1097, 3, 2, 2, 2, 1098, 1099, 3, 2, 2, 2, 1099, 1100, 3, 2, 2, 2, 1100,
+	1115, 7, 6, 2, 2, 1101, 1108, 5, 30, 16, 2, 1102, 1105, 5, 128, 65, 2,
+	1103, 1104, 7, 14, 2, 2, 1104, 1106, 5, 30, 16, 2, 1105, 1103, 3, 2, 2,
+	2, 1105, 1106, 3, 2, 2, 2, 1106, 1108, 3, 2, 2, 2, 1107, 1101, 3, 2, 2,
+	2, 1107, 1102, 3, 2, 2, 2, 1108, 1110, 3, 2, 2, 2, 1109, 1111, 5, 174,
+	88, 2, 1110, 1109, 3, 2, 2, 2, 1110, 1111, 3, 2, 2, 2, 1111, 1113, 3, 2,
+	2, 2, 1112, 1114, 7, 14, 2, 2, 1113, 1112, 3, 2, 2, 2, 1113, 1114, 3, 2,
+	2, 2, 1114, 1116, 3, 2, 2, 2, 1115, 1107, 3, 2, 2, 2, 1115, 1116, 3, 2,
+	2, 2, 1116, 1117, 3, 2, 2, 2, 1117, 1118, 7, 7, 2, 2, 1118, 225, 3, 2,
+	2, 2, 1119, 1120, 5, 228, 115, 2, 1120, 1121, 7, 10, 2, 2, 1121, 1122,
+	7, 75, 2, 2, 1122, 227, 3, 2, 2, 2, 1123, 1134, 5, 130, 66, 2, 1124, 1125,
+	7, 6, 2, 2, 1125, 1126, 7, 17, 2, 2, 1126, 1127, 5, 130, 66, 2, 1127, 1128,
+	7, 7, 2, 2, 1128, 1134, 3, 2, 2, 2, 1129, 1130, 7, 6, 2, 2, 1130, 1131,
+	5, 228, 115, 2, 1131, 1132, 7, 7, 2, 2, 1132, 1134, 3, 2, 2, 2, 1133, 1123,
+	3, 2, 2, 2, 1133, 1124, 3, 2, 2, 2, 1133, 1129, 3, 2, 2, 2, 1134, 229,
+	3, 2, 2, 2, 1135, 1136, 8, 116, 1, 2, 1136, 1137, 5, 232, 117, 2, 1137,
+	1144, 3, 2, 2, 2, 1138, 1139, 12, 4, 2, 2, 1139, 1140, 6, 116, 8, 2, 1140,
+	1141, 9, 12, 2, 2, 1141, 1143, 5, 230, 116, 5, 1142, 1138, 3, 2, 2, 2,
+	1143, 1146, 3, 2, 2, 2, 1144, 1142, 3, 2, 2, 2, 1144, 1145, 3, 2, 2, 2,
+	1145, 231, 3, 2, 2, 2, 1146, 1144, 3, 2, 2, 2, 1147, 1151, 5, 212, 107,
+	2, 1148, 1149, 9, 13, 2, 2, 1149, 1151, 5, 232, 117, 2, 1150, 1147, 3,
+	2, 2, 2, 1150, 1148, 3, 2, 2, 2, 1151, 233, 3, 2, 2, 2, 1152, 1153, 5,
+	128, 65, 2, 1153, 1154, 7, 6, 2, 2, 1154, 1156, 5, 230, 116, 2, 1155, 1157,
+	7, 14, 2, 2, 1156, 1155, 3, 2, 2, 2, 1156, 1157, 3, 2, 2, 2, 1157, 1158,
+	3, 2, 2, 2, 1158, 1159, 7, 7, 2, 2, 1159, 235, 3, 2, 2, 2, 1160, 1165,
+	7, 67, 2, 2, 1161, 1165, 7, 2, 2, 3, 1162, 1165, 6, 119, 9, 2, 1163, 1165,
+	6, 119, 10, 2, 1164, 1160, 3, 2, 2, 2, 1164, 1161, 3, 2, 2, 2, 1164, 1162,
+	3, 2, 2, 2, 1164, 1163, 3, 2, 2, 2, 1165, 237, 3, 2, 2, 2, 134, 245, 253,
+	260, 273, 282, 286, 291, 298, 304, 314, 318, 322, 335, 343, 350, 358, 369,
+	373, 378, 389, 401, 406, 414, 420, 425, 430, 442, 446, 452, 456, 467, 485,
+	493, 507, 518, 529, 533, 537, 551, 560, 569, 571, 573, 578, 584, 587, 593,
+	604, 612, 622, 627, 634, 638, 644, 651, 658, 672, 679, 687, 696, 700, 703,
+	711, 719, 725, 729, 733, 737, 745, 753, 757, 767, 783, 791, 795, 815, 826,
+	833, 840, 843, 851, 859, 866, 870, 879, 885, 887, 890, 897, 901, 904, 917,
+	922, 931, 936, 946, 950, 963, 968, 970, 976, 981, 987, 994, 998, 1002,
+	1005, 1013, 1017, 1024, 1027, 1030, 1033, 1038, 1048, 1054, 1063, 1074,
+	1078, 1081, 1088, 1098, 1105, 1107, 1110, 1113, 1115, 1133, 1144, 1150,
+	1156, 1164,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "'package'", "'!'", "'import'", "'('", "')'", "'{'", "'}'", "':'",
-	"'.'", "'const'", "'='", "'_'", "','", "'type'", "'::'", "'*'", "'var'",
-	"'<-'", "'++'", "'--'", "'+'", "'-'", "'|'", "'^'", "'/'", "'%'", "'<<'",
-	"'>>'", "'&'", "'&^'", "':='", "';'", "'~'", "'return'", "'break'", "'continue'",
-	"'goto'", "'fallthrough'", "'defer'", "'if'", "'=>'", "'else'", "'switch'",
-	"'select'", "'for'", "'in'", "'go'", "'['", "']'", "'interface'", "'map'",
-	"'chan'", "'fn'", "'<'", "'>'", "'...'", "'true'", "'false'", "'nil'",
-	"'@'", "'struct'", "'class'", "'||'", "'&&'", "'=='", "'!='", "'<='", "'>='",
-	"", "", "", "'->'",
+	"", "'package'", "'!'", "'import'", "'('", "')'", "'{'", "'}'", "'.'",
+	"'const'", "'='", "'enum'", "','", "'type'", "'::'", "'*'", "'var'", "'<-'",
+	"'++'", "'--'", "'+'", "'-'", "'|'", "'^'", "'/'", "'%'", "'<<'", "'>>'",
+	"'&'", "'&^'", "':='", "'~'", "'return'", "'break'", "'continue'", "'goto'",
+	"'fallthrough'", "'defer'", "'else'", "'switch'", "'match'", "'as'", "'select'",
+	"'in'", "'go'", "'['", "']'", "'interface'", "'map'", "'chan'", "'fn'",
+	"'<'", "'>'", "'...'", "'true'", "'false'", "'nil'", "'@'", "'class'",
+	"'||'", "'&&'", "'=='", "'!='", "'<='", "'>='", "';'", "':'", "'_'", "'=>'",
+	"'?'", "'if'", "'for'", "'struct'", "", "", "", "'->'",
 }
 var symbolicNames = []string{
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
 	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "IDENTIFIER",
-	"KEYWORD", "BINARY_OP", "FUNC", "INT_LIT", "FLOAT_LIT", "IMAGINARY_LIT",
-	"RUNE_LIT", "LITTLE_U_VALUE", "BIG_U_VALUE", "STRING_LIT", "WS", "COMMENT",
-	"LINE_COMMENT", "TERMINATOR", "ErrorChar",
+	"", "", "", "", "", "", "", "", "", "", "", "SEMI", "COLON", "BLANK", "ARROW",
+	"QUESTION", "IF", "FOR", "STRUCT", "IDENTIFIER", "KEYWORD", "BINARY_OP",
+	"FUNC", "INT_LIT", "FLOAT_LIT", "IMAGINARY_LIT", "RUNE_LIT", "LITTLE_U_VALUE",
+	"BIG_U_VALUE", "STRING_LIT", "WS", "COMMENT", "LINE_COMMENT", "TERMINATOR",
+	"ErrorChar", "INDENT", "DEDENT",
 }
 
 var ruleNames = []string{
 	"sourceFile", "interp", "packageClause", "importDecl", "importBody", "importSpec",
 	"importPath", "topLevelDecl", "declaration", "constDecl", "constSpec",
-	"identifierList", "expressionList", "typeDecl", "typeSpec", "functionDecl",
-	"function", "methodDecl", "receiver", "varDecl", "varSpec", "block", "statementList",
-	"statement", "simpleStmt", "sendStmt", "incDecStmt", "assignment", "assign_op",
-	"shortVarDecl", "emptyStmt", "labeledStmt", "returnStmt", "breakStmt",
-	"continueStmt", "gotoStmt", "fallthroughStmt", "deferStmt", "ifStmt", "switchStmt",
-	"exprSwitchStmt", "exprCaseClause", "exprSwitchCase", "typeSwitchStmt",
-	"typeSwitchGuard", "typeCaseClause", "typeSwitchCase", "typeList", "selectStmt",
-	"commClause", "commCase", "recvStmt", "forStmt", "forClause", "rangeClause",
-	"goStmt", "type_", "typeName", "typeLit", "arrayType", "arrayLength", "elementType",
-	"pointerType", "interfaceType", "sliceType", "mapType", "channelType",
-	"channelDecl", "methodSpec", "functionType", "signature", "templateSpec",
+	"enumDecl", "enumSpec", "identifierList", "expressionList", "typeDecl",
+	"dataDecl", "variant", "typeSpec", "functionDecl", "function", "methodDecl",
+	"receiver", "varDecl", "varSpec", "block", "statementList", "statement",
+	"simpleStmt", "sendStmt", "incDecStmt", "assignment", "assign_op", "shortVarDecl",
+	"emptyStmt", "labeledStmt", "returnStmt", "breakStmt", "continueStmt",
+	"gotoStmt", "fallthroughStmt", "deferStmt", "ifStmt", "switchStmt", "exprSwitchStmt",
+	"exprCaseClause", "exprSwitchCase", "matchStmt", "matchArm", "pattern",
+	"typeSwitchStmt", "typeSwitchGuard", "typeCaseClause", "typeSwitchCase",
+	"typeList", "selectStmt", "commClause", "commCase", "recvStmt", "forStmt",
+	"forClause", "rangeClause", "goStmt", "type_", "typeName", "typeLit", "arrayType",
+	"arrayLength", "elementType", "pointerType", "interfaceType", "sliceType",
+	"mapType", "channelType", "channelDecl", "methodSpec", "functionType",
+	"signature", "templateSpec", "templateParam", "constraint", "constraintTerm",
 	"result", "parameters", "parameterList", "parameterDecl", "restOp", "operand",
 	"literal", "basicLit", "operandName", "this_", "qualifiedIdent", "compositeLit",
 	"literalType", "literalValue", "elementList", "keyedElement", "key", "element",
@@ -632,6 +706,11 @@ func (this *OgParser) noTerminatorBetween(tokenOffset int) bool {
 	return true
 }
 
+// The next token is the `data` that starts a data declaration
+func (this *OgParser) isData() bool {
+	return this.BaseParser.GetTokenStream().LT(1).GetText() == "data"
+}
+
 func (this *OgParser) lookAhead(token int) bool {
 	possibleIndexEosToken := this.BaseParser.GetCurrentToken().GetTokenIndex() - 1
 
@@ -768,26 +847,32 @@ const (
 	OgParserT__61          = 62
 	OgParserT__62          = 63
 	OgParserT__63          = 64
-	OgParserT__64          = 65
-	OgParserT__65          = 66
-	OgParserT__66          = 67
-	OgParserT__67          = 68
-	OgParserIDENTIFIER     = 69
-	OgParserKEYWORD        = 70
-	OgParserBINARY_OP      = 71
-	OgParserFUNC           = 72
-	OgParserINT_LIT        = 73
-	OgParserFLOAT_LIT      = 74
-	OgParserIMAGINARY_LIT  = 75
-	OgParserRUNE_LIT       = 76
-	OgParserLITTLE_U_VALUE = 77
-	OgParserBIG_U_VALUE    = 78
-	OgParserSTRING_LIT     = 79
-	OgParserWS             = 80
-	OgParserCOMMENT        = 81
-	OgParserLINE_COMMENT   = 82
-	OgParserTERMINATOR     = 83
-	OgParserErrorChar      = 84
+	OgParserSEMI           = 65
+	OgParserCOLON          = 66
+	OgParserBLANK          = 67
+	OgParserARROW          = 68
+	OgParserQUESTION       = 69
+	OgParserIF             = 70
+	OgParserFOR            = 71
+	OgParserSTRUCT         = 72
+	OgParserIDENTIFIER     = 73
+	OgParserKEYWORD        = 74
+	OgParserBINARY_OP      = 75
+	OgParserFUNC           = 76
+	OgParserINT_LIT        = 77
+	OgParserFLOAT_LIT      = 78
+	OgParserIMAGINARY_LIT  = 79
+	OgParserRUNE_LIT       = 80
+	OgParserLITTLE_U_VALUE = 81
+	OgParserBIG_U_VALUE    = 82
+	OgParserSTRING_LIT     = 83
+	OgParserWS             = 84
+	OgParserCOMMENT        = 85
+	OgParserLINE_COMMENT   = 86
+	OgParserTERMINATOR     = 87
+	OgParserErrorChar      = 88
+	OgParserINDENT         = 89
+	OgParserDEDENT         = 90
 )
 
 // OgParser rules.
@@ -803,103 +888,113 @@ const (
 	OgParserRULE_declaration        = 8
 	OgParserRULE_constDecl          = 9
 	OgParserRULE_constSpec          = 10
-	OgParserRULE_identifierList     = 11
-	OgParserRULE_expressionList     = 12
-	OgParserRULE_typeDecl           = 13
-	OgParserRULE_typeSpec           = 14
-	OgParserRULE_functionDecl       = 15
-	OgParserRULE_function           = 16
-	OgParserRULE_methodDecl         = 17
-	OgParserRULE_receiver           = 18
-	OgParserRULE_varDecl            = 19
-	OgParserRULE_varSpec            = 20
-	OgParserRULE_block              = 21
-	OgParserRULE_statementList      = 22
-	OgParserRULE_statement          = 23
-	OgParserRULE_simpleStmt         = 24
-	OgParserRULE_sendStmt           = 25
-	OgParserRULE_incDecStmt         = 26
-	OgParserRULE_assignment         = 27
-	OgParserRULE_assign_op          = 28
-	OgParserRULE_shortVarDecl       = 29
-	OgParserRULE_emptyStmt          = 30
-	OgParserRULE_labeledStmt        = 31
-	OgParserRULE_returnStmt         = 32
-	OgParserRULE_breakStmt          = 33
-	OgParserRULE_continueStmt       = 34
-	OgParserRULE_gotoStmt           = 35
-	OgParserRULE_fallthroughStmt    = 36
-	OgParserRULE_deferStmt          = 37
-	OgParserRULE_ifStmt             = 38
-	OgParserRULE_switchStmt         = 39
-	OgParserRULE_exprSwitchStmt     = 40
-	OgParserRULE_exprCaseClause     = 41
-	OgParserRULE_exprSwitchCase     = 42
-	OgParserRULE_typeSwitchStmt     = 43
-	OgParserRULE_typeSwitchGuard    = 44
-	OgParserRULE_typeCaseClause     = 45
-	OgParserRULE_typeSwitchCase     = 46
-	OgParserRULE_typeList           = 47
-	OgParserRULE_selectStmt         = 48
-	OgParserRULE_commClause         = 49
-	OgParserRULE_commCase           = 50
-	OgParserRULE_recvStmt           = 51
-	OgParserRULE_forStmt            = 52
-	OgParserRULE_forClause          = 53
-	OgParserRULE_rangeClause        = 54
-	OgParserRULE_goStmt             = 55
-	OgParserRULE_type_              = 56
-	OgParserRULE_typeName           = 57
-	OgParserRULE_typeLit            = 58
-	OgParserRULE_arrayType          = 59
-	OgParserRULE_arrayLength        = 60
-	OgParserRULE_elementType        = 61
-	OgParserRULE_pointerType        = 62
-	OgParserRULE_interfaceType      = 63
-	OgParserRULE_sliceType          = 64
-	OgParserRULE_mapType            = 65
-	OgParserRULE_channelType        = 66
-	OgParserRULE_channelDecl        = 67
-	OgParserRULE_methodSpec         = 68
-	OgParserRULE_functionType       = 69
-	OgParserRULE_signature          = 70
-	OgParserRULE_templateSpec       = 71
-	OgParserRULE_result             = 72
-	OgParserRULE_parameters         = 73
-	OgParserRULE_parameterList      = 74
-	OgParserRULE_parameterDecl      = 75
-	OgParserRULE_restOp             = 76
-	OgParserRULE_operand            = 77
-	OgParserRULE_literal            = 78
-	OgParserRULE_basicLit           = 79
-	OgParserRULE_operandName        = 80
-	OgParserRULE_this_              = 81
-	OgParserRULE_qualifiedIdent     = 82
-	OgParserRULE_compositeLit       = 83
-	OgParserRULE_literalType        = 84
-	OgParserRULE_literalValue       = 85
-	OgParserRULE_elementList        = 86
-	OgParserRULE_keyedElement       = 87
-	OgParserRULE_key                = 88
-	OgParserRULE_element            = 89
-	OgParserRULE_structType         = 90
-	OgParserRULE_fieldDecl          = 91
-	OgParserRULE_inlineStructMethod = 92
-	OgParserRULE_anonymousField     = 93
-	OgParserRULE_functionLit        = 94
-	OgParserRULE_primaryExpr        = 95
-	OgParserRULE_secondaryExpr      = 96
-	OgParserRULE_selector           = 97
-	OgParserRULE_index              = 98
-	OgParserRULE_slice              = 99
-	OgParserRULE_typeAssertion      = 100
-	OgParserRULE_arguments          = 101
-	OgParserRULE_methodExpr         = 102
-	OgParserRULE_receiverType       = 103
-	OgParserRULE_expression         = 104
-	OgParserRULE_unaryExpr          = 105
-	OgParserRULE_conversion         = 106
-	OgParserRULE_eos                = 107
+	OgParserRULE_enumDecl           = 11
+	OgParserRULE_enumSpec           = 12
+	OgParserRULE_identifierList     = 13
+	OgParserRULE_expressionList     = 14
+	OgParserRULE_typeDecl           = 15
+	OgParserRULE_dataDecl           = 16
+	OgParserRULE_variant            = 17
+	OgParserRULE_typeSpec           = 18
+	OgParserRULE_functionDecl       = 19
+	OgParserRULE_function           = 20
+	OgParserRULE_methodDecl         = 21
+	OgParserRULE_receiver           = 22
+	OgParserRULE_varDecl            = 23
+	OgParserRULE_varSpec            = 24
+	OgParserRULE_block              = 25
+	OgParserRULE_statementList      = 26
+	OgParserRULE_statement          = 27
+	OgParserRULE_simpleStmt         = 28
+	OgParserRULE_sendStmt           = 29
+	OgParserRULE_incDecStmt         = 30
+	OgParserRULE_assignment         = 31
+	OgParserRULE_assign_op          = 32
+	OgParserRULE_shortVarDecl       = 33
+	OgParserRULE_emptyStmt          = 34
+	OgParserRULE_labeledStmt        = 35
+	OgParserRULE_returnStmt         = 36
+	OgParserRULE_breakStmt          = 37
+	OgParserRULE_continueStmt       = 38
+	OgParserRULE_gotoStmt           = 39
+	OgParserRULE_fallthroughStmt    = 40
+	OgParserRULE_deferStmt          = 41
+	OgParserRULE_ifStmt             = 42
+	OgParserRULE_switchStmt         = 43
+	OgParserRULE_exprSwitchStmt     = 44
+	OgParserRULE_exprCaseClause     = 45
+	OgParserRULE_exprSwitchCase     = 46
+	OgParserRULE_matchStmt          = 47
+	OgParserRULE_matchArm           = 48
+	OgParserRULE_pattern            = 49
+	OgParserRULE_typeSwitchStmt     = 50
+	OgParserRULE_typeSwitchGuard    = 51
+	OgParserRULE_typeCaseClause     = 52
+	OgParserRULE_typeSwitchCase     = 53
+	OgParserRULE_typeList           = 54
+	OgParserRULE_selectStmt         = 55
+	OgParserRULE_commClause         = 56
+	OgParserRULE_commCase           = 57
+	OgParserRULE_recvStmt           = 58
+	OgParserRULE_forStmt            = 59
+	OgParserRULE_forClause          = 60
+	OgParserRULE_rangeClause        = 61
+	OgParserRULE_goStmt             = 62
+	OgParserRULE_type_              = 63
+	OgParserRULE_typeName           = 64
+	OgParserRULE_typeLit            = 65
+	OgParserRULE_arrayType          = 66
+	OgParserRULE_arrayLength        = 67
+	OgParserRULE_elementType        = 68
+	OgParserRULE_pointerType        = 69
+	OgParserRULE_interfaceType      = 70
+	OgParserRULE_sliceType          = 71
+	OgParserRULE_mapType            = 72
+	OgParserRULE_channelType        = 73
+	OgParserRULE_channelDecl        = 74
+	OgParserRULE_methodSpec         = 75
+	OgParserRULE_functionType       = 76
+	OgParserRULE_signature          = 77
+	OgParserRULE_templateSpec       = 78
+	OgParserRULE_templateParam      = 79
+	OgParserRULE_constraint         = 80
+	OgParserRULE_constraintTerm     = 81
+	OgParserRULE_result             = 82
+	OgParserRULE_parameters         = 83
+	OgParserRULE_parameterList      = 84
+	OgParserRULE_parameterDecl      = 85
+	OgParserRULE_restOp             = 86
+	OgParserRULE_operand            = 87
+	OgParserRULE_literal            = 88
+	OgParserRULE_basicLit           = 89
+	OgParserRULE_operandName        = 90
+	OgParserRULE_this_              = 91
+	OgParserRULE_qualifiedIdent     = 92
+	OgParserRULE_compositeLit       = 93
+	OgParserRULE_literalType        = 94
+	OgParserRULE_literalValue       = 95
+	OgParserRULE_elementList        = 96
+	OgParserRULE_keyedElement       = 97
+	OgParserRULE_key                = 98
+	OgParserRULE_element            = 99
+	OgParserRULE_structType         = 100
+	OgParserRULE_fieldDecl          = 101
+	OgParserRULE_inlineStructMethod = 102
+	OgParserRULE_anonymousField     = 103
+	OgParserRULE_functionLit        = 104
+	OgParserRULE_primaryExpr        = 105
+	OgParserRULE_secondaryExpr      = 106
+	OgParserRULE_selector           = 107
+	OgParserRULE_index              = 108
+	OgParserRULE_slice              = 109
+	OgParserRULE_typeAssertion      = 110
+	OgParserRULE_arguments          = 111
+	OgParserRULE_methodExpr         = 112
+	OgParserRULE_receiverType       = 113
+	OgParserRULE_expression         = 114
+	OgParserRULE_unaryExpr          = 115
+	OgParserRULE_conversion         = 116
+	OgParserRULE_eos                = 117
 )
 
 // ISourceFileContext is an interface to support dynamic dispatch.
@@ -1055,7 +1150,6 @@ func (s *SourceFileContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 func (p *OgParser) SourceFile() (localctx ISourceFileContext) {
 	localctx = NewSourceFileContext(p, p.GetParserRuleContext(), p.GetState())
 	p.EnterRule(localctx, 0, OgParserRULE_sourceFile)
-	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1073,53 +1167,59 @@ func (p *OgParser) SourceFile() (localctx ISourceFileContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(216)
+		p.SetState(236)
 		p.PackageClause()
 	}
 	{
-		p.SetState(217)
+		p.SetState(237)
 		p.Eos()
 	}
-	p.SetState(223)
+	p.SetState(243)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
 
-	for _la == OgParserT__2 {
-		{
-			p.SetState(218)
-			p.ImportDecl()
-		}
-		{
-			p.SetState(219)
-			p.Eos()
-		}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(238)
+				p.ImportDecl()
+			}
+			{
+				p.SetState(239)
+				p.Eos()
+			}
 
-		p.SetState(225)
+		}
+		p.SetState(245)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 0, p.GetParserRuleContext())
 	}
-	p.SetState(231)
+	p.SetState(251)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__9)|(1<<OgParserT__13)|(1<<OgParserT__16))) != 0) || (((_la-50)&-(0x1f+1)) == 0 && ((1<<uint((_la-50)))&((1<<(OgParserT__49-50))|(1<<(OgParserT__60-50))|(1<<(OgParserT__61-50))|(1<<(OgParserIDENTIFIER-50)))) != 0) {
-		{
-			p.SetState(226)
-			p.TopLevelDecl()
-		}
-		{
-			p.SetState(227)
-			p.Eos()
-		}
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(246)
+				p.TopLevelDecl()
+			}
+			{
+				p.SetState(247)
+				p.Eos()
+			}
 
-		p.SetState(233)
+		}
+		p.SetState(253)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext())
 	}
 	{
-		p.SetState(234)
+		p.SetState(254)
 		p.Match(OgParserEOF)
 	}
 
@@ -1238,24 +1338,24 @@ func (p *OgParser) Interp() (localctx IInterpContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(238)
+	p.SetState(258)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(236)
+			p.SetState(256)
 			p.TopLevelDecl()
 		}
 
 	case 2:
 		{
-			p.SetState(237)
+			p.SetState(257)
 			p.Statement()
 		}
 
 	}
 	{
-		p.SetState(240)
+		p.SetState(260)
 		p.Match(OgParserEOF)
 	}
 
@@ -1355,7 +1455,7 @@ func (p *OgParser) PackageClause() (localctx IPackageClauseContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(242)
+	p.SetState(262)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(_la == OgParserT__0 || _la == OgParserT__1) {
@@ -1365,7 +1465,7 @@ func (p *OgParser) PackageClause() (localctx IPackageClauseContext) {
 		p.Consume()
 	}
 	{
-		p.SetState(243)
+		p.SetState(263)
 		p.Match(OgParserIDENTIFIER)
 	}
 
@@ -1471,30 +1571,30 @@ func (p *OgParser) ImportDecl() (localctx IImportDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(245)
+		p.SetState(265)
 		p.Match(OgParserT__2)
 	}
-	p.SetState(251)
+	p.SetState(271)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case OgParserT__5, OgParserIDENTIFIER, OgParserSTRING_LIT:
+	case OgParserT__5, OgParserIDENTIFIER, OgParserSTRING_LIT, OgParserINDENT:
 		{
-			p.SetState(246)
+			p.SetState(266)
 			p.ImportBody()
 		}
 
 	case OgParserT__3:
 		{
-			p.SetState(247)
+			p.SetState(267)
 			p.Match(OgParserT__3)
 		}
 		{
-			p.SetState(248)
+			p.SetState(268)
 			p.ImportBody()
 		}
 		{
-			p.SetState(249)
+			p.SetState(269)
 			p.Match(OgParserT__4)
 		}
 
@@ -1566,6 +1666,14 @@ func (s *ImportBodyContext) ImportSpec(i int) IImportSpecContext {
 	return t.(IImportSpecContext)
 }
 
+func (s *ImportBodyContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *ImportBodyContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
 func (s *ImportBodyContext) AllEos() []IEosContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
 	var tst = make([]IEosContext, len(ts))
@@ -1639,44 +1747,54 @@ func (p *OgParser) ImportBody() (localctx IImportBodyContext) {
 		}
 	}()
 
-	p.SetState(264)
+	p.SetState(284)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case OgParserIDENTIFIER, OgParserSTRING_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(253)
+			p.SetState(273)
 			p.ImportSpec()
 		}
 
-	case OgParserT__5:
+	case OgParserT__5, OgParserINDENT:
 		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(254)
-			p.Match(OgParserT__5)
+		p.SetState(274)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-		p.SetState(260)
+		p.SetState(280)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == OgParserIDENTIFIER || _la == OgParserSTRING_LIT {
 			{
-				p.SetState(255)
+				p.SetState(275)
 				p.ImportSpec()
 			}
 			{
-				p.SetState(256)
+				p.SetState(276)
 				p.Eos()
 			}
 
-			p.SetState(262)
+			p.SetState(282)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
-		{
-			p.SetState(263)
-			p.Match(OgParserT__6)
+		p.SetState(283)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 
 	default:
@@ -1790,21 +1908,21 @@ func (p *OgParser) ImportSpec() (localctx IImportSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(266)
+		p.SetState(286)
 		p.ImportPath()
 	}
-	p.SetState(269)
+	p.SetState(289)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 6, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(267)
-			p.Match(OgParserT__7)
+			p.SetState(287)
+			p.Match(OgParserCOLON)
 		}
-		p.SetState(268)
+		p.SetState(288)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == OgParserT__8 || _la == OgParserIDENTIFIER) {
+		if !(_la == OgParserT__7 || _la == OgParserIDENTIFIER) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -1913,7 +2031,7 @@ func (p *OgParser) ImportPath() (localctx IImportPathContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(271)
+	p.SetState(291)
 	_la = p.GetTokenStream().LA(1)
 
 	if !(_la == OgParserIDENTIFIER || _la == OgParserSTRING_LIT) {
@@ -2043,27 +2161,27 @@ func (p *OgParser) TopLevelDecl() (localctx ITopLevelDeclContext) {
 		}
 	}()
 
-	p.SetState(276)
+	p.SetState(296)
 	p.GetErrorHandler().Sync(p)
 	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 7, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(273)
+			p.SetState(293)
 			p.Declaration()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(274)
+			p.SetState(294)
 			p.FunctionDecl()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(275)
+			p.SetState(295)
 			p.MethodDecl()
 		}
 
@@ -2140,6 +2258,16 @@ func (s *DeclarationContext) VarDecl() IVarDeclContext {
 	return t.(IVarDeclContext)
 }
 
+func (s *DeclarationContext) EnumDecl() IEnumDeclContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumDeclContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumDeclContext)
+}
+
 func (s *DeclarationContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -2189,33 +2317,37 @@ func (p *OgParser) Declaration() (localctx IDeclarationContext) {
 		}
 	}()
 
-	p.SetState(281)
+	p.SetState(302)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__9:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 8, p.GetParserRuleContext()) {
+	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(278)
+			p.SetState(298)
 			p.ConstDecl()
 		}
 
-	case OgParserT__13, OgParserT__49, OgParserT__60, OgParserT__61:
+	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(279)
+			p.SetState(299)
 			p.TypeDecl()
 		}
 
-	case OgParserT__16:
+	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(280)
+			p.SetState(300)
 			p.VarDecl()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(301)
+			p.EnumDecl()
+		}
+
 	}
 
 	return localctx
@@ -2357,44 +2489,44 @@ func (p *OgParser) ConstDecl() (localctx IConstDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(283)
-		p.Match(OgParserT__9)
+		p.SetState(304)
+		p.Match(OgParserT__8)
 	}
-	p.SetState(295)
+	p.SetState(316)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case OgParserT__11, OgParserIDENTIFIER:
+	case OgParserBLANK, OgParserIDENTIFIER:
 		{
-			p.SetState(284)
+			p.SetState(305)
 			p.ConstSpec()
 		}
 
 	case OgParserT__3:
 		{
-			p.SetState(285)
+			p.SetState(306)
 			p.Match(OgParserT__3)
 		}
-		p.SetState(291)
+		p.SetState(312)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		for _la == OgParserT__11 || _la == OgParserIDENTIFIER {
+		for _la == OgParserBLANK || _la == OgParserIDENTIFIER {
 			{
-				p.SetState(286)
+				p.SetState(307)
 				p.ConstSpec()
 			}
 			{
-				p.SetState(287)
+				p.SetState(308)
 				p.Eos()
 			}
 
-			p.SetState(293)
+			p.SetState(314)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(294)
+			p.SetState(315)
 			p.Match(OgParserT__4)
 		}
 
@@ -2525,111 +2657,161 @@ func (p *OgParser) ConstSpec() (localctx IConstSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(297)
+		p.SetState(318)
 		p.IdentifierList()
 	}
 
-	p.SetState(299)
+	p.SetState(320)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__3)|(1<<OgParserT__15)|(1<<OgParserT__17))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) {
 		{
-			p.SetState(298)
+			p.SetState(319)
 			p.Type_()
 		}
 
 	}
 	{
-		p.SetState(301)
-		p.Match(OgParserT__10)
+		p.SetState(322)
+		p.Match(OgParserT__9)
 	}
 	{
-		p.SetState(302)
+		p.SetState(323)
 		p.ExpressionList()
 	}
 
 	return localctx
 }
 
-// IIdentifierListContext is an interface to support dynamic dispatch.
-type IIdentifierListContext interface {
+// IEnumDeclContext is an interface to support dynamic dispatch.
+type IEnumDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsIdentifierListContext differentiates from other interfaces.
-	IsIdentifierListContext()
+	// IsEnumDeclContext differentiates from other interfaces.
+	IsEnumDeclContext()
 }
 
-type IdentifierListContext struct {
+type EnumDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyIdentifierListContext() *IdentifierListContext {
-	var p = new(IdentifierListContext)
+func NewEmptyEnumDeclContext() *EnumDeclContext {
+	var p = new(EnumDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_identifierList
+	p.RuleIndex = OgParserRULE_enumDecl
 	return p
 }
 
-func (*IdentifierListContext) IsIdentifierListContext() {}
+func (*EnumDeclContext) IsEnumDeclContext() {}
 
-func NewIdentifierListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierListContext {
-	var p = new(IdentifierListContext)
+func NewEnumDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumDeclContext {
+	var p = new(EnumDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_identifierList
+	p.RuleIndex = OgParserRULE_enumDecl
 
 	return p
 }
 
-func (s *IdentifierListContext) GetParser() antlr.Parser { return s.parser }
+func (s *EnumDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IdentifierListContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(OgParserIDENTIFIER)
+func (s *EnumDeclContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *IdentifierListContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, i)
+func (s *EnumDeclContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
 }
 
-func (s *IdentifierListContext) GetRuleContext() antlr.RuleContext {
+func (s *EnumDeclContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *EnumDeclContext) AllEnumSpec() []IEnumSpecContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEnumSpecContext)(nil)).Elem())
+	var tst = make([]IEnumSpecContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEnumSpecContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EnumDeclContext) EnumSpec(i int) IEnumSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEnumSpecContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEnumSpecContext)
+}
+
+func (s *EnumDeclContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *EnumDeclContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEosContext)
+}
+
+func (s *EnumDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IdentifierListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EnumDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IdentifierListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EnumDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterIdentifierList(s)
+		listenerT.EnterEnumDecl(s)
 	}
 }
 
-func (s *IdentifierListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EnumDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitIdentifierList(s)
+		listenerT.ExitEnumDecl(s)
 	}
 }
 
-func (s *IdentifierListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *EnumDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case IdentifierListVisitor:
-		return t.VisitIdentifierList(s, delegate)
+	case EnumDeclVisitor:
+		return t.VisitEnumDecl(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) IdentifierList() (localctx IIdentifierListContext) {
-	localctx = NewIdentifierListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, OgParserRULE_identifierList)
+func (p *OgParser) EnumDecl() (localctx IEnumDeclContext) {
+	localctx = NewEnumDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, OgParserRULE_enumDecl)
 	var _la int
 
 	defer func() {
@@ -2649,135 +2831,144 @@ func (p *OgParser) IdentifierList() (localctx IIdentifierListContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(304)
+	{
+		p.SetState(325)
+		p.Match(OgParserT__10)
+	}
+	{
+		p.SetState(326)
+		p.Match(OgParserIDENTIFIER)
+	}
+	p.SetState(327)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(_la == OgParserT__11 || _la == OgParserIDENTIFIER) {
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
 		p.Consume()
 	}
-	p.SetState(309)
+	p.SetState(333)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == OgParserT__12 {
+	for _la == OgParserBLANK || _la == OgParserIDENTIFIER {
 		{
-			p.SetState(305)
-			p.Match(OgParserT__12)
+			p.SetState(328)
+			p.EnumSpec()
 		}
-		p.SetState(306)
-		_la = p.GetTokenStream().LA(1)
-
-		if !(_la == OgParserT__11 || _la == OgParserIDENTIFIER) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		{
+			p.SetState(329)
+			p.Eos()
 		}
 
-		p.SetState(311)
+		p.SetState(335)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
+	p.SetState(336)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
 
 	return localctx
 }
 
-// IExpressionListContext is an interface to support dynamic dispatch.
-type IExpressionListContext interface {
+// IEnumSpecContext is an interface to support dynamic dispatch.
+type IEnumSpecContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExpressionListContext differentiates from other interfaces.
-	IsExpressionListContext()
+	// IsEnumSpecContext differentiates from other interfaces.
+	IsEnumSpecContext()
 }
 
-type ExpressionListContext struct {
+type EnumSpecContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionListContext() *ExpressionListContext {
-	var p = new(ExpressionListContext)
+func NewEmptyEnumSpecContext() *EnumSpecContext {
+	var p = new(EnumSpecContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_expressionList
+	p.RuleIndex = OgParserRULE_enumSpec
 	return p
 }
 
-func (*ExpressionListContext) IsExpressionListContext() {}
+func (*EnumSpecContext) IsEnumSpecContext() {}
 
-func NewExpressionListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionListContext {
-	var p = new(ExpressionListContext)
+func NewEnumSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EnumSpecContext {
+	var p = new(EnumSpecContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_expressionList
+	p.RuleIndex = OgParserRULE_enumSpec
 
 	return p
 }
 
-func (s *ExpressionListContext) GetParser() antlr.Parser { return s.parser }
+func (s *EnumSpecContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExpressionListContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
+func (s *EnumSpecContext) IdentifierList() IIdentifierListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IIdentifierListContext)
 }
 
-func (s *ExpressionListContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *EnumSpecContext) ExpressionList() IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IExpressionListContext)
 }
 
-func (s *ExpressionListContext) GetRuleContext() antlr.RuleContext {
+func (s *EnumSpecContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EnumSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EnumSpecContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterExpressionList(s)
+		listenerT.EnterEnumSpec(s)
 	}
 }
 
-func (s *ExpressionListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EnumSpecContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitExpressionList(s)
+		listenerT.ExitEnumSpec(s)
 	}
 }
 
-func (s *ExpressionListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *EnumSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ExpressionListVisitor:
-		return t.VisitExpressionList(s, delegate)
+	case EnumSpecVisitor:
+		return t.VisitEnumSpec(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ExpressionList() (localctx IExpressionListContext) {
-	localctx = NewExpressionListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, OgParserRULE_expressionList)
+func (p *OgParser) EnumSpec() (localctx IEnumSpecContext) {
+	localctx = NewEnumSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, OgParserRULE_enumSpec)
 
 	defer func() {
 		p.ExitRule()
@@ -2795,173 +2986,107 @@ func (p *OgParser) ExpressionList() (localctx IExpressionListContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(312)
-		p.expression(0)
+		p.SetState(338)
+		p.IdentifierList()
 	}
-	p.SetState(317)
+	p.SetState(341)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext())
-
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(313)
-				p.Match(OgParserT__12)
-			}
-			{
-				p.SetState(314)
-				p.expression(0)
-			}
 
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(339)
+			p.Match(OgParserT__9)
 		}
-		p.SetState(319)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 13, p.GetParserRuleContext())
+		{
+			p.SetState(340)
+			p.ExpressionList()
+		}
+
 	}
 
 	return localctx
 }
 
-// ITypeDeclContext is an interface to support dynamic dispatch.
-type ITypeDeclContext interface {
+// IIdentifierListContext is an interface to support dynamic dispatch.
+type IIdentifierListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeDeclContext differentiates from other interfaces.
-	IsTypeDeclContext()
+	// IsIdentifierListContext differentiates from other interfaces.
+	IsIdentifierListContext()
 }
 
-type TypeDeclContext struct {
+type IdentifierListContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeDeclContext() *TypeDeclContext {
-	var p = new(TypeDeclContext)
+func NewEmptyIdentifierListContext() *IdentifierListContext {
+	var p = new(IdentifierListContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeDecl
+	p.RuleIndex = OgParserRULE_identifierList
 	return p
 }
 
-func (*TypeDeclContext) IsTypeDeclContext() {}
+func (*IdentifierListContext) IsIdentifierListContext() {}
 
-func NewTypeDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeDeclContext {
-	var p = new(TypeDeclContext)
+func NewIdentifierListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IdentifierListContext {
+	var p = new(IdentifierListContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeDecl
+	p.RuleIndex = OgParserRULE_identifierList
 
 	return p
 }
 
-func (s *TypeDeclContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeDeclContext) AllTypeSpec() []ITypeSpecContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem())
-	var tst = make([]ITypeSpecContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ITypeSpecContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *TypeDeclContext) TypeSpec(i int) ITypeSpecContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITypeSpecContext)
-}
-
-func (s *TypeDeclContext) AllEos() []IEosContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
-	var tst = make([]IEosContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IEosContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *TypeDeclContext) Eos(i int) IEosContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IEosContext)
-}
-
-func (s *TypeDeclContext) StructType() IStructTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStructTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *IdentifierListContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IStructTypeContext)
+func (s *IdentifierListContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(OgParserIDENTIFIER)
 }
 
-func (s *TypeDeclContext) InterfaceType() IInterfaceTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInterfaceTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IInterfaceTypeContext)
+func (s *IdentifierListContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, i)
 }
 
-func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *IdentifierListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IdentifierListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeDecl(s)
+		listenerT.EnterIdentifierList(s)
 	}
 }
 
-func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IdentifierListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeDecl(s)
+		listenerT.ExitIdentifierList(s)
 	}
 }
 
-func (s *TypeDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *IdentifierListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeDeclVisitor:
-		return t.VisitTypeDecl(s, delegate)
+	case IdentifierListVisitor:
+		return t.VisitIdentifierList(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeDecl() (localctx ITypeDeclContext) {
-	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, OgParserRULE_typeDecl)
+func (p *OgParser) IdentifierList() (localctx IIdentifierListContext) {
+	localctx = NewIdentifierListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, OgParserRULE_identifierList)
 	var _la int
 
 	defer func() {
@@ -2980,163 +3105,140 @@ func (p *OgParser) TypeDecl() (localctx ITypeDeclContext) {
 		}
 	}()
 
-	p.SetState(336)
-	p.GetErrorHandler().Sync(p)
+	var _alt int
 
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__13:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(320)
-			p.Match(OgParserT__13)
-		}
-		p.SetState(332)
-		p.GetErrorHandler().Sync(p)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(343)
+	_la = p.GetTokenStream().LA(1)
 
-		switch p.GetTokenStream().LA(1) {
-		case OgParserIDENTIFIER:
-			{
-				p.SetState(321)
-				p.TypeSpec()
-			}
+	if !(_la == OgParserBLANK || _la == OgParserIDENTIFIER) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+	p.SetState(348)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext())
 
-		case OgParserT__3:
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
 			{
-				p.SetState(322)
-				p.Match(OgParserT__3)
+				p.SetState(344)
+				p.Match(OgParserT__11)
 			}
-			p.SetState(328)
-			p.GetErrorHandler().Sync(p)
+			p.SetState(345)
 			_la = p.GetTokenStream().LA(1)
 
-			for _la == OgParserIDENTIFIER {
-				{
-					p.SetState(323)
-					p.TypeSpec()
-				}
-				{
-					p.SetState(324)
-					p.Eos()
-				}
-
-				p.SetState(330)
-				p.GetErrorHandler().Sync(p)
-				_la = p.GetTokenStream().LA(1)
-			}
-			{
-				p.SetState(331)
-				p.Match(OgParserT__4)
+			if !(_la == OgParserBLANK || _la == OgParserIDENTIFIER) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
 			}
 
-		default:
-			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		}
-
-	case OgParserT__60, OgParserT__61:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(334)
-			p.StructType()
-		}
-
-	case OgParserT__49:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(335)
-			p.InterfaceType()
 		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetState(350)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 14, p.GetParserRuleContext())
 	}
 
 	return localctx
 }
 
-// ITypeSpecContext is an interface to support dynamic dispatch.
-type ITypeSpecContext interface {
+// IExpressionListContext is an interface to support dynamic dispatch.
+type IExpressionListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeSpecContext differentiates from other interfaces.
-	IsTypeSpecContext()
+	// IsExpressionListContext differentiates from other interfaces.
+	IsExpressionListContext()
 }
 
-type TypeSpecContext struct {
+type ExpressionListContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeSpecContext() *TypeSpecContext {
-	var p = new(TypeSpecContext)
+func NewEmptyExpressionListContext() *ExpressionListContext {
+	var p = new(ExpressionListContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeSpec
+	p.RuleIndex = OgParserRULE_expressionList
 	return p
 }
 
-func (*TypeSpecContext) IsTypeSpecContext() {}
+func (*ExpressionListContext) IsExpressionListContext() {}
 
-func NewTypeSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSpecContext {
-	var p = new(TypeSpecContext)
+func NewExpressionListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionListContext {
+	var p = new(ExpressionListContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeSpec
+	p.RuleIndex = OgParserRULE_expressionList
 
 	return p
 }
 
-func (s *TypeSpecContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExpressionListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeSpecContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
+func (s *ExpressionListContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *TypeSpecContext) Type_() IType_Context {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
+func (s *ExpressionListContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IType_Context)
+	return t.(IExpressionContext)
 }
 
-func (s *TypeSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *ExpressionListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExpressionListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeSpec(s)
+		listenerT.EnterExpressionList(s)
 	}
 }
 
-func (s *TypeSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExpressionListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeSpec(s)
+		listenerT.ExitExpressionList(s)
 	}
 }
 
-func (s *TypeSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ExpressionListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeSpecVisitor:
-		return t.VisitTypeSpec(s, delegate)
+	case ExpressionListVisitor:
+		return t.VisitExpressionList(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeSpec() (localctx ITypeSpecContext) {
-	localctx = NewTypeSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, OgParserRULE_typeSpec)
+func (p *OgParser) ExpressionList() (localctx IExpressionListContext) {
+	localctx = NewExpressionListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, OgParserRULE_expressionList)
 
 	defer func() {
 		p.ExitRule()
@@ -3154,113 +3256,184 @@ func (p *OgParser) TypeSpec() (localctx ITypeSpecContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(338)
-		p.Match(OgParserIDENTIFIER)
-	}
-	{
-		p.SetState(339)
-		p.Type_()
+		p.SetState(351)
+		p.expression(0)
 	}
+	p.SetState(356)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext())
 
-	return localctx
-}
-
-// IFunctionDeclContext is an interface to support dynamic dispatch.
-type IFunctionDeclContext interface {
-	antlr.ParserRuleContext
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(352)
+				p.Match(OgParserT__11)
+			}
+			{
+				p.SetState(353)
+				p.expression(0)
+			}
+
+		}
+		p.SetState(358)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// ITypeDeclContext is an interface to support dynamic dispatch.
+type ITypeDeclContext interface {
+	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFunctionDeclContext differentiates from other interfaces.
-	IsFunctionDeclContext()
+	// IsTypeDeclContext differentiates from other interfaces.
+	IsTypeDeclContext()
 }
 
-type FunctionDeclContext struct {
+type TypeDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionDeclContext() *FunctionDeclContext {
-	var p = new(FunctionDeclContext)
+func NewEmptyTypeDeclContext() *TypeDeclContext {
+	var p = new(TypeDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_functionDecl
+	p.RuleIndex = OgParserRULE_typeDecl
 	return p
 }
 
-func (*FunctionDeclContext) IsFunctionDeclContext() {}
+func (*TypeDeclContext) IsTypeDeclContext() {}
 
-func NewFunctionDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionDeclContext {
-	var p = new(FunctionDeclContext)
+func NewTypeDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeDeclContext {
+	var p = new(TypeDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_functionDecl
+	p.RuleIndex = OgParserRULE_typeDecl
 
 	return p
 }
 
-func (s *FunctionDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionDeclContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
+func (s *TypeDeclContext) AllTypeSpec() []ITypeSpecContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem())
+	var tst = make([]ITypeSpecContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITypeSpecContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *FunctionDeclContext) Function() IFunctionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionContext)(nil)).Elem(), 0)
+func (s *TypeDeclContext) TypeSpec(i int) ITypeSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSpecContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFunctionContext)
+	return t.(ITypeSpecContext)
 }
 
-func (s *FunctionDeclContext) Signature() ISignatureContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
+func (s *TypeDeclContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *TypeDeclContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISignatureContext)
+	return t.(IEosContext)
 }
 
-func (s *FunctionDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeDeclContext) StructType() IStructTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStructTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStructTypeContext)
+}
+
+func (s *TypeDeclContext) InterfaceType() IInterfaceTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInterfaceTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IInterfaceTypeContext)
+}
+
+func (s *TypeDeclContext) DataDecl() IDataDeclContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDataDeclContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDataDeclContext)
+}
+
+func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterFunctionDecl(s)
+		listenerT.EnterTypeDecl(s)
 	}
 }
 
-func (s *FunctionDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitFunctionDecl(s)
+		listenerT.ExitTypeDecl(s)
 	}
 }
 
-func (s *FunctionDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case FunctionDeclVisitor:
-		return t.VisitFunctionDecl(s, delegate)
+	case TypeDeclVisitor:
+		return t.VisitTypeDecl(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) FunctionDecl() (localctx IFunctionDeclContext) {
-	localctx = NewFunctionDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, OgParserRULE_functionDecl)
+func (p *OgParser) TypeDecl() (localctx ITypeDeclContext) {
+	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, OgParserRULE_typeDecl)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3278,24 +3451,76 @@ func (p *OgParser) FunctionDecl() (localctx IFunctionDeclContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(341)
-		p.Match(OgParserIDENTIFIER)
-	}
-	p.SetState(344)
+	p.SetState(376)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext()) {
 	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(342)
-			p.Function()
+			p.SetState(359)
+			p.Match(OgParserT__12)
+		}
+		p.SetState(371)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case OgParserIDENTIFIER:
+			{
+				p.SetState(360)
+				p.TypeSpec()
+			}
+
+		case OgParserT__3:
+			{
+				p.SetState(361)
+				p.Match(OgParserT__3)
+			}
+			p.SetState(367)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for _la == OgParserIDENTIFIER {
+				{
+					p.SetState(362)
+					p.TypeSpec()
+				}
+				{
+					p.SetState(363)
+					p.Eos()
+				}
+
+				p.SetState(369)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+			{
+				p.SetState(370)
+				p.Match(OgParserT__4)
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
 	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(343)
-			p.Signature()
+			p.SetState(373)
+			p.StructType()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(374)
+			p.InterfaceType()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(375)
+			p.DataDecl()
 		}
 
 	}
@@ -3303,106 +3528,139 @@ func (p *OgParser) FunctionDecl() (localctx IFunctionDeclContext) {
 	return localctx
 }
 
-// IFunctionContext is an interface to support dynamic dispatch.
-type IFunctionContext interface {
+// IDataDeclContext is an interface to support dynamic dispatch.
+type IDataDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFunctionContext differentiates from other interfaces.
-	IsFunctionContext()
+	// IsDataDeclContext differentiates from other interfaces.
+	IsDataDeclContext()
 }
 
-type FunctionContext struct {
+type DataDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionContext() *FunctionContext {
-	var p = new(FunctionContext)
+func NewEmptyDataDeclContext() *DataDeclContext {
+	var p = new(DataDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_function
+	p.RuleIndex = OgParserRULE_dataDecl
 	return p
 }
 
-func (*FunctionContext) IsFunctionContext() {}
+func (*DataDeclContext) IsDataDeclContext() {}
 
-func NewFunctionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionContext {
-	var p = new(FunctionContext)
+func NewDataDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DataDeclContext {
+	var p = new(DataDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_function
+	p.RuleIndex = OgParserRULE_dataDecl
 
 	return p
 }
 
-func (s *FunctionContext) GetParser() antlr.Parser { return s.parser }
+func (s *DataDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionContext) Signature() ISignatureContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
+func (s *DataDeclContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(OgParserIDENTIFIER)
+}
 
-	if t == nil {
-		return nil
+func (s *DataDeclContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, i)
+}
+
+func (s *DataDeclContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *DataDeclContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *DataDeclContext) AllVariant() []IVariantContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVariantContext)(nil)).Elem())
+	var tst = make([]IVariantContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IVariantContext)
+		}
 	}
 
-	return t.(ISignatureContext)
+	return tst
 }
 
-func (s *FunctionContext) Block() IBlockContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+func (s *DataDeclContext) Variant(i int) IVariantContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVariantContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IBlockContext)
+	return t.(IVariantContext)
 }
 
-func (s *FunctionContext) Statement() IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+func (s *DataDeclContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *DataDeclContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementContext)
+	return t.(IEosContext)
 }
 
-func (s *FunctionContext) GetRuleContext() antlr.RuleContext {
+func (s *DataDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DataDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DataDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterFunction(s)
+		listenerT.EnterDataDecl(s)
 	}
 }
 
-func (s *FunctionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DataDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitFunction(s)
+		listenerT.ExitDataDecl(s)
 	}
 }
 
-func (s *FunctionContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *DataDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case FunctionVisitor:
-		return t.VisitFunction(s, delegate)
+	case DataDeclVisitor:
+		return t.VisitDataDecl(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Function() (localctx IFunctionContext) {
-	localctx = NewFunctionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, OgParserRULE_function)
+func (p *OgParser) DataDecl() (localctx IDataDeclContext) {
+	localctx = NewDataDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, OgParserRULE_dataDecl)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3420,135 +3678,193 @@ func (p *OgParser) Function() (localctx IFunctionContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
+	p.SetState(378)
+
+	if !(p.isData()) {
+		panic(antlr.NewFailedPredicateException(p, "p.isData()", ""))
+	}
 	{
-		p.SetState(346)
-		p.Signature()
+		p.SetState(379)
+		p.Match(OgParserIDENTIFIER)
 	}
 	{
-		p.SetState(347)
-		p.Match(OgParserFUNC)
+		p.SetState(380)
+		p.Match(OgParserIDENTIFIER)
+	}
+	p.SetState(381)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
-	p.SetState(350)
+	p.SetState(387)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 18, p.GetParserRuleContext()) {
-	case 1:
-		{
-			p.SetState(348)
-			p.Block()
-		}
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(382)
+				p.Variant()
+			}
+			{
+				p.SetState(383)
+				p.Eos()
+			}
 
-	case 2:
-		{
-			p.SetState(349)
-			p.Statement()
 		}
+		p.SetState(389)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext())
+	}
+	p.SetState(390)
+	_la = p.GetTokenStream().LA(1)
 
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
 	return localctx
 }
 
-// IMethodDeclContext is an interface to support dynamic dispatch.
-type IMethodDeclContext interface {
+// IVariantContext is an interface to support dynamic dispatch.
+type IVariantContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMethodDeclContext differentiates from other interfaces.
-	IsMethodDeclContext()
+	// IsVariantContext differentiates from other interfaces.
+	IsVariantContext()
 }
 
-type MethodDeclContext struct {
+type VariantContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMethodDeclContext() *MethodDeclContext {
-	var p = new(MethodDeclContext)
+func NewEmptyVariantContext() *VariantContext {
+	var p = new(VariantContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_methodDecl
+	p.RuleIndex = OgParserRULE_variant
 	return p
 }
 
-func (*MethodDeclContext) IsMethodDeclContext() {}
+func (*VariantContext) IsVariantContext() {}
 
-func NewMethodDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethodDeclContext {
-	var p = new(MethodDeclContext)
+func NewVariantContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VariantContext {
+	var p = new(VariantContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_methodDecl
+	p.RuleIndex = OgParserRULE_variant
 
 	return p
 }
 
-func (s *MethodDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *VariantContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MethodDeclContext) Receiver() IReceiverContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReceiverContext)(nil)).Elem(), 0)
+func (s *VariantContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
+}
 
-	if t == nil {
-		return nil
+func (s *VariantContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *VariantContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *VariantContext) AllFieldDecl() []IFieldDeclContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFieldDeclContext)(nil)).Elem())
+	var tst = make([]IFieldDeclContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IFieldDeclContext)
+		}
 	}
 
-	return t.(IReceiverContext)
+	return tst
 }
 
-func (s *MethodDeclContext) Function() IFunctionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionContext)(nil)).Elem(), 0)
+func (s *VariantContext) FieldDecl(i int) IFieldDeclContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFieldDeclContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFunctionContext)
+	return t.(IFieldDeclContext)
 }
 
-func (s *MethodDeclContext) Signature() ISignatureContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
+func (s *VariantContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *VariantContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISignatureContext)
+	return t.(IEosContext)
 }
 
-func (s *MethodDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *VariantContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MethodDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *VariantContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MethodDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *VariantContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterMethodDecl(s)
+		listenerT.EnterVariant(s)
 	}
 }
 
-func (s *MethodDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *VariantContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitMethodDecl(s)
+		listenerT.ExitVariant(s)
 	}
 }
 
-func (s *MethodDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *VariantContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case MethodDeclVisitor:
-		return t.VisitMethodDecl(s, delegate)
+	case VariantVisitor:
+		return t.VisitVariant(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) MethodDecl() (localctx IMethodDeclContext) {
-	localctx = NewMethodDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, OgParserRULE_methodDecl)
+func (p *OgParser) Variant() (localctx IVariantContext) {
+	localctx = NewVariantContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, OgParserRULE_variant)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3566,24 +3882,61 @@ func (p *OgParser) MethodDecl() (localctx IMethodDeclContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(352)
-		p.Receiver()
-	}
-	p.SetState(355)
+	var _alt int
+
+	p.SetState(404)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
 	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(353)
-			p.Function()
+			p.SetState(392)
+			p.Match(OgParserIDENTIFIER)
+		}
+		p.SetState(393)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+		p.SetState(399)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(394)
+					p.FieldDecl()
+				}
+				{
+					p.SetState(395)
+					p.Eos()
+				}
+
+			}
+			p.SetState(401)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext())
+		}
+		p.SetState(402)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 
 	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(354)
-			p.Signature()
+			p.SetState(403)
+			p.FieldDecl()
 		}
 
 	}
@@ -3591,85 +3944,90 @@ func (p *OgParser) MethodDecl() (localctx IMethodDeclContext) {
 	return localctx
 }
 
-// IReceiverContext is an interface to support dynamic dispatch.
-type IReceiverContext interface {
+// ITypeSpecContext is an interface to support dynamic dispatch.
+type ITypeSpecContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsReceiverContext differentiates from other interfaces.
-	IsReceiverContext()
+	// IsTypeSpecContext differentiates from other interfaces.
+	IsTypeSpecContext()
 }
 
-type ReceiverContext struct {
+type TypeSpecContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyReceiverContext() *ReceiverContext {
-	var p = new(ReceiverContext)
+func NewEmptyTypeSpecContext() *TypeSpecContext {
+	var p = new(TypeSpecContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_receiver
+	p.RuleIndex = OgParserRULE_typeSpec
 	return p
 }
 
-func (*ReceiverContext) IsReceiverContext() {}
+func (*TypeSpecContext) IsTypeSpecContext() {}
 
-func NewReceiverContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReceiverContext {
-	var p = new(ReceiverContext)
+func NewTypeSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSpecContext {
+	var p = new(TypeSpecContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_receiver
+	p.RuleIndex = OgParserRULE_typeSpec
 
 	return p
 }
 
-func (s *ReceiverContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeSpecContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ReceiverContext) AllIDENTIFIER() []antlr.TerminalNode {
-	return s.GetTokens(OgParserIDENTIFIER)
+func (s *TypeSpecContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *ReceiverContext) IDENTIFIER(i int) antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, i)
+func (s *TypeSpecContext) Type_() IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IType_Context)
 }
 
-func (s *ReceiverContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeSpecContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReceiverContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReceiverContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeSpecContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterReceiver(s)
+		listenerT.EnterTypeSpec(s)
 	}
 }
 
-func (s *ReceiverContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeSpecContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitReceiver(s)
+		listenerT.ExitTypeSpec(s)
 	}
 }
 
-func (s *ReceiverContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ReceiverVisitor:
-		return t.VisitReceiver(s, delegate)
+	case TypeSpecVisitor:
+		return t.VisitTypeSpec(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Receiver() (localctx IReceiverContext) {
-	localctx = NewReceiverContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, OgParserRULE_receiver)
-	var _la int
+func (p *OgParser) TypeSpec() (localctx ITypeSpecContext) {
+	localctx = NewTypeSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, OgParserRULE_typeSpec)
 
 	defer func() {
 		p.ExitRule()
@@ -3689,149 +4047,111 @@ func (p *OgParser) Receiver() (localctx IReceiverContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(357)
+		p.SetState(406)
 		p.Match(OgParserIDENTIFIER)
 	}
 	{
-		p.SetState(358)
-		p.Match(OgParserT__14)
-	}
-	p.SetState(360)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if _la == OgParserT__15 {
-		{
-			p.SetState(359)
-			p.Match(OgParserT__15)
-		}
-
-	}
-	{
-		p.SetState(362)
-		p.Match(OgParserIDENTIFIER)
+		p.SetState(407)
+		p.Type_()
 	}
 
 	return localctx
 }
 
-// IVarDeclContext is an interface to support dynamic dispatch.
-type IVarDeclContext interface {
+// IFunctionDeclContext is an interface to support dynamic dispatch.
+type IFunctionDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsVarDeclContext differentiates from other interfaces.
-	IsVarDeclContext()
+	// IsFunctionDeclContext differentiates from other interfaces.
+	IsFunctionDeclContext()
 }
 
-type VarDeclContext struct {
+type FunctionDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVarDeclContext() *VarDeclContext {
-	var p = new(VarDeclContext)
+func NewEmptyFunctionDeclContext() *FunctionDeclContext {
+	var p = new(FunctionDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_varDecl
+	p.RuleIndex = OgParserRULE_functionDecl
 	return p
 }
 
-func (*VarDeclContext) IsVarDeclContext() {}
+func (*FunctionDeclContext) IsFunctionDeclContext() {}
 
-func NewVarDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VarDeclContext {
-	var p = new(VarDeclContext)
+func NewFunctionDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionDeclContext {
+	var p = new(FunctionDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_varDecl
+	p.RuleIndex = OgParserRULE_functionDecl
 
 	return p
 }
 
-func (s *VarDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *FunctionDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *VarDeclContext) AllVarSpec() []IVarSpecContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVarSpecContext)(nil)).Elem())
-	var tst = make([]IVarSpecContext, len(ts))
+func (s *FunctionDeclContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
+}
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IVarSpecContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *VarDeclContext) VarSpec(i int) IVarSpecContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVarSpecContext)(nil)).Elem(), i)
+func (s *FunctionDeclContext) Function() IFunctionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IVarSpecContext)
-}
-
-func (s *VarDeclContext) AllEos() []IEosContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
-	var tst = make([]IEosContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IEosContext)
-		}
-	}
-
-	return tst
+	return t.(IFunctionContext)
 }
 
-func (s *VarDeclContext) Eos(i int) IEosContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
+func (s *FunctionDeclContext) Signature() ISignatureContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IEosContext)
+	return t.(ISignatureContext)
 }
 
-func (s *VarDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VarDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FunctionDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VarDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterVarDecl(s)
+		listenerT.EnterFunctionDecl(s)
 	}
 }
 
-func (s *VarDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitVarDecl(s)
+		listenerT.ExitFunctionDecl(s)
 	}
 }
 
-func (s *VarDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case VarDeclVisitor:
-		return t.VisitVarDecl(s, delegate)
+	case FunctionDeclVisitor:
+		return t.VisitFunctionDecl(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) VarDecl() (localctx IVarDeclContext) {
-	localctx = NewVarDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, OgParserRULE_varDecl)
-	var _la int
+func (p *OgParser) FunctionDecl() (localctx IFunctionDeclContext) {
+	localctx = NewFunctionDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, OgParserRULE_functionDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -3851,123 +4171,88 @@ func (p *OgParser) VarDecl() (localctx IVarDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(364)
-		p.Match(OgParserT__16)
+		p.SetState(409)
+		p.Match(OgParserIDENTIFIER)
 	}
-	p.SetState(376)
+	p.SetState(412)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__11, OgParserIDENTIFIER:
-		{
-			p.SetState(365)
-			p.VarSpec()
-		}
-
-	case OgParserT__3:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(366)
-			p.Match(OgParserT__3)
+			p.SetState(410)
+			p.Function()
 		}
-		p.SetState(372)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == OgParserT__11 || _la == OgParserIDENTIFIER {
-			{
-				p.SetState(367)
-				p.VarSpec()
-			}
-			{
-				p.SetState(368)
-				p.Eos()
-			}
 
-			p.SetState(374)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
-		}
+	case 2:
 		{
-			p.SetState(375)
-			p.Match(OgParserT__4)
+			p.SetState(411)
+			p.Signature()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IVarSpecContext is an interface to support dynamic dispatch.
-type IVarSpecContext interface {
+// IFunctionContext is an interface to support dynamic dispatch.
+type IFunctionContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsVarSpecContext differentiates from other interfaces.
-	IsVarSpecContext()
+	// IsFunctionContext differentiates from other interfaces.
+	IsFunctionContext()
 }
 
-type VarSpecContext struct {
+type FunctionContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVarSpecContext() *VarSpecContext {
-	var p = new(VarSpecContext)
+func NewEmptyFunctionContext() *FunctionContext {
+	var p = new(FunctionContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_varSpec
+	p.RuleIndex = OgParserRULE_function
 	return p
 }
 
-func (*VarSpecContext) IsVarSpecContext() {}
+func (*FunctionContext) IsFunctionContext() {}
 
-func NewVarSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VarSpecContext {
-	var p = new(VarSpecContext)
+func NewFunctionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionContext {
+	var p = new(FunctionContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_varSpec
+	p.RuleIndex = OgParserRULE_function
 
 	return p
 }
 
-func (s *VarSpecContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *VarSpecContext) IdentifierList() IIdentifierListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIdentifierListContext)
-}
+func (s *FunctionContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *VarSpecContext) Type_() IType_Context {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
+func (s *FunctionContext) Signature() ISignatureContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IType_Context)
+	return t.(ISignatureContext)
 }
 
-func (s *VarSpecContext) ExpressionList() IExpressionListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
+func (s *FunctionContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionListContext)
+	return t.(IBlockContext)
 }
 
-func (s *VarSpecContext) Statement() IStatementContext {
+func (s *FunctionContext) Statement() IStatementContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -3977,38 +4262,38 @@ func (s *VarSpecContext) Statement() IStatementContext {
 	return t.(IStatementContext)
 }
 
-func (s *VarSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VarSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FunctionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VarSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterVarSpec(s)
+		listenerT.EnterFunction(s)
 	}
 }
 
-func (s *VarSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitVarSpec(s)
+		listenerT.ExitFunction(s)
 	}
 }
 
-func (s *VarSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case VarSpecVisitor:
-		return t.VisitVarSpec(s, delegate)
+	case FunctionVisitor:
+		return t.VisitFunction(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) VarSpec() (localctx IVarSpecContext) {
-	localctx = NewVarSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, OgParserRULE_varSpec)
+func (p *OgParser) Function() (localctx IFunctionContext) {
+	localctx = NewFunctionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, OgParserRULE_function)
 
 	defer func() {
 		p.ExitRule()
@@ -4028,130 +4313,133 @@ func (p *OgParser) VarSpec() (localctx IVarSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(378)
-		p.IdentifierList()
+		p.SetState(414)
+		p.Signature()
+	}
+	{
+		p.SetState(415)
+		p.Match(OgParserFUNC)
 	}
-	p.SetState(386)
+	p.SetState(418)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__3, OgParserT__15, OgParserT__17, OgParserT__47, OgParserT__49, OgParserT__50, OgParserT__51, OgParserT__52, OgParserT__59, OgParserT__60, OgParserT__61, OgParserIDENTIFIER:
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(379)
-			p.Type_()
-		}
-		p.SetState(382)
-		p.GetErrorHandler().Sync(p)
-
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
-			{
-				p.SetState(380)
-				p.Match(OgParserT__10)
-			}
-			{
-				p.SetState(381)
-				p.Statement()
-			}
-
+			p.SetState(416)
+			p.Block()
 		}
 
-	case OgParserT__10:
-		{
-			p.SetState(384)
-			p.Match(OgParserT__10)
-		}
+	case 2:
 		{
-			p.SetState(385)
-			p.ExpressionList()
+			p.SetState(417)
+			p.Statement()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IBlockContext is an interface to support dynamic dispatch.
-type IBlockContext interface {
+// IMethodDeclContext is an interface to support dynamic dispatch.
+type IMethodDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsBlockContext differentiates from other interfaces.
-	IsBlockContext()
+	// IsMethodDeclContext differentiates from other interfaces.
+	IsMethodDeclContext()
 }
 
-type BlockContext struct {
+type MethodDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBlockContext() *BlockContext {
-	var p = new(BlockContext)
+func NewEmptyMethodDeclContext() *MethodDeclContext {
+	var p = new(MethodDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_block
+	p.RuleIndex = OgParserRULE_methodDecl
 	return p
 }
 
-func (*BlockContext) IsBlockContext() {}
+func (*MethodDeclContext) IsMethodDeclContext() {}
 
-func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
-	var p = new(BlockContext)
+func NewMethodDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethodDeclContext {
+	var p = new(MethodDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_block
+	p.RuleIndex = OgParserRULE_methodDecl
 
 	return p
 }
 
-func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+func (s *MethodDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BlockContext) StatementList() IStatementListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
+func (s *MethodDeclContext) Receiver() IReceiverContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReceiverContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementListContext)
+	return t.(IReceiverContext)
 }
 
-func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+func (s *MethodDeclContext) Function() IFunctionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionContext)
+}
+
+func (s *MethodDeclContext) Signature() ISignatureContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignatureContext)
+}
+
+func (s *MethodDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MethodDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BlockContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MethodDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterBlock(s)
+		listenerT.EnterMethodDecl(s)
 	}
 }
 
-func (s *BlockContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MethodDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitBlock(s)
+		listenerT.ExitMethodDecl(s)
 	}
 }
 
-func (s *BlockContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *MethodDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case BlockVisitor:
-		return t.VisitBlock(s, delegate)
+	case MethodDeclVisitor:
+		return t.VisitMethodDecl(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Block() (localctx IBlockContext) {
-	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, OgParserRULE_block)
+func (p *OgParser) MethodDecl() (localctx IMethodDeclContext) {
+	localctx = NewMethodDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, OgParserRULE_methodDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -4171,137 +4459,108 @@ func (p *OgParser) Block() (localctx IBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(388)
-		p.Match(OgParserT__5)
-	}
-	{
-		p.SetState(389)
-		p.StatementList()
+		p.SetState(420)
+		p.Receiver()
 	}
-	{
-		p.SetState(390)
-		p.Match(OgParserT__6)
+	p.SetState(423)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 24, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(421)
+			p.Function()
+		}
+
+	case 2:
+		{
+			p.SetState(422)
+			p.Signature()
+		}
+
 	}
 
 	return localctx
 }
 
-// IStatementListContext is an interface to support dynamic dispatch.
-type IStatementListContext interface {
+// IReceiverContext is an interface to support dynamic dispatch.
+type IReceiverContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsStatementListContext differentiates from other interfaces.
-	IsStatementListContext()
+	// IsReceiverContext differentiates from other interfaces.
+	IsReceiverContext()
 }
 
-type StatementListContext struct {
+type ReceiverContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStatementListContext() *StatementListContext {
-	var p = new(StatementListContext)
+func NewEmptyReceiverContext() *ReceiverContext {
+	var p = new(ReceiverContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_statementList
+	p.RuleIndex = OgParserRULE_receiver
 	return p
 }
 
-func (*StatementListContext) IsStatementListContext() {}
+func (*ReceiverContext) IsReceiverContext() {}
 
-func NewStatementListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementListContext {
-	var p = new(StatementListContext)
+func NewReceiverContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReceiverContext {
+	var p = new(ReceiverContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_statementList
+	p.RuleIndex = OgParserRULE_receiver
 
 	return p
 }
 
-func (s *StatementListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *StatementListContext) AllStatement() []IStatementContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
-	var tst = make([]IStatementContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IStatementContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *StatementListContext) Statement(i int) IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStatementContext)
-}
-
-func (s *StatementListContext) AllEos() []IEosContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
-	var tst = make([]IEosContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IEosContext)
-		}
-	}
+func (s *ReceiverContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *ReceiverContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(OgParserIDENTIFIER)
 }
 
-func (s *StatementListContext) Eos(i int) IEosContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IEosContext)
+func (s *ReceiverContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, i)
 }
 
-func (s *StatementListContext) GetRuleContext() antlr.RuleContext {
+func (s *ReceiverContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StatementListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReceiverContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StatementListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ReceiverContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterStatementList(s)
+		listenerT.EnterReceiver(s)
 	}
 }
 
-func (s *StatementListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ReceiverContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitStatementList(s)
+		listenerT.ExitReceiver(s)
 	}
 }
 
-func (s *StatementListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ReceiverContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case StatementListVisitor:
-		return t.VisitStatementList(s, delegate)
+	case ReceiverVisitor:
+		return t.VisitReceiver(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) StatementList() (localctx IStatementListContext) {
-	localctx = NewStatementListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, OgParserRULE_statementList)
+func (p *OgParser) Receiver() (localctx IReceiverContext) {
+	localctx = NewReceiverContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, OgParserRULE_receiver)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -4319,253 +4578,1853 @@ func (p *OgParser) StatementList() (localctx IStatementListContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(397)
+	{
+		p.SetState(425)
+		p.Match(OgParserIDENTIFIER)
+	}
+	{
+		p.SetState(426)
+		p.Match(OgParserT__13)
+	}
+	p.SetState(428)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
-
-	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-		if _alt == 1 {
-			{
-				p.SetState(392)
-				p.Statement()
-			}
-			{
-				p.SetState(393)
-				p.Eos()
-			}
+	_la = p.GetTokenStream().LA(1)
 
+	if _la == OgParserT__14 {
+		{
+			p.SetState(427)
+			p.Match(OgParserT__14)
 		}
-		p.SetState(399)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
+
+	}
+	{
+		p.SetState(430)
+		p.Match(OgParserIDENTIFIER)
 	}
 
 	return localctx
 }
 
-// IStatementContext is an interface to support dynamic dispatch.
-type IStatementContext interface {
+// IVarDeclContext is an interface to support dynamic dispatch.
+type IVarDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsStatementContext differentiates from other interfaces.
-	IsStatementContext()
+	// IsVarDeclContext differentiates from other interfaces.
+	IsVarDeclContext()
 }
 
-type StatementContext struct {
+type VarDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyStatementContext() *StatementContext {
-	var p = new(StatementContext)
+func NewEmptyVarDeclContext() *VarDeclContext {
+	var p = new(VarDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_statement
+	p.RuleIndex = OgParserRULE_varDecl
 	return p
 }
 
-func (*StatementContext) IsStatementContext() {}
+func (*VarDeclContext) IsVarDeclContext() {}
 
-func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementContext {
-	var p = new(StatementContext)
+func NewVarDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VarDeclContext {
+	var p = new(VarDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_statement
+	p.RuleIndex = OgParserRULE_varDecl
 
 	return p
 }
 
-func (s *StatementContext) GetParser() antlr.Parser { return s.parser }
+func (s *VarDeclContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *StatementContext) ForStmt() IForStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForStmtContext)(nil)).Elem(), 0)
+func (s *VarDeclContext) AllVarSpec() []IVarSpecContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVarSpecContext)(nil)).Elem())
+	var tst = make([]IVarSpecContext, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IVarSpecContext)
+		}
 	}
 
-	return t.(IForStmtContext)
+	return tst
 }
 
-func (s *StatementContext) SimpleStmt() ISimpleStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
+func (s *VarDeclContext) VarSpec(i int) IVarSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVarSpecContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISimpleStmtContext)
+	return t.(IVarSpecContext)
 }
 
-func (s *StatementContext) GoStmt() IGoStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGoStmtContext)(nil)).Elem(), 0)
+func (s *VarDeclContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
 	}
 
-	return t.(IGoStmtContext)
+	return tst
 }
 
-func (s *StatementContext) ReturnStmt() IReturnStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnStmtContext)(nil)).Elem(), 0)
+func (s *VarDeclContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IReturnStmtContext)
+	return t.(IEosContext)
 }
 
-func (s *StatementContext) BreakStmt() IBreakStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBreakStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IBreakStmtContext)
+func (s *VarDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
 }
 
-func (s *StatementContext) ContinueStmt() IContinueStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IContinueStmtContext)(nil)).Elem(), 0)
+func (s *VarDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
 
-	if t == nil {
-		return nil
+func (s *VarDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterVarDecl(s)
 	}
-
-	return t.(IContinueStmtContext)
 }
 
-func (s *StatementContext) GotoStmt() IGotoStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGotoStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
+func (s *VarDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitVarDecl(s)
 	}
-
-	return t.(IGotoStmtContext)
 }
 
-func (s *StatementContext) FallthroughStmt() IFallthroughStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFallthroughStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
+func (s *VarDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case VarDeclVisitor:
+		return t.VisitVarDecl(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
 	}
-
-	return t.(IFallthroughStmtContext)
 }
 
-func (s *StatementContext) IfStmt() IIfStmtContext {
+func (p *OgParser) VarDecl() (localctx IVarDeclContext) {
+	localctx = NewVarDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, OgParserRULE_varDecl)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(432)
+		p.Match(OgParserT__15)
+	}
+	p.SetState(444)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case OgParserBLANK, OgParserIDENTIFIER:
+		{
+			p.SetState(433)
+			p.VarSpec()
+		}
+
+	case OgParserT__3:
+		{
+			p.SetState(434)
+			p.Match(OgParserT__3)
+		}
+		p.SetState(440)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == OgParserBLANK || _la == OgParserIDENTIFIER {
+			{
+				p.SetState(435)
+				p.VarSpec()
+			}
+			{
+				p.SetState(436)
+				p.Eos()
+			}
+
+			p.SetState(442)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(443)
+			p.Match(OgParserT__4)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IVarSpecContext is an interface to support dynamic dispatch.
+type IVarSpecContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsVarSpecContext differentiates from other interfaces.
+	IsVarSpecContext()
+}
+
+type VarSpecContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVarSpecContext() *VarSpecContext {
+	var p = new(VarSpecContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_varSpec
+	return p
+}
+
+func (*VarSpecContext) IsVarSpecContext() {}
+
+func NewVarSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VarSpecContext {
+	var p = new(VarSpecContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_varSpec
+
+	return p
+}
+
+func (s *VarSpecContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VarSpecContext) IdentifierList() IIdentifierListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *VarSpecContext) Type_() IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IType_Context)
+}
+
+func (s *VarSpecContext) ExpressionList() IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionListContext)
+}
+
+func (s *VarSpecContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *VarSpecContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VarSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *VarSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterVarSpec(s)
+	}
+}
+
+func (s *VarSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitVarSpec(s)
+	}
+}
+
+func (s *VarSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case VarSpecVisitor:
+		return t.VisitVarSpec(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) VarSpec() (localctx IVarSpecContext) {
+	localctx = NewVarSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, OgParserRULE_varSpec)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(446)
+		p.IdentifierList()
+	}
+	p.SetState(454)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case OgParserT__3, OgParserT__14, OgParserT__16, OgParserT__44, OgParserT__46, OgParserT__47, OgParserT__48, OgParserT__49, OgParserT__56, OgParserT__57, OgParserSTRUCT, OgParserIDENTIFIER:
+		{
+			p.SetState(447)
+			p.Type_()
+		}
+		p.SetState(450)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(448)
+				p.Match(OgParserT__9)
+			}
+			{
+				p.SetState(449)
+				p.Statement()
+			}
+
+		}
+
+	case OgParserT__9:
+		{
+			p.SetState(452)
+			p.Match(OgParserT__9)
+		}
+		{
+			p.SetState(453)
+			p.ExpressionList()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IBlockContext is an interface to support dynamic dispatch.
+type IBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBlockContext differentiates from other interfaces.
+	IsBlockContext()
+}
+
+type BlockContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBlockContext() *BlockContext {
+	var p = new(BlockContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_block
+	return p
+}
+
+func (*BlockContext) IsBlockContext() {}
+
+func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
+	var p = new(BlockContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_block
+
+	return p
+}
+
+func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BlockContext) StatementList() IStatementListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementListContext)
+}
+
+func (s *BlockContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *BlockContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BlockContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterBlock(s)
+	}
+}
+
+func (s *BlockContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitBlock(s)
+	}
+}
+
+func (s *BlockContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case BlockVisitor:
+		return t.VisitBlock(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) Block() (localctx IBlockContext) {
+	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, OgParserRULE_block)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(456)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+	{
+		p.SetState(457)
+		p.StatementList()
+	}
+	p.SetState(458)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+
+	return localctx
+}
+
+// IStatementListContext is an interface to support dynamic dispatch.
+type IStatementListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsStatementListContext differentiates from other interfaces.
+	IsStatementListContext()
+}
+
+type StatementListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStatementListContext() *StatementListContext {
+	var p = new(StatementListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_statementList
+	return p
+}
+
+func (*StatementListContext) IsStatementListContext() {}
+
+func NewStatementListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementListContext {
+	var p = new(StatementListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_statementList
+
+	return p
+}
+
+func (s *StatementListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StatementListContext) AllStatement() []IStatementContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
+	var tst = make([]IStatementContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStatementContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *StatementListContext) Statement(i int) IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *StatementListContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *StatementListContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEosContext)
+}
+
+func (s *StatementListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StatementListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StatementListContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterStatementList(s)
+	}
+}
+
+func (s *StatementListContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitStatementList(s)
+	}
+}
+
+func (s *StatementListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case StatementListVisitor:
+		return t.VisitStatementList(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) StatementList() (localctx IStatementListContext) {
+	localctx = NewStatementListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, OgParserRULE_statementList)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(465)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(460)
+				p.Statement()
+			}
+			{
+				p.SetState(461)
+				p.Eos()
+			}
+
+		}
+		p.SetState(467)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IStatementContext is an interface to support dynamic dispatch.
+type IStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsStatementContext differentiates from other interfaces.
+	IsStatementContext()
+}
+
+type StatementContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStatementContext() *StatementContext {
+	var p = new(StatementContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_statement
+	return p
+}
+
+func (*StatementContext) IsStatementContext() {}
+
+func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementContext {
+	var p = new(StatementContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_statement
+
+	return p
+}
+
+func (s *StatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StatementContext) ForStmt() IForStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForStmtContext)
+}
+
+func (s *StatementContext) SimpleStmt() ISimpleStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISimpleStmtContext)
+}
+
+func (s *StatementContext) GoStmt() IGoStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGoStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGoStmtContext)
+}
+
+func (s *StatementContext) ReturnStmt() IReturnStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IReturnStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IReturnStmtContext)
+}
+
+func (s *StatementContext) BreakStmt() IBreakStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBreakStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBreakStmtContext)
+}
+
+func (s *StatementContext) ContinueStmt() IContinueStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IContinueStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IContinueStmtContext)
+}
+
+func (s *StatementContext) GotoStmt() IGotoStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IGotoStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IGotoStmtContext)
+}
+
+func (s *StatementContext) FallthroughStmt() IFallthroughStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFallthroughStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFallthroughStmtContext)
+}
+
+func (s *StatementContext) IfStmt() IIfStmtContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfStmtContext)(nil)).Elem(), 0)
 
-	if t == nil {
-		return nil
-	}
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStmtContext)
+}
+
+func (s *StatementContext) SwitchStmt() ISwitchStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISwitchStmtContext)
+}
+
+func (s *StatementContext) SelectStmt() ISelectStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISelectStmtContext)
+}
+
+func (s *StatementContext) DeferStmt() IDeferStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDeferStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeferStmtContext)
+}
+
+func (s *StatementContext) LabeledStmt() ILabeledStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILabeledStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILabeledStmtContext)
+}
+
+func (s *StatementContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *StatementContext) Declaration() IDeclarationContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDeclarationContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDeclarationContext)
+}
+
+func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StatementContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterStatement(s)
+	}
+}
+
+func (s *StatementContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitStatement(s)
+	}
+}
+
+func (s *StatementContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case StatementVisitor:
+		return t.VisitStatement(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) Statement() (localctx IStatementContext) {
+	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, OgParserRULE_statement)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(483)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(468)
+			p.ForStmt()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(469)
+			p.SimpleStmt()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(470)
+			p.GoStmt()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(471)
+			p.ReturnStmt()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(472)
+			p.BreakStmt()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(473)
+			p.ContinueStmt()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(474)
+			p.GotoStmt()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(475)
+			p.FallthroughStmt()
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(476)
+			p.IfStmt()
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(477)
+			p.SwitchStmt()
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(478)
+			p.SelectStmt()
+		}
+
+	case 12:
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(479)
+			p.DeferStmt()
+		}
+
+	case 13:
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(480)
+			p.LabeledStmt()
+		}
+
+	case 14:
+		p.EnterOuterAlt(localctx, 14)
+		{
+			p.SetState(481)
+			p.Block()
+		}
+
+	case 15:
+		p.EnterOuterAlt(localctx, 15)
+		{
+			p.SetState(482)
+			p.Declaration()
+		}
+
+	}
+
+	return localctx
+}
+
+// ISimpleStmtContext is an interface to support dynamic dispatch.
+type ISimpleStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSimpleStmtContext differentiates from other interfaces.
+	IsSimpleStmtContext()
+}
+
+type SimpleStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySimpleStmtContext() *SimpleStmtContext {
+	var p = new(SimpleStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_simpleStmt
+	return p
+}
+
+func (*SimpleStmtContext) IsSimpleStmtContext() {}
+
+func NewSimpleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SimpleStmtContext {
+	var p = new(SimpleStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_simpleStmt
+
+	return p
+}
+
+func (s *SimpleStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SimpleStmtContext) SendStmt() ISendStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISendStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISendStmtContext)
+}
+
+func (s *SimpleStmtContext) IncDecStmt() IIncDecStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIncDecStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIncDecStmtContext)
+}
+
+func (s *SimpleStmtContext) ShortVarDecl() IShortVarDeclContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShortVarDeclContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IShortVarDeclContext)
+}
+
+func (s *SimpleStmtContext) Assignment() IAssignmentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignmentContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAssignmentContext)
+}
+
+func (s *SimpleStmtContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SimpleStmtContext) EmptyStmt() IEmptyStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEmptyStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEmptyStmtContext)
+}
+
+func (s *SimpleStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SimpleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SimpleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterSimpleStmt(s)
+	}
+}
+
+func (s *SimpleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitSimpleStmt(s)
+	}
+}
+
+func (s *SimpleStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case SimpleStmtVisitor:
+		return t.VisitSimpleStmt(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) SimpleStmt() (localctx ISimpleStmtContext) {
+	localctx = NewSimpleStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, OgParserRULE_simpleStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(491)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(485)
+			p.SendStmt()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(486)
+			p.IncDecStmt()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(487)
+			p.ShortVarDecl()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(488)
+			p.Assignment()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(489)
+			p.expression(0)
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(490)
+			p.EmptyStmt()
+		}
+
+	}
+
+	return localctx
+}
+
+// ISendStmtContext is an interface to support dynamic dispatch.
+type ISendStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSendStmtContext differentiates from other interfaces.
+	IsSendStmtContext()
+}
+
+type SendStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySendStmtContext() *SendStmtContext {
+	var p = new(SendStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_sendStmt
+	return p
+}
+
+func (*SendStmtContext) IsSendStmtContext() {}
+
+func NewSendStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SendStmtContext {
+	var p = new(SendStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_sendStmt
+
+	return p
+}
+
+func (s *SendStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SendStmtContext) AllExpression() []IExpressionContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
+	var tst = make([]IExpressionContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *SendStmtContext) Expression(i int) IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *SendStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SendStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SendStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterSendStmt(s)
+	}
+}
+
+func (s *SendStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitSendStmt(s)
+	}
+}
+
+func (s *SendStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case SendStmtVisitor:
+		return t.VisitSendStmt(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) SendStmt() (localctx ISendStmtContext) {
+	localctx = NewSendStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, OgParserRULE_sendStmt)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(493)
+		p.expression(0)
+	}
+	{
+		p.SetState(494)
+		p.Match(OgParserT__16)
+	}
+	{
+		p.SetState(495)
+		p.expression(0)
+	}
+
+	return localctx
+}
+
+// IIncDecStmtContext is an interface to support dynamic dispatch.
+type IIncDecStmtContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsIncDecStmtContext differentiates from other interfaces.
+	IsIncDecStmtContext()
+}
+
+type IncDecStmtContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyIncDecStmtContext() *IncDecStmtContext {
+	var p = new(IncDecStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_incDecStmt
+	return p
+}
+
+func (*IncDecStmtContext) IsIncDecStmtContext() {}
+
+func NewIncDecStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IncDecStmtContext {
+	var p = new(IncDecStmtContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_incDecStmt
+
+	return p
+}
+
+func (s *IncDecStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *IncDecStmtContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *IncDecStmtContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IncDecStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *IncDecStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterIncDecStmt(s)
+	}
+}
+
+func (s *IncDecStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitIncDecStmt(s)
+	}
+}
+
+func (s *IncDecStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case IncDecStmtVisitor:
+		return t.VisitIncDecStmt(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) IncDecStmt() (localctx IIncDecStmtContext) {
+	localctx = NewIncDecStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, OgParserRULE_incDecStmt)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(497)
+		p.expression(0)
+	}
+	p.SetState(498)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__17 || _la == OgParserT__18) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+
+	return localctx
+}
+
+// IAssignmentContext is an interface to support dynamic dispatch.
+type IAssignmentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAssignmentContext differentiates from other interfaces.
+	IsAssignmentContext()
+}
+
+type AssignmentContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAssignmentContext() *AssignmentContext {
+	var p = new(AssignmentContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_assignment
+	return p
+}
+
+func (*AssignmentContext) IsAssignmentContext() {}
+
+func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
+	var p = new(AssignmentContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_assignment
+
+	return p
+}
+
+func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AssignmentContext) AllExpressionList() []IExpressionListContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionListContext)(nil)).Elem())
+	var tst = make([]IExpressionListContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExpressionListContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *AssignmentContext) ExpressionList(i int) IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionListContext)
+}
+
+func (s *AssignmentContext) Assign_op() IAssign_opContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssign_opContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAssign_opContext)
+}
+
+func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterAssignment(s)
+	}
+}
+
+func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitAssignment(s)
+	}
+}
+
+func (s *AssignmentContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case AssignmentVisitor:
+		return t.VisitAssignment(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) Assignment() (localctx IAssignmentContext) {
+	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, OgParserRULE_assignment)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(500)
+		p.ExpressionList()
+	}
+	{
+		p.SetState(501)
+		p.Assign_op()
+	}
+	{
+		p.SetState(502)
+		p.ExpressionList()
+	}
+
+	return localctx
+}
+
+// IAssign_opContext is an interface to support dynamic dispatch.
+type IAssign_opContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAssign_opContext differentiates from other interfaces.
+	IsAssign_opContext()
+}
+
+type Assign_opContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAssign_opContext() *Assign_opContext {
+	var p = new(Assign_opContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_assign_op
+	return p
+}
+
+func (*Assign_opContext) IsAssign_opContext() {}
+
+func NewAssign_opContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Assign_opContext {
+	var p = new(Assign_opContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_assign_op
+
+	return p
+}
+
+func (s *Assign_opContext) GetParser() antlr.Parser { return s.parser }
+func (s *Assign_opContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *Assign_opContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *Assign_opContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.EnterAssign_op(s)
+	}
+}
+
+func (s *Assign_opContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(OgListener); ok {
+		listenerT.ExitAssign_op(s)
+	}
+}
+
+func (s *Assign_opContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case Assign_opVisitor:
+		return t.VisitAssign_op(s, delegate)
+	default:
+		return delegate.VisitChildren(s, delegate)
+	}
+}
+
+func (p *OgParser) Assign_op() (localctx IAssign_opContext) {
+	localctx = NewAssign_opContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, OgParserRULE_assign_op)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(505)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__14)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__22)|(1<<OgParserT__23)|(1<<OgParserT__24)|(1<<OgParserT__25)|(1<<OgParserT__26)|(1<<OgParserT__27)|(1<<OgParserT__28))) != 0 {
+		p.SetState(504)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__14)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__22)|(1<<OgParserT__23)|(1<<OgParserT__24)|(1<<OgParserT__25)|(1<<OgParserT__26)|(1<<OgParserT__27)|(1<<OgParserT__28))) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+
+	}
+	{
+		p.SetState(507)
+		p.Match(OgParserT__9)
+	}
+
+	return localctx
+}
+
+// IShortVarDeclContext is an interface to support dynamic dispatch.
+type IShortVarDeclContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsShortVarDeclContext differentiates from other interfaces.
+	IsShortVarDeclContext()
+}
+
+type ShortVarDeclContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyShortVarDeclContext() *ShortVarDeclContext {
+	var p = new(ShortVarDeclContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_shortVarDecl
+	return p
+}
+
+func (*ShortVarDeclContext) IsShortVarDeclContext() {}
+
+func NewShortVarDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShortVarDeclContext {
+	var p = new(ShortVarDeclContext)
 
-	return t.(IIfStmtContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_shortVarDecl
+
+	return p
 }
 
-func (s *StatementContext) SwitchStmt() ISwitchStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchStmtContext)(nil)).Elem(), 0)
+func (s *ShortVarDeclContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ShortVarDeclContext) IdentifierList() IIdentifierListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISwitchStmtContext)
+	return t.(IIdentifierListContext)
 }
 
-func (s *StatementContext) SelectStmt() ISelectStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectStmtContext)(nil)).Elem(), 0)
+func (s *ShortVarDeclContext) ExpressionList() IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISelectStmtContext)
+	return t.(IExpressionListContext)
 }
 
-func (s *StatementContext) DeferStmt() IDeferStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDeferStmtContext)(nil)).Elem(), 0)
+func (s *ShortVarDeclContext) IfStmt() IIfStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfStmtContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDeferStmtContext)
+	return t.(IIfStmtContext)
 }
 
-func (s *StatementContext) LabeledStmt() ILabeledStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILabeledStmtContext)(nil)).Elem(), 0)
+func (s *ShortVarDeclContext) SwitchStmt() ISwitchStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISwitchStmtContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ILabeledStmtContext)
+	return t.(ISwitchStmtContext)
 }
 
-func (s *StatementContext) Block() IBlockContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+func (s *ShortVarDeclContext) SelectStmt() ISelectStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISelectStmtContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IBlockContext)
+	return t.(ISelectStmtContext)
 }
 
-func (s *StatementContext) Declaration() IDeclarationContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDeclarationContext)(nil)).Elem(), 0)
+func (s *ShortVarDeclContext) ForStmt() IForStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForStmtContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDeclarationContext)
+	return t.(IForStmtContext)
 }
 
-func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+func (s *ShortVarDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ShortVarDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *StatementContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ShortVarDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterStatement(s)
+		listenerT.EnterShortVarDecl(s)
 	}
 }
 
-func (s *StatementContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ShortVarDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitStatement(s)
+		listenerT.ExitShortVarDecl(s)
 	}
 }
 
-func (s *StatementContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ShortVarDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case StatementVisitor:
-		return t.VisitStatement(s, delegate)
+	case ShortVarDeclVisitor:
+		return t.VisitShortVarDecl(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Statement() (localctx IStatementContext) {
-	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, OgParserRULE_statement)
+func (p *OgParser) ShortVarDecl() (localctx IShortVarDeclContext) {
+	localctx = NewShortVarDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, OgParserRULE_shortVarDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -4583,249 +6442,125 @@ func (p *OgParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(415)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(509)
+		p.IdentifierList()
+	}
+	{
+		p.SetState(510)
+		p.Match(OgParserT__29)
+	}
+	p.SetState(516)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(400)
-			p.ForStmt()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(401)
-			p.SimpleStmt()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(402)
-			p.GoStmt()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(403)
-			p.ReturnStmt()
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(404)
-			p.BreakStmt()
-		}
-
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(405)
-			p.ContinueStmt()
-		}
 
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(406)
-			p.GotoStmt()
-		}
-
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
+	switch p.GetTokenStream().LA(1) {
+	case OgParserT__1, OgParserT__3, OgParserT__14, OgParserT__16, OgParserT__19, OgParserT__20, OgParserT__22, OgParserT__27, OgParserT__44, OgParserT__46, OgParserT__47, OgParserT__48, OgParserT__49, OgParserT__53, OgParserT__54, OgParserT__55, OgParserT__56, OgParserT__57, OgParserSTRUCT, OgParserIDENTIFIER, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
 		{
-			p.SetState(407)
-			p.FallthroughStmt()
+			p.SetState(511)
+			p.ExpressionList()
 		}
 
-	case 9:
-		p.EnterOuterAlt(localctx, 9)
+	case OgParserIF:
 		{
-			p.SetState(408)
+			p.SetState(512)
 			p.IfStmt()
 		}
 
-	case 10:
-		p.EnterOuterAlt(localctx, 10)
+	case OgParserT__38, OgParserT__39:
 		{
-			p.SetState(409)
+			p.SetState(513)
 			p.SwitchStmt()
 		}
 
-	case 11:
-		p.EnterOuterAlt(localctx, 11)
+	case OgParserT__41:
 		{
-			p.SetState(410)
+			p.SetState(514)
 			p.SelectStmt()
 		}
 
-	case 12:
-		p.EnterOuterAlt(localctx, 12)
-		{
-			p.SetState(411)
-			p.DeferStmt()
-		}
-
-	case 13:
-		p.EnterOuterAlt(localctx, 13)
-		{
-			p.SetState(412)
-			p.LabeledStmt()
-		}
-
-	case 14:
-		p.EnterOuterAlt(localctx, 14)
-		{
-			p.SetState(413)
-			p.Block()
-		}
-
-	case 15:
-		p.EnterOuterAlt(localctx, 15)
+	case OgParserFOR:
 		{
-			p.SetState(414)
-			p.Declaration()
+			p.SetState(515)
+			p.ForStmt()
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ISimpleStmtContext is an interface to support dynamic dispatch.
-type ISimpleStmtContext interface {
+// IEmptyStmtContext is an interface to support dynamic dispatch.
+type IEmptyStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSimpleStmtContext differentiates from other interfaces.
-	IsSimpleStmtContext()
+	// IsEmptyStmtContext differentiates from other interfaces.
+	IsEmptyStmtContext()
 }
 
-type SimpleStmtContext struct {
+type EmptyStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySimpleStmtContext() *SimpleStmtContext {
-	var p = new(SimpleStmtContext)
+func NewEmptyEmptyStmtContext() *EmptyStmtContext {
+	var p = new(EmptyStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_simpleStmt
+	p.RuleIndex = OgParserRULE_emptyStmt
 	return p
 }
 
-func (*SimpleStmtContext) IsSimpleStmtContext() {}
+func (*EmptyStmtContext) IsEmptyStmtContext() {}
 
-func NewSimpleStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SimpleStmtContext {
-	var p = new(SimpleStmtContext)
+func NewEmptyStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EmptyStmtContext {
+	var p = new(EmptyStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_simpleStmt
+	p.RuleIndex = OgParserRULE_emptyStmt
 
 	return p
 }
 
-func (s *SimpleStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SimpleStmtContext) SendStmt() ISendStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISendStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISendStmtContext)
-}
-
-func (s *SimpleStmtContext) IncDecStmt() IIncDecStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIncDecStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIncDecStmtContext)
-}
-
-func (s *SimpleStmtContext) ShortVarDecl() IShortVarDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IShortVarDeclContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IShortVarDeclContext)
-}
-
-func (s *SimpleStmtContext) Assignment() IAssignmentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssignmentContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IAssignmentContext)
-}
-
-func (s *SimpleStmtContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
-}
-
-func (s *SimpleStmtContext) EmptyStmt() IEmptyStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEmptyStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IEmptyStmtContext)
-}
-
-func (s *SimpleStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *EmptyStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *EmptyStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SimpleStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *EmptyStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SimpleStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *EmptyStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterSimpleStmt(s)
+		listenerT.EnterEmptyStmt(s)
 	}
 }
 
-func (s *SimpleStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *EmptyStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitSimpleStmt(s)
+		listenerT.ExitEmptyStmt(s)
 	}
 }
 
-func (s *SimpleStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *EmptyStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case SimpleStmtVisitor:
-		return t.VisitSimpleStmt(s, delegate)
+	case EmptyStmtVisitor:
+		return t.VisitEmptyStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) SimpleStmt() (localctx ISimpleStmtContext) {
-	localctx = NewSimpleStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, OgParserRULE_simpleStmt)
+func (p *OgParser) EmptyStmt() (localctx IEmptyStmtContext) {
+	localctx = NewEmptyStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, OgParserRULE_emptyStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4843,149 +6578,99 @@ func (p *OgParser) SimpleStmt() (localctx ISimpleStmtContext) {
 		}
 	}()
 
-	p.SetState(423)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(417)
-			p.SendStmt()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(418)
-			p.IncDecStmt()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(419)
-			p.ShortVarDecl()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(420)
-			p.Assignment()
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(421)
-			p.expression(0)
-		}
-
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(422)
-			p.EmptyStmt()
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(518)
+		p.Match(OgParserSEMI)
 	}
 
 	return localctx
 }
 
-// ISendStmtContext is an interface to support dynamic dispatch.
-type ISendStmtContext interface {
+// ILabeledStmtContext is an interface to support dynamic dispatch.
+type ILabeledStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSendStmtContext differentiates from other interfaces.
-	IsSendStmtContext()
+	// IsLabeledStmtContext differentiates from other interfaces.
+	IsLabeledStmtContext()
 }
 
-type SendStmtContext struct {
+type LabeledStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySendStmtContext() *SendStmtContext {
-	var p = new(SendStmtContext)
+func NewEmptyLabeledStmtContext() *LabeledStmtContext {
+	var p = new(LabeledStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_sendStmt
+	p.RuleIndex = OgParserRULE_labeledStmt
 	return p
 }
 
-func (*SendStmtContext) IsSendStmtContext() {}
+func (*LabeledStmtContext) IsLabeledStmtContext() {}
 
-func NewSendStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SendStmtContext {
-	var p = new(SendStmtContext)
+func NewLabeledStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LabeledStmtContext {
+	var p = new(LabeledStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_sendStmt
+	p.RuleIndex = OgParserRULE_labeledStmt
 
 	return p
 }
 
-func (s *SendStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SendStmtContext) AllExpression() []IExpressionContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionContext)(nil)).Elem())
-	var tst = make([]IExpressionContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionContext)
-		}
-	}
+func (s *LabeledStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *LabeledStmtContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *SendStmtContext) Expression(i int) IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), i)
+func (s *LabeledStmtContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IStatementContext)
 }
 
-func (s *SendStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *LabeledStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SendStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LabeledStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SendStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LabeledStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterSendStmt(s)
+		listenerT.EnterLabeledStmt(s)
 	}
 }
 
-func (s *SendStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LabeledStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitSendStmt(s)
+		listenerT.ExitLabeledStmt(s)
 	}
 }
 
-func (s *SendStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *LabeledStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case SendStmtVisitor:
-		return t.VisitSendStmt(s, delegate)
+	case LabeledStmtVisitor:
+		return t.VisitLabeledStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) SendStmt() (localctx ISendStmtContext) {
-	localctx = NewSendStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, OgParserRULE_sendStmt)
+func (p *OgParser) LabeledStmt() (localctx ILabeledStmtContext) {
+	localctx = NewLabeledStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, OgParserRULE_labeledStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5005,102 +6690,105 @@ func (p *OgParser) SendStmt() (localctx ISendStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(425)
-		p.expression(0)
+		p.SetState(520)
+		p.Match(OgParserT__30)
 	}
 	{
-		p.SetState(426)
-		p.Match(OgParserT__17)
+		p.SetState(521)
+		p.Match(OgParserIDENTIFIER)
 	}
 	{
-		p.SetState(427)
-		p.expression(0)
+		p.SetState(522)
+		p.Match(OgParserCOLON)
+	}
+	{
+		p.SetState(523)
+		p.Statement()
 	}
 
 	return localctx
 }
 
-// IIncDecStmtContext is an interface to support dynamic dispatch.
-type IIncDecStmtContext interface {
+// IReturnStmtContext is an interface to support dynamic dispatch.
+type IReturnStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsIncDecStmtContext differentiates from other interfaces.
-	IsIncDecStmtContext()
+	// IsReturnStmtContext differentiates from other interfaces.
+	IsReturnStmtContext()
 }
 
-type IncDecStmtContext struct {
+type ReturnStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyIncDecStmtContext() *IncDecStmtContext {
-	var p = new(IncDecStmtContext)
+func NewEmptyReturnStmtContext() *ReturnStmtContext {
+	var p = new(ReturnStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_incDecStmt
+	p.RuleIndex = OgParserRULE_returnStmt
 	return p
 }
 
-func (*IncDecStmtContext) IsIncDecStmtContext() {}
+func (*ReturnStmtContext) IsReturnStmtContext() {}
 
-func NewIncDecStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IncDecStmtContext {
-	var p = new(IncDecStmtContext)
+func NewReturnStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnStmtContext {
+	var p = new(ReturnStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_incDecStmt
+	p.RuleIndex = OgParserRULE_returnStmt
 
 	return p
 }
 
-func (s *IncDecStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ReturnStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *IncDecStmtContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *ReturnStmtContext) ExpressionList() IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IExpressionListContext)
 }
 
-func (s *IncDecStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ReturnStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IncDecStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ReturnStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IncDecStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ReturnStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterIncDecStmt(s)
+		listenerT.EnterReturnStmt(s)
 	}
 }
 
-func (s *IncDecStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ReturnStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitIncDecStmt(s)
+		listenerT.ExitReturnStmt(s)
 	}
 }
 
-func (s *IncDecStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ReturnStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case IncDecStmtVisitor:
-		return t.VisitIncDecStmt(s, delegate)
+	case ReturnStmtVisitor:
+		return t.VisitReturnStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) IncDecStmt() (localctx IIncDecStmtContext) {
-	localctx = NewIncDecStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, OgParserRULE_incDecStmt)
-	var _la int
+func (p *OgParser) ReturnStmt() (localctx IReturnStmtContext) {
+	localctx = NewReturnStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, OgParserRULE_returnStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5120,125 +6808,97 @@ func (p *OgParser) IncDecStmt() (localctx IIncDecStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(429)
-		p.expression(0)
+		p.SetState(525)
+		p.Match(OgParserT__31)
 	}
-	p.SetState(430)
-	_la = p.GetTokenStream().LA(1)
+	p.SetState(527)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 35, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(526)
+			p.ExpressionList()
+		}
 
-	if !(_la == OgParserT__18 || _la == OgParserT__19) {
-		p.GetErrorHandler().RecoverInline(p)
-	} else {
-		p.GetErrorHandler().ReportMatch(p)
-		p.Consume()
 	}
 
 	return localctx
 }
 
-// IAssignmentContext is an interface to support dynamic dispatch.
-type IAssignmentContext interface {
+// IBreakStmtContext is an interface to support dynamic dispatch.
+type IBreakStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsAssignmentContext differentiates from other interfaces.
-	IsAssignmentContext()
+	// IsBreakStmtContext differentiates from other interfaces.
+	IsBreakStmtContext()
 }
 
-type AssignmentContext struct {
+type BreakStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAssignmentContext() *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewEmptyBreakStmtContext() *BreakStmtContext {
+	var p = new(BreakStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_assignment
+	p.RuleIndex = OgParserRULE_breakStmt
 	return p
 }
 
-func (*AssignmentContext) IsAssignmentContext() {}
+func (*BreakStmtContext) IsBreakStmtContext() {}
 
-func NewAssignmentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignmentContext {
-	var p = new(AssignmentContext)
+func NewBreakStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BreakStmtContext {
+	var p = new(BreakStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_assignment
+	p.RuleIndex = OgParserRULE_breakStmt
 
 	return p
 }
 
-func (s *AssignmentContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *AssignmentContext) AllExpressionList() []IExpressionListContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExpressionListContext)(nil)).Elem())
-	var tst = make([]IExpressionListContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IExpressionListContext)
-		}
-	}
-
-	return tst
-}
-
-func (s *AssignmentContext) ExpressionList(i int) IExpressionListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionListContext)
-}
-
-func (s *AssignmentContext) Assign_op() IAssign_opContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAssign_opContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *BreakStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IAssign_opContext)
+func (s *BreakStmtContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *AssignmentContext) GetRuleContext() antlr.RuleContext {
+func (s *BreakStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *AssignmentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BreakStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *AssignmentContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BreakStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterAssignment(s)
+		listenerT.EnterBreakStmt(s)
 	}
 }
 
-func (s *AssignmentContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BreakStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitAssignment(s)
+		listenerT.ExitBreakStmt(s)
 	}
 }
 
-func (s *AssignmentContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *BreakStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case AssignmentVisitor:
-		return t.VisitAssignment(s, delegate)
+	case BreakStmtVisitor:
+		return t.VisitBreakStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Assignment() (localctx IAssignmentContext) {
-	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, OgParserRULE_assignment)
+func (p *OgParser) BreakStmt() (localctx IBreakStmtContext) {
+	localctx = NewBreakStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, OgParserRULE_breakStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5258,91 +6918,97 @@ func (p *OgParser) Assignment() (localctx IAssignmentContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(432)
-		p.ExpressionList()
-	}
-	{
-		p.SetState(433)
-		p.Assign_op()
+		p.SetState(529)
+		p.Match(OgParserT__32)
 	}
-	{
-		p.SetState(434)
-		p.ExpressionList()
+	p.SetState(531)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(530)
+			p.Match(OgParserIDENTIFIER)
+		}
+
 	}
 
 	return localctx
 }
 
-// IAssign_opContext is an interface to support dynamic dispatch.
-type IAssign_opContext interface {
+// IContinueStmtContext is an interface to support dynamic dispatch.
+type IContinueStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsAssign_opContext differentiates from other interfaces.
-	IsAssign_opContext()
+	// IsContinueStmtContext differentiates from other interfaces.
+	IsContinueStmtContext()
 }
 
-type Assign_opContext struct {
+type ContinueStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyAssign_opContext() *Assign_opContext {
-	var p = new(Assign_opContext)
+func NewEmptyContinueStmtContext() *ContinueStmtContext {
+	var p = new(ContinueStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_assign_op
+	p.RuleIndex = OgParserRULE_continueStmt
 	return p
 }
 
-func (*Assign_opContext) IsAssign_opContext() {}
+func (*ContinueStmtContext) IsContinueStmtContext() {}
 
-func NewAssign_opContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Assign_opContext {
-	var p = new(Assign_opContext)
+func NewContinueStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ContinueStmtContext {
+	var p = new(ContinueStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_assign_op
+	p.RuleIndex = OgParserRULE_continueStmt
 
 	return p
 }
 
-func (s *Assign_opContext) GetParser() antlr.Parser { return s.parser }
-func (s *Assign_opContext) GetRuleContext() antlr.RuleContext {
+func (s *ContinueStmtContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ContinueStmtContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
+}
+
+func (s *ContinueStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Assign_opContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ContinueStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Assign_opContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ContinueStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterAssign_op(s)
+		listenerT.EnterContinueStmt(s)
 	}
 }
 
-func (s *Assign_opContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ContinueStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitAssign_op(s)
+		listenerT.ExitContinueStmt(s)
 	}
 }
 
-func (s *Assign_opContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ContinueStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case Assign_opVisitor:
-		return t.VisitAssign_op(s, delegate)
+	case ContinueStmtVisitor:
+		return t.VisitContinueStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Assign_op() (localctx IAssign_opContext) {
-	localctx = NewAssign_opContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, OgParserRULE_assign_op)
-	var _la int
+func (p *OgParser) ContinueStmt() (localctx IContinueStmtContext) {
+	localctx = NewContinueStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, OgParserRULE_continueStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5361,120 +7027,98 @@ func (p *OgParser) Assign_op() (localctx IAssign_opContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(437)
+	{
+		p.SetState(533)
+		p.Match(OgParserT__33)
+	}
+	p.SetState(535)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__15)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__22)|(1<<OgParserT__23)|(1<<OgParserT__24)|(1<<OgParserT__25)|(1<<OgParserT__26)|(1<<OgParserT__27)|(1<<OgParserT__28)|(1<<OgParserT__29))) != 0 {
-		p.SetState(436)
-		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__15)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__22)|(1<<OgParserT__23)|(1<<OgParserT__24)|(1<<OgParserT__25)|(1<<OgParserT__26)|(1<<OgParserT__27)|(1<<OgParserT__28)|(1<<OgParserT__29))) != 0) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(534)
+			p.Match(OgParserIDENTIFIER)
 		}
 
 	}
-	{
-		p.SetState(439)
-		p.Match(OgParserT__10)
-	}
 
 	return localctx
 }
 
-// IShortVarDeclContext is an interface to support dynamic dispatch.
-type IShortVarDeclContext interface {
+// IGotoStmtContext is an interface to support dynamic dispatch.
+type IGotoStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsShortVarDeclContext differentiates from other interfaces.
-	IsShortVarDeclContext()
+	// IsGotoStmtContext differentiates from other interfaces.
+	IsGotoStmtContext()
 }
 
-type ShortVarDeclContext struct {
+type GotoStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyShortVarDeclContext() *ShortVarDeclContext {
-	var p = new(ShortVarDeclContext)
+func NewEmptyGotoStmtContext() *GotoStmtContext {
+	var p = new(GotoStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_shortVarDecl
+	p.RuleIndex = OgParserRULE_gotoStmt
 	return p
 }
 
-func (*ShortVarDeclContext) IsShortVarDeclContext() {}
+func (*GotoStmtContext) IsGotoStmtContext() {}
 
-func NewShortVarDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ShortVarDeclContext {
-	var p = new(ShortVarDeclContext)
+func NewGotoStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GotoStmtContext {
+	var p = new(GotoStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_shortVarDecl
+	p.RuleIndex = OgParserRULE_gotoStmt
 
 	return p
 }
 
-func (s *ShortVarDeclContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ShortVarDeclContext) IdentifierList() IIdentifierListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIdentifierListContext)
-}
-
-func (s *ShortVarDeclContext) ExpressionList() IExpressionListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *GotoStmtContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IExpressionListContext)
+func (s *GotoStmtContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *ShortVarDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *GotoStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ShortVarDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GotoStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ShortVarDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GotoStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterShortVarDecl(s)
+		listenerT.EnterGotoStmt(s)
 	}
 }
 
-func (s *ShortVarDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GotoStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitShortVarDecl(s)
+		listenerT.ExitGotoStmt(s)
 	}
 }
 
-func (s *ShortVarDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *GotoStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ShortVarDeclVisitor:
-		return t.VisitShortVarDecl(s, delegate)
+	case GotoStmtVisitor:
+		return t.VisitGotoStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ShortVarDecl() (localctx IShortVarDeclContext) {
-	localctx = NewShortVarDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, OgParserRULE_shortVarDecl)
+func (p *OgParser) GotoStmt() (localctx IGotoStmtContext) {
+	localctx = NewGotoStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, OgParserRULE_gotoStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5494,90 +7138,86 @@ func (p *OgParser) ShortVarDecl() (localctx IShortVarDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(441)
-		p.IdentifierList()
-	}
-	{
-		p.SetState(442)
-		p.Match(OgParserT__30)
+		p.SetState(537)
+		p.Match(OgParserT__34)
 	}
 	{
-		p.SetState(443)
-		p.ExpressionList()
+		p.SetState(538)
+		p.Match(OgParserIDENTIFIER)
 	}
 
 	return localctx
 }
 
-// IEmptyStmtContext is an interface to support dynamic dispatch.
-type IEmptyStmtContext interface {
+// IFallthroughStmtContext is an interface to support dynamic dispatch.
+type IFallthroughStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsEmptyStmtContext differentiates from other interfaces.
-	IsEmptyStmtContext()
+	// IsFallthroughStmtContext differentiates from other interfaces.
+	IsFallthroughStmtContext()
 }
 
-type EmptyStmtContext struct {
+type FallthroughStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyEmptyStmtContext() *EmptyStmtContext {
-	var p = new(EmptyStmtContext)
+func NewEmptyFallthroughStmtContext() *FallthroughStmtContext {
+	var p = new(FallthroughStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_emptyStmt
+	p.RuleIndex = OgParserRULE_fallthroughStmt
 	return p
 }
 
-func (*EmptyStmtContext) IsEmptyStmtContext() {}
+func (*FallthroughStmtContext) IsFallthroughStmtContext() {}
 
-func NewEmptyStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *EmptyStmtContext {
-	var p = new(EmptyStmtContext)
+func NewFallthroughStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FallthroughStmtContext {
+	var p = new(FallthroughStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_emptyStmt
+	p.RuleIndex = OgParserRULE_fallthroughStmt
 
 	return p
 }
 
-func (s *EmptyStmtContext) GetParser() antlr.Parser { return s.parser }
-func (s *EmptyStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *FallthroughStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *FallthroughStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *EmptyStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FallthroughStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *EmptyStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FallthroughStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterEmptyStmt(s)
+		listenerT.EnterFallthroughStmt(s)
 	}
 }
 
-func (s *EmptyStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FallthroughStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitEmptyStmt(s)
+		listenerT.ExitFallthroughStmt(s)
 	}
 }
 
-func (s *EmptyStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *FallthroughStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case EmptyStmtVisitor:
-		return t.VisitEmptyStmt(s, delegate)
+	case FallthroughStmtVisitor:
+		return t.VisitFallthroughStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) EmptyStmt() (localctx IEmptyStmtContext) {
-	localctx = NewEmptyStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, OgParserRULE_emptyStmt)
+func (p *OgParser) FallthroughStmt() (localctx IFallthroughStmtContext) {
+	localctx = NewFallthroughStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, OgParserRULE_fallthroughStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5597,97 +7237,93 @@ func (p *OgParser) EmptyStmt() (localctx IEmptyStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(445)
-		p.Match(OgParserT__31)
+		p.SetState(540)
+		p.Match(OgParserT__35)
 	}
 
 	return localctx
 }
 
-// ILabeledStmtContext is an interface to support dynamic dispatch.
-type ILabeledStmtContext interface {
+// IDeferStmtContext is an interface to support dynamic dispatch.
+type IDeferStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsLabeledStmtContext differentiates from other interfaces.
-	IsLabeledStmtContext()
+	// IsDeferStmtContext differentiates from other interfaces.
+	IsDeferStmtContext()
 }
 
-type LabeledStmtContext struct {
+type DeferStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyLabeledStmtContext() *LabeledStmtContext {
-	var p = new(LabeledStmtContext)
+func NewEmptyDeferStmtContext() *DeferStmtContext {
+	var p = new(DeferStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_labeledStmt
+	p.RuleIndex = OgParserRULE_deferStmt
 	return p
 }
 
-func (*LabeledStmtContext) IsLabeledStmtContext() {}
+func (*DeferStmtContext) IsDeferStmtContext() {}
 
-func NewLabeledStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LabeledStmtContext {
-	var p = new(LabeledStmtContext)
+func NewDeferStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeferStmtContext {
+	var p = new(DeferStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_labeledStmt
+	p.RuleIndex = OgParserRULE_deferStmt
 
 	return p
 }
 
-func (s *LabeledStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *LabeledStmtContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
-}
+func (s *DeferStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *LabeledStmtContext) Statement() IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+func (s *DeferStmtContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementContext)
+	return t.(IExpressionContext)
 }
 
-func (s *LabeledStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *DeferStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LabeledStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *DeferStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LabeledStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *DeferStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterLabeledStmt(s)
+		listenerT.EnterDeferStmt(s)
 	}
 }
 
-func (s *LabeledStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *DeferStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitLabeledStmt(s)
+		listenerT.ExitDeferStmt(s)
 	}
 }
 
-func (s *LabeledStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *DeferStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case LabeledStmtVisitor:
-		return t.VisitLabeledStmt(s, delegate)
+	case DeferStmtVisitor:
+		return t.VisitDeferStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) LabeledStmt() (localctx ILabeledStmtContext) {
-	localctx = NewLabeledStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, OgParserRULE_labeledStmt)
+func (p *OgParser) DeferStmt() (localctx IDeferStmtContext) {
+	localctx = NewDeferStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, OgParserRULE_deferStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5707,105 +7343,186 @@ func (p *OgParser) LabeledStmt() (localctx ILabeledStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(447)
-		p.Match(OgParserT__32)
-	}
-	{
-		p.SetState(448)
-		p.Match(OgParserIDENTIFIER)
-	}
-	{
-		p.SetState(449)
-		p.Match(OgParserT__7)
+		p.SetState(542)
+		p.Match(OgParserT__36)
 	}
 	{
-		p.SetState(450)
-		p.Statement()
+		p.SetState(543)
+		p.expression(0)
 	}
 
 	return localctx
 }
 
-// IReturnStmtContext is an interface to support dynamic dispatch.
-type IReturnStmtContext interface {
+// IIfStmtContext is an interface to support dynamic dispatch.
+type IIfStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsReturnStmtContext differentiates from other interfaces.
-	IsReturnStmtContext()
+	// IsIfStmtContext differentiates from other interfaces.
+	IsIfStmtContext()
 }
 
-type ReturnStmtContext struct {
+type IfStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyReturnStmtContext() *ReturnStmtContext {
-	var p = new(ReturnStmtContext)
+func NewEmptyIfStmtContext() *IfStmtContext {
+	var p = new(IfStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_returnStmt
+	p.RuleIndex = OgParserRULE_ifStmt
 	return p
 }
 
-func (*ReturnStmtContext) IsReturnStmtContext() {}
+func (*IfStmtContext) IsIfStmtContext() {}
 
-func NewReturnStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ReturnStmtContext {
-	var p = new(ReturnStmtContext)
+func NewIfStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStmtContext {
+	var p = new(IfStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_returnStmt
+	p.RuleIndex = OgParserRULE_ifStmt
 
 	return p
 }
 
-func (s *ReturnStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *IfStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ReturnStmtContext) ExpressionList() IExpressionListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
+func (s *IfStmtContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionListContext)
+	return t.(IExpressionContext)
 }
 
-func (s *ReturnStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *IfStmtContext) AllBlock() []IBlockContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IBlockContext)(nil)).Elem())
+	var tst = make([]IBlockContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IBlockContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStmtContext) Block(i int) IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *IfStmtContext) SimpleStmt() ISimpleStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISimpleStmtContext)
+}
+
+func (s *IfStmtContext) AllStatement() []IStatementContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
+	var tst = make([]IStatementContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IStatementContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStmtContext) Statement(i int) IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *IfStmtContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStmtContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEosContext)
+}
+
+func (s *IfStmtContext) IfStmt() IIfStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIfStmtContext)
+}
+
+func (s *IfStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ReturnStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *IfStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ReturnStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *IfStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterReturnStmt(s)
+		listenerT.EnterIfStmt(s)
 	}
 }
 
-func (s *ReturnStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *IfStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitReturnStmt(s)
+		listenerT.ExitIfStmt(s)
 	}
 }
 
-func (s *ReturnStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *IfStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ReturnStmtVisitor:
-		return t.VisitReturnStmt(s, delegate)
+	case IfStmtVisitor:
+		return t.VisitIfStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ReturnStmt() (localctx IReturnStmtContext) {
-	localctx = NewReturnStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, OgParserRULE_returnStmt)
+func (p *OgParser) IfStmt() (localctx IIfStmtContext) {
+	localctx = NewIfStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, OgParserRULE_ifStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5825,16 +7542,107 @@ func (p *OgParser) ReturnStmt() (localctx IReturnStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(452)
-		p.Match(OgParserT__33)
+		p.SetState(545)
+		p.Match(OgParserIF)
 	}
-	p.SetState(454)
+	p.SetState(549)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(546)
+			p.SimpleStmt()
+		}
+		{
+			p.SetState(547)
+			p.Match(OgParserSEMI)
+		}
+
+	}
+	{
+		p.SetState(551)
+		p.expression(0)
+	}
+	p.SetState(558)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case OgParserARROW:
+		{
+			p.SetState(552)
+			p.Match(OgParserARROW)
+		}
+		{
+			p.SetState(553)
+			p.Statement()
+		}
+		{
+			p.SetState(554)
+			p.Eos()
+		}
+
+	case OgParserSEMI:
+		{
+			p.SetState(556)
+			p.Match(OgParserSEMI)
+		}
+		{
+			p.SetState(557)
+			p.Block()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	p.SetState(571)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 29, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(453)
-			p.ExpressionList()
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(560)
+			p.Match(OgParserT__37)
+		}
+		p.SetState(569)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case OgParserIF:
+			{
+				p.SetState(561)
+				p.IfStmt()
+			}
+
+		case OgParserT__5, OgParserARROW, OgParserINDENT:
+			p.SetState(567)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case OgParserARROW:
+				{
+					p.SetState(562)
+					p.Match(OgParserARROW)
+				}
+				{
+					p.SetState(563)
+					p.Statement()
+				}
+				{
+					p.SetState(564)
+					p.Eos()
+				}
+
+			case OgParserT__5, OgParserINDENT:
+				{
+					p.SetState(566)
+					p.Block()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
 	}
@@ -5842,80 +7650,106 @@ func (p *OgParser) ReturnStmt() (localctx IReturnStmtContext) {
 	return localctx
 }
 
-// IBreakStmtContext is an interface to support dynamic dispatch.
-type IBreakStmtContext interface {
+// ISwitchStmtContext is an interface to support dynamic dispatch.
+type ISwitchStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsBreakStmtContext differentiates from other interfaces.
-	IsBreakStmtContext()
+	// IsSwitchStmtContext differentiates from other interfaces.
+	IsSwitchStmtContext()
 }
 
-type BreakStmtContext struct {
+type SwitchStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBreakStmtContext() *BreakStmtContext {
-	var p = new(BreakStmtContext)
+func NewEmptySwitchStmtContext() *SwitchStmtContext {
+	var p = new(SwitchStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_breakStmt
+	p.RuleIndex = OgParserRULE_switchStmt
 	return p
 }
 
-func (*BreakStmtContext) IsBreakStmtContext() {}
+func (*SwitchStmtContext) IsSwitchStmtContext() {}
 
-func NewBreakStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BreakStmtContext {
-	var p = new(BreakStmtContext)
+func NewSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchStmtContext {
+	var p = new(SwitchStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_breakStmt
+	p.RuleIndex = OgParserRULE_switchStmt
 
 	return p
 }
 
-func (s *BreakStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *SwitchStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BreakStmtContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
+func (s *SwitchStmtContext) ExprSwitchStmt() IExprSwitchStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprSwitchStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprSwitchStmtContext)
 }
 
-func (s *BreakStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *SwitchStmtContext) TypeSwitchStmt() ITypeSwitchStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSwitchStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeSwitchStmtContext)
+}
+
+func (s *SwitchStmtContext) MatchStmt() IMatchStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchStmtContext)
+}
+
+func (s *SwitchStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BreakStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BreakStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterBreakStmt(s)
+		listenerT.EnterSwitchStmt(s)
 	}
 }
 
-func (s *BreakStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitBreakStmt(s)
+		listenerT.ExitSwitchStmt(s)
 	}
 }
 
-func (s *BreakStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *SwitchStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case BreakStmtVisitor:
-		return t.VisitBreakStmt(s, delegate)
+	case SwitchStmtVisitor:
+		return t.VisitSwitchStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) BreakStmt() (localctx IBreakStmtContext) {
-	localctx = NewBreakStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, OgParserRULE_breakStmt)
+func (p *OgParser) SwitchStmt() (localctx ISwitchStmtContext) {
+	localctx = NewSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, OgParserRULE_switchStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5933,18 +7767,28 @@ func (p *OgParser) BreakStmt() (localctx IBreakStmtContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(456)
-		p.Match(OgParserT__34)
-	}
-	p.SetState(458)
+	p.SetState(576)
 	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(573)
+			p.ExprSwitchStmt()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(574)
+			p.TypeSwitchStmt()
+		}
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext()) == 1 {
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(457)
-			p.Match(OgParserIDENTIFIER)
+			p.SetState(575)
+			p.MatchStmt()
 		}
 
 	}
@@ -5952,80 +7796,128 @@ func (p *OgParser) BreakStmt() (localctx IBreakStmtContext) {
 	return localctx
 }
 
-// IContinueStmtContext is an interface to support dynamic dispatch.
-type IContinueStmtContext interface {
+// IExprSwitchStmtContext is an interface to support dynamic dispatch.
+type IExprSwitchStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsContinueStmtContext differentiates from other interfaces.
-	IsContinueStmtContext()
+	// IsExprSwitchStmtContext differentiates from other interfaces.
+	IsExprSwitchStmtContext()
 }
 
-type ContinueStmtContext struct {
+type ExprSwitchStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyContinueStmtContext() *ContinueStmtContext {
-	var p = new(ContinueStmtContext)
+func NewEmptyExprSwitchStmtContext() *ExprSwitchStmtContext {
+	var p = new(ExprSwitchStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_continueStmt
+	p.RuleIndex = OgParserRULE_exprSwitchStmt
 	return p
 }
 
-func (*ContinueStmtContext) IsContinueStmtContext() {}
+func (*ExprSwitchStmtContext) IsExprSwitchStmtContext() {}
 
-func NewContinueStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ContinueStmtContext {
-	var p = new(ContinueStmtContext)
+func NewExprSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprSwitchStmtContext {
+	var p = new(ExprSwitchStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_continueStmt
+	p.RuleIndex = OgParserRULE_exprSwitchStmt
 
 	return p
 }
 
-func (s *ContinueStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExprSwitchStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ContinueStmtContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
+func (s *ExprSwitchStmtContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
 }
 
-func (s *ContinueStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ExprSwitchStmtContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *ExprSwitchStmtContext) SimpleStmt() ISimpleStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISimpleStmtContext)
+}
+
+func (s *ExprSwitchStmtContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionContext)
+}
+
+func (s *ExprSwitchStmtContext) AllExprCaseClause() []IExprCaseClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprCaseClauseContext)(nil)).Elem())
+	var tst = make([]IExprCaseClauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IExprCaseClauseContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExprSwitchStmtContext) ExprCaseClause(i int) IExprCaseClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprCaseClauseContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprCaseClauseContext)
+}
+
+func (s *ExprSwitchStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ContinueStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExprSwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ContinueStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExprSwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterContinueStmt(s)
+		listenerT.EnterExprSwitchStmt(s)
 	}
 }
 
-func (s *ContinueStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExprSwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitContinueStmt(s)
+		listenerT.ExitExprSwitchStmt(s)
 	}
 }
 
-func (s *ContinueStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ExprSwitchStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ContinueStmtVisitor:
-		return t.VisitContinueStmt(s, delegate)
+	case ExprSwitchStmtVisitor:
+		return t.VisitExprSwitchStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ContinueStmt() (localctx IContinueStmtContext) {
-	localctx = NewContinueStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, OgParserRULE_continueStmt)
+func (p *OgParser) ExprSwitchStmt() (localctx IExprSwitchStmtContext) {
+	localctx = NewExprSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, OgParserRULE_exprSwitchStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6045,97 +7937,160 @@ func (p *OgParser) ContinueStmt() (localctx IContinueStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(460)
-		p.Match(OgParserT__35)
+		p.SetState(578)
+		p.Match(OgParserT__38)
 	}
-	p.SetState(462)
+	p.SetState(582)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(461)
-			p.Match(OgParserIDENTIFIER)
+			p.SetState(579)
+			p.SimpleStmt()
+		}
+		{
+			p.SetState(580)
+			p.Match(OgParserSEMI)
+		}
+
+	}
+	p.SetState(585)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
+		{
+			p.SetState(584)
+			p.expression(0)
+		}
+
+	}
+	p.SetState(587)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+	p.SetState(591)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserBLANK-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
+		{
+			p.SetState(588)
+			p.ExprCaseClause()
 		}
 
+		p.SetState(593)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(594)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
 	return localctx
 }
 
-// IGotoStmtContext is an interface to support dynamic dispatch.
-type IGotoStmtContext interface {
+// IExprCaseClauseContext is an interface to support dynamic dispatch.
+type IExprCaseClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsGotoStmtContext differentiates from other interfaces.
-	IsGotoStmtContext()
+	// IsExprCaseClauseContext differentiates from other interfaces.
+	IsExprCaseClauseContext()
 }
 
-type GotoStmtContext struct {
+type ExprCaseClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGotoStmtContext() *GotoStmtContext {
-	var p = new(GotoStmtContext)
+func NewEmptyExprCaseClauseContext() *ExprCaseClauseContext {
+	var p = new(ExprCaseClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_gotoStmt
+	p.RuleIndex = OgParserRULE_exprCaseClause
 	return p
 }
 
-func (*GotoStmtContext) IsGotoStmtContext() {}
+func (*ExprCaseClauseContext) IsExprCaseClauseContext() {}
 
-func NewGotoStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GotoStmtContext {
-	var p = new(GotoStmtContext)
+func NewExprCaseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprCaseClauseContext {
+	var p = new(ExprCaseClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_gotoStmt
+	p.RuleIndex = OgParserRULE_exprCaseClause
 
 	return p
 }
 
-func (s *GotoStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *ExprCaseClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GotoStmtContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
+func (s *ExprCaseClauseContext) ExprSwitchCase() IExprSwitchCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprSwitchCaseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprSwitchCaseContext)
 }
 
-func (s *GotoStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ExprCaseClauseContext) StatementList() IStatementListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementListContext)
+}
+
+func (s *ExprCaseClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GotoStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExprCaseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GotoStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExprCaseClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterGotoStmt(s)
+		listenerT.EnterExprCaseClause(s)
 	}
 }
 
-func (s *GotoStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExprCaseClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitGotoStmt(s)
+		listenerT.ExitExprCaseClause(s)
 	}
 }
 
-func (s *GotoStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ExprCaseClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case GotoStmtVisitor:
-		return t.VisitGotoStmt(s, delegate)
+	case ExprCaseClauseVisitor:
+		return t.VisitExprCaseClause(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) GotoStmt() (localctx IGotoStmtContext) {
-	localctx = NewGotoStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, OgParserRULE_gotoStmt)
+func (p *OgParser) ExprCaseClause() (localctx IExprCaseClauseContext) {
+	localctx = NewExprCaseClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, OgParserRULE_exprCaseClause)
 
 	defer func() {
 		p.ExitRule()
@@ -6155,86 +8110,101 @@ func (p *OgParser) GotoStmt() (localctx IGotoStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(464)
-		p.Match(OgParserT__36)
+		p.SetState(596)
+		p.ExprSwitchCase()
 	}
 	{
-		p.SetState(465)
-		p.Match(OgParserIDENTIFIER)
+		p.SetState(597)
+		p.Match(OgParserARROW)
+	}
+	{
+		p.SetState(598)
+		p.StatementList()
 	}
 
 	return localctx
 }
 
-// IFallthroughStmtContext is an interface to support dynamic dispatch.
-type IFallthroughStmtContext interface {
+// IExprSwitchCaseContext is an interface to support dynamic dispatch.
+type IExprSwitchCaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFallthroughStmtContext differentiates from other interfaces.
-	IsFallthroughStmtContext()
+	// IsExprSwitchCaseContext differentiates from other interfaces.
+	IsExprSwitchCaseContext()
 }
 
-type FallthroughStmtContext struct {
+type ExprSwitchCaseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFallthroughStmtContext() *FallthroughStmtContext {
-	var p = new(FallthroughStmtContext)
+func NewEmptyExprSwitchCaseContext() *ExprSwitchCaseContext {
+	var p = new(ExprSwitchCaseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_fallthroughStmt
+	p.RuleIndex = OgParserRULE_exprSwitchCase
 	return p
 }
 
-func (*FallthroughStmtContext) IsFallthroughStmtContext() {}
+func (*ExprSwitchCaseContext) IsExprSwitchCaseContext() {}
 
-func NewFallthroughStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FallthroughStmtContext {
-	var p = new(FallthroughStmtContext)
+func NewExprSwitchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprSwitchCaseContext {
+	var p = new(ExprSwitchCaseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_fallthroughStmt
+	p.RuleIndex = OgParserRULE_exprSwitchCase
 
 	return p
 }
 
-func (s *FallthroughStmtContext) GetParser() antlr.Parser { return s.parser }
-func (s *FallthroughStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *ExprSwitchCaseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprSwitchCaseContext) ExpressionList() IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExpressionListContext)
+}
+
+func (s *ExprSwitchCaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FallthroughStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExprSwitchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FallthroughStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExprSwitchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterFallthroughStmt(s)
+		listenerT.EnterExprSwitchCase(s)
 	}
 }
 
-func (s *FallthroughStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExprSwitchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitFallthroughStmt(s)
+		listenerT.ExitExprSwitchCase(s)
 	}
 }
 
-func (s *FallthroughStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ExprSwitchCaseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case FallthroughStmtVisitor:
-		return t.VisitFallthroughStmt(s, delegate)
+	case ExprSwitchCaseVisitor:
+		return t.VisitExprSwitchCase(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) FallthroughStmt() (localctx IFallthroughStmtContext) {
-	localctx = NewFallthroughStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, OgParserRULE_fallthroughStmt)
+func (p *OgParser) ExprSwitchCase() (localctx IExprSwitchCaseContext) {
+	localctx = NewExprSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, OgParserRULE_exprSwitchCase)
 
 	defer func() {
 		p.ExitRule()
@@ -6252,54 +8222,70 @@ func (p *OgParser) FallthroughStmt() (localctx IFallthroughStmtContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(467)
-		p.Match(OgParserT__37)
+	p.SetState(602)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case OgParserT__1, OgParserT__3, OgParserT__14, OgParserT__16, OgParserT__19, OgParserT__20, OgParserT__22, OgParserT__27, OgParserT__44, OgParserT__46, OgParserT__47, OgParserT__48, OgParserT__49, OgParserT__53, OgParserT__54, OgParserT__55, OgParserT__56, OgParserT__57, OgParserSTRUCT, OgParserIDENTIFIER, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(600)
+			p.ExpressionList()
+		}
+
+	case OgParserBLANK:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(601)
+			p.Match(OgParserBLANK)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IDeferStmtContext is an interface to support dynamic dispatch.
-type IDeferStmtContext interface {
+// IMatchStmtContext is an interface to support dynamic dispatch.
+type IMatchStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDeferStmtContext differentiates from other interfaces.
-	IsDeferStmtContext()
+	// IsMatchStmtContext differentiates from other interfaces.
+	IsMatchStmtContext()
 }
 
-type DeferStmtContext struct {
+type MatchStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDeferStmtContext() *DeferStmtContext {
-	var p = new(DeferStmtContext)
+func NewEmptyMatchStmtContext() *MatchStmtContext {
+	var p = new(MatchStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_deferStmt
+	p.RuleIndex = OgParserRULE_matchStmt
 	return p
 }
 
-func (*DeferStmtContext) IsDeferStmtContext() {}
+func (*MatchStmtContext) IsMatchStmtContext() {}
 
-func NewDeferStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DeferStmtContext {
-	var p = new(DeferStmtContext)
+func NewMatchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchStmtContext {
+	var p = new(MatchStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_deferStmt
+	p.RuleIndex = OgParserRULE_matchStmt
 
 	return p
 }
 
-func (s *DeferStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *MatchStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *DeferStmtContext) Expression() IExpressionContext {
+func (s *MatchStmtContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -6309,38 +8295,70 @@ func (s *DeferStmtContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *DeferStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchStmtContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *MatchStmtContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *MatchStmtContext) AllMatchArm() []IMatchArmContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMatchArmContext)(nil)).Elem())
+	var tst = make([]IMatchArmContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMatchArmContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *MatchStmtContext) MatchArm(i int) IMatchArmContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMatchArmContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMatchArmContext)
+}
+
+func (s *MatchStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *DeferStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *DeferStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterDeferStmt(s)
+		listenerT.EnterMatchStmt(s)
 	}
 }
 
-func (s *DeferStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitDeferStmt(s)
+		listenerT.ExitMatchStmt(s)
 	}
 }
 
-func (s *DeferStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *MatchStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case DeferStmtVisitor:
-		return t.VisitDeferStmt(s, delegate)
+	case MatchStmtVisitor:
+		return t.VisitMatchStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) DeferStmt() (localctx IDeferStmtContext) {
-	localctx = NewDeferStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, OgParserRULE_deferStmt)
+func (p *OgParser) MatchStmt() (localctx IMatchStmtContext) {
+	localctx = NewMatchStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, OgParserRULE_matchStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6360,186 +8378,163 @@ func (p *OgParser) DeferStmt() (localctx IDeferStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(469)
-		p.Match(OgParserT__38)
+		p.SetState(604)
+		p.Match(OgParserT__39)
 	}
 	{
-		p.SetState(470)
+		p.SetState(605)
 		p.expression(0)
 	}
+	p.SetState(606)
+	_la = p.GetTokenStream().LA(1)
 
-	return localctx
-}
-
-// IIfStmtContext is an interface to support dynamic dispatch.
-type IIfStmtContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsIfStmtContext differentiates from other interfaces.
-	IsIfStmtContext()
-}
-
-type IfStmtContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyIfStmtContext() *IfStmtContext {
-	var p = new(IfStmtContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_ifStmt
-	return p
-}
-
-func (*IfStmtContext) IsIfStmtContext() {}
-
-func NewIfStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IfStmtContext {
-	var p = new(IfStmtContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = OgParserRULE_ifStmt
-
-	return p
-}
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
+	}
+	p.SetState(610)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-func (s *IfStmtContext) GetParser() antlr.Parser { return s.parser }
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserBLANK-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
+		{
+			p.SetState(607)
+			p.MatchArm()
+		}
 
-func (s *IfStmtContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+		p.SetState(612)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(613)
+	_la = p.GetTokenStream().LA(1)
 
-	if t == nil {
-		return nil
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
-	return t.(IExpressionContext)
+	return localctx
 }
 
-func (s *IfStmtContext) AllBlock() []IBlockContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IBlockContext)(nil)).Elem())
-	var tst = make([]IBlockContext, len(ts))
+// IMatchArmContext is an interface to support dynamic dispatch.
+type IMatchArmContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IBlockContext)
-		}
-	}
+	// IsMatchArmContext differentiates from other interfaces.
+	IsMatchArmContext()
+}
 
-	return tst
+type MatchArmContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *IfStmtContext) Block(i int) IBlockContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), i)
+func NewEmptyMatchArmContext() *MatchArmContext {
+	var p = new(MatchArmContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = OgParserRULE_matchArm
+	return p
+}
 
-	if t == nil {
-		return nil
-	}
+func (*MatchArmContext) IsMatchArmContext() {}
 
-	return t.(IBlockContext)
-}
+func NewMatchArmContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MatchArmContext {
+	var p = new(MatchArmContext)
 
-func (s *IfStmtContext) SimpleStmt() ISimpleStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
-	if t == nil {
-		return nil
-	}
+	p.parser = parser
+	p.RuleIndex = OgParserRULE_matchArm
 
-	return t.(ISimpleStmtContext)
+	return p
 }
 
-func (s *IfStmtContext) AllStatement() []IStatementContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IStatementContext)(nil)).Elem())
-	var tst = make([]IStatementContext, len(ts))
+func (s *MatchArmContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MatchArmContext) AllPattern() []IPatternContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IPatternContext)(nil)).Elem())
+	var tst = make([]IPatternContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IStatementContext)
+			tst[i] = t.(IPatternContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *IfStmtContext) Statement(i int) IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), i)
+func (s *MatchArmContext) Pattern(i int) IPatternContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPatternContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementContext)
-}
-
-func (s *IfStmtContext) AllEos() []IEosContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
-	var tst = make([]IEosContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IEosContext)
-		}
-	}
-
-	return tst
+	return t.(IPatternContext)
 }
 
-func (s *IfStmtContext) Eos(i int) IEosContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
+func (s *MatchArmContext) StatementList() IStatementListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IEosContext)
+	return t.(IStatementListContext)
 }
 
-func (s *IfStmtContext) IfStmt() IIfStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIfStmtContext)(nil)).Elem(), 0)
+func (s *MatchArmContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIfStmtContext)
+	return t.(IExpressionContext)
 }
 
-func (s *IfStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *MatchArmContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IfStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MatchArmContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IfStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MatchArmContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterIfStmt(s)
+		listenerT.EnterMatchArm(s)
 	}
 }
 
-func (s *IfStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MatchArmContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitIfStmt(s)
+		listenerT.ExitMatchArm(s)
 	}
 }
 
-func (s *IfStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *MatchArmContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case IfStmtVisitor:
-		return t.VisitIfStmt(s, delegate)
+	case MatchArmVisitor:
+		return t.VisitMatchArm(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) IfStmt() (localctx IIfStmtContext) {
-	localctx = NewIfStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, OgParserRULE_ifStmt)
+func (p *OgParser) MatchArm() (localctx IMatchArmContext) {
+	localctx = NewMatchArmContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 96, OgParserRULE_matchArm)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6559,204 +8554,139 @@ func (p *OgParser) IfStmt() (localctx IIfStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(472)
-		p.Match(OgParserT__39)
+		p.SetState(615)
+		p.Pattern()
 	}
-	p.SetState(476)
+	p.SetState(620)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
+	for _la == OgParserT__11 {
 		{
-			p.SetState(473)
-			p.SimpleStmt()
+			p.SetState(616)
+			p.Match(OgParserT__11)
 		}
 		{
-			p.SetState(474)
-			p.Match(OgParserT__31)
+			p.SetState(617)
+			p.Pattern()
 		}
 
+		p.SetState(622)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(478)
-		p.expression(0)
-	}
-	p.SetState(485)
+	p.SetState(625)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__40:
-		{
-			p.SetState(479)
-			p.Match(OgParserT__40)
-		}
-		{
-			p.SetState(480)
-			p.Statement()
-		}
-		{
-			p.SetState(481)
-			p.Eos()
-		}
-
-	case OgParserT__31:
+	if _la == OgParserIF {
 		{
-			p.SetState(483)
-			p.Match(OgParserT__31)
+			p.SetState(623)
+			p.Match(OgParserIF)
 		}
 		{
-			p.SetState(484)
-			p.Block()
+			p.SetState(624)
+			p.expression(0)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(498)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(487)
-			p.Match(OgParserT__41)
-		}
-		p.SetState(496)
-		p.GetErrorHandler().Sync(p)
-
-		switch p.GetTokenStream().LA(1) {
-		case OgParserT__39:
-			{
-				p.SetState(488)
-				p.IfStmt()
-			}
-
-		case OgParserT__5, OgParserT__40:
-			p.SetState(494)
-			p.GetErrorHandler().Sync(p)
-
-			switch p.GetTokenStream().LA(1) {
-			case OgParserT__40:
-				{
-					p.SetState(489)
-					p.Match(OgParserT__40)
-				}
-				{
-					p.SetState(490)
-					p.Statement()
-				}
-				{
-					p.SetState(491)
-					p.Eos()
-				}
-
-			case OgParserT__5:
-				{
-					p.SetState(493)
-					p.Block()
-				}
-
-			default:
-				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-			}
-
-		default:
-			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
-		}
-
+	{
+		p.SetState(627)
+		p.Match(OgParserARROW)
+	}
+	{
+		p.SetState(628)
+		p.StatementList()
 	}
 
 	return localctx
 }
 
-// ISwitchStmtContext is an interface to support dynamic dispatch.
-type ISwitchStmtContext interface {
+// IPatternContext is an interface to support dynamic dispatch.
+type IPatternContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSwitchStmtContext differentiates from other interfaces.
-	IsSwitchStmtContext()
+	// IsPatternContext differentiates from other interfaces.
+	IsPatternContext()
 }
 
-type SwitchStmtContext struct {
+type PatternContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySwitchStmtContext() *SwitchStmtContext {
-	var p = new(SwitchStmtContext)
+func NewEmptyPatternContext() *PatternContext {
+	var p = new(PatternContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_switchStmt
+	p.RuleIndex = OgParserRULE_pattern
 	return p
 }
 
-func (*SwitchStmtContext) IsSwitchStmtContext() {}
+func (*PatternContext) IsPatternContext() {}
 
-func NewSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SwitchStmtContext {
-	var p = new(SwitchStmtContext)
+func NewPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PatternContext {
+	var p = new(PatternContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_switchStmt
+	p.RuleIndex = OgParserRULE_pattern
 
 	return p
 }
 
-func (s *SwitchStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *PatternContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SwitchStmtContext) ExprSwitchStmt() IExprSwitchStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprSwitchStmtContext)(nil)).Elem(), 0)
+func (s *PatternContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprSwitchStmtContext)
+	return t.(IExpressionContext)
 }
 
-func (s *SwitchStmtContext) TypeSwitchStmt() ITypeSwitchStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSwitchStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITypeSwitchStmtContext)
+func (s *PatternContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *SwitchStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *PatternContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PatternContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterSwitchStmt(s)
+		listenerT.EnterPattern(s)
 	}
 }
 
-func (s *SwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PatternContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitSwitchStmt(s)
+		listenerT.ExitPattern(s)
 	}
 }
 
-func (s *SwitchStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *PatternContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case SwitchStmtVisitor:
-		return t.VisitSwitchStmt(s, delegate)
+	case PatternVisitor:
+		return t.VisitPattern(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) SwitchStmt() (localctx ISwitchStmtContext) {
-	localctx = NewSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, OgParserRULE_switchStmt)
+func (p *OgParser) Pattern() (localctx IPatternContext) {
+	localctx = NewPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, OgParserRULE_pattern)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -6774,21 +8704,38 @@ func (p *OgParser) SwitchStmt() (localctx ISwitchStmtContext) {
 		}
 	}()
 
-	p.SetState(502)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(632)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
+
+	switch p.GetTokenStream().LA(1) {
+	case OgParserBLANK:
 		{
-			p.SetState(500)
-			p.ExprSwitchStmt()
+			p.SetState(630)
+			p.Match(OgParserBLANK)
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+	case OgParserT__1, OgParserT__3, OgParserT__14, OgParserT__16, OgParserT__19, OgParserT__20, OgParserT__22, OgParserT__27, OgParserT__44, OgParserT__46, OgParserT__47, OgParserT__48, OgParserT__49, OgParserT__53, OgParserT__54, OgParserT__55, OgParserT__56, OgParserT__57, OgParserSTRUCT, OgParserIDENTIFIER, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
 		{
-			p.SetState(501)
-			p.TypeSwitchStmt()
+			p.SetState(631)
+			p.expression(0)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	p.SetState(636)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == OgParserT__40 {
+		{
+			p.SetState(634)
+			p.Match(OgParserT__40)
+		}
+		{
+			p.SetState(635)
+			p.Match(OgParserIDENTIFIER)
 		}
 
 	}
@@ -6796,119 +8743,127 @@ func (p *OgParser) SwitchStmt() (localctx ISwitchStmtContext) {
 	return localctx
 }
 
-// IExprSwitchStmtContext is an interface to support dynamic dispatch.
-type IExprSwitchStmtContext interface {
+// ITypeSwitchStmtContext is an interface to support dynamic dispatch.
+type ITypeSwitchStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExprSwitchStmtContext differentiates from other interfaces.
-	IsExprSwitchStmtContext()
+	// IsTypeSwitchStmtContext differentiates from other interfaces.
+	IsTypeSwitchStmtContext()
 }
 
-type ExprSwitchStmtContext struct {
+type TypeSwitchStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExprSwitchStmtContext() *ExprSwitchStmtContext {
-	var p = new(ExprSwitchStmtContext)
+func NewEmptyTypeSwitchStmtContext() *TypeSwitchStmtContext {
+	var p = new(TypeSwitchStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_exprSwitchStmt
+	p.RuleIndex = OgParserRULE_typeSwitchStmt
 	return p
 }
 
-func (*ExprSwitchStmtContext) IsExprSwitchStmtContext() {}
+func (*TypeSwitchStmtContext) IsTypeSwitchStmtContext() {}
 
-func NewExprSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprSwitchStmtContext {
-	var p = new(ExprSwitchStmtContext)
+func NewTypeSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchStmtContext {
+	var p = new(TypeSwitchStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_exprSwitchStmt
+	p.RuleIndex = OgParserRULE_typeSwitchStmt
 
 	return p
 }
 
-func (s *ExprSwitchStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeSwitchStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExprSwitchStmtContext) SimpleStmt() ISimpleStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
+func (s *TypeSwitchStmtContext) TypeSwitchGuard() ITypeSwitchGuardContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSwitchGuardContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISimpleStmtContext)
+	return t.(ITypeSwitchGuardContext)
 }
 
-func (s *ExprSwitchStmtContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *TypeSwitchStmtContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *TypeSwitchStmtContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *TypeSwitchStmtContext) SimpleStmt() ISimpleStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(ISimpleStmtContext)
 }
 
-func (s *ExprSwitchStmtContext) AllExprCaseClause() []IExprCaseClauseContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IExprCaseClauseContext)(nil)).Elem())
-	var tst = make([]IExprCaseClauseContext, len(ts))
+func (s *TypeSwitchStmtContext) AllTypeCaseClause() []ITypeCaseClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeCaseClauseContext)(nil)).Elem())
+	var tst = make([]ITypeCaseClauseContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IExprCaseClauseContext)
+			tst[i] = t.(ITypeCaseClauseContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *ExprSwitchStmtContext) ExprCaseClause(i int) IExprCaseClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprCaseClauseContext)(nil)).Elem(), i)
+func (s *TypeSwitchStmtContext) TypeCaseClause(i int) ITypeCaseClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeCaseClauseContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprCaseClauseContext)
+	return t.(ITypeCaseClauseContext)
 }
 
-func (s *ExprSwitchStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeSwitchStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprSwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeSwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExprSwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterExprSwitchStmt(s)
+		listenerT.EnterTypeSwitchStmt(s)
 	}
 }
 
-func (s *ExprSwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitExprSwitchStmt(s)
+		listenerT.ExitTypeSwitchStmt(s)
 	}
 }
 
-func (s *ExprSwitchStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeSwitchStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ExprSwitchStmtVisitor:
-		return t.VisitExprSwitchStmt(s, delegate)
+	case TypeSwitchStmtVisitor:
+		return t.VisitTypeSwitchStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ExprSwitchStmt() (localctx IExprSwitchStmtContext) {
-	localctx = NewExprSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, OgParserRULE_exprSwitchStmt)
+func (p *OgParser) TypeSwitchStmt() (localctx ITypeSwitchStmtContext) {
+	localctx = NewTypeSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, OgParserRULE_typeSwitchStmt)
 	var _la int
 
 	defer func() {
@@ -6929,150 +8884,147 @@ func (p *OgParser) ExprSwitchStmt() (localctx IExprSwitchStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(504)
-		p.Match(OgParserT__42)
+		p.SetState(638)
+		p.Match(OgParserT__38)
 	}
-	p.SetState(508)
+	p.SetState(642)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(505)
+			p.SetState(639)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(506)
-			p.Match(OgParserT__31)
+			p.SetState(640)
+			p.Match(OgParserSEMI)
 		}
 
 	}
-	p.SetState(511)
-	p.GetErrorHandler().Sync(p)
+	{
+		p.SetState(644)
+		p.TypeSwitchGuard()
+	}
+	p.SetState(645)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
-		{
-			p.SetState(510)
-			p.expression(0)
-		}
-
-	}
-	{
-		p.SetState(513)
-		p.Match(OgParserT__5)
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
-	p.SetState(517)
+	p.SetState(649)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__11)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserBLANK-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) {
 		{
-			p.SetState(514)
-			p.ExprCaseClause()
+			p.SetState(646)
+			p.TypeCaseClause()
 		}
 
-		p.SetState(519)
+		p.SetState(651)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	{
-		p.SetState(520)
-		p.Match(OgParserT__6)
+	p.SetState(652)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
 	return localctx
 }
 
-// IExprCaseClauseContext is an interface to support dynamic dispatch.
-type IExprCaseClauseContext interface {
+// ITypeSwitchGuardContext is an interface to support dynamic dispatch.
+type ITypeSwitchGuardContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExprCaseClauseContext differentiates from other interfaces.
-	IsExprCaseClauseContext()
+	// IsTypeSwitchGuardContext differentiates from other interfaces.
+	IsTypeSwitchGuardContext()
 }
 
-type ExprCaseClauseContext struct {
+type TypeSwitchGuardContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExprCaseClauseContext() *ExprCaseClauseContext {
-	var p = new(ExprCaseClauseContext)
+func NewEmptyTypeSwitchGuardContext() *TypeSwitchGuardContext {
+	var p = new(TypeSwitchGuardContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_exprCaseClause
+	p.RuleIndex = OgParserRULE_typeSwitchGuard
 	return p
 }
 
-func (*ExprCaseClauseContext) IsExprCaseClauseContext() {}
+func (*TypeSwitchGuardContext) IsTypeSwitchGuardContext() {}
 
-func NewExprCaseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprCaseClauseContext {
-	var p = new(ExprCaseClauseContext)
+func NewTypeSwitchGuardContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchGuardContext {
+	var p = new(TypeSwitchGuardContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_exprCaseClause
+	p.RuleIndex = OgParserRULE_typeSwitchGuard
 
 	return p
 }
 
-func (s *ExprCaseClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeSwitchGuardContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExprCaseClauseContext) ExprSwitchCase() IExprSwitchCaseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExprSwitchCaseContext)(nil)).Elem(), 0)
+func (s *TypeSwitchGuardContext) PrimaryExpr() IPrimaryExprContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrimaryExprContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExprSwitchCaseContext)
+	return t.(IPrimaryExprContext)
 }
 
-func (s *ExprCaseClauseContext) StatementList() IStatementListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStatementListContext)
+func (s *TypeSwitchGuardContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *ExprCaseClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeSwitchGuardContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprCaseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeSwitchGuardContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExprCaseClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchGuardContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterExprCaseClause(s)
+		listenerT.EnterTypeSwitchGuard(s)
 	}
 }
 
-func (s *ExprCaseClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchGuardContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitExprCaseClause(s)
+		listenerT.ExitTypeSwitchGuard(s)
 	}
 }
 
-func (s *ExprCaseClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeSwitchGuardContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ExprCaseClauseVisitor:
-		return t.VisitExprCaseClause(s, delegate)
+	case TypeSwitchGuardVisitor:
+		return t.VisitTypeSwitchGuard(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ExprCaseClause() (localctx IExprCaseClauseContext) {
-	localctx = NewExprCaseClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, OgParserRULE_exprCaseClause)
+func (p *OgParser) TypeSwitchGuard() (localctx ITypeSwitchGuardContext) {
+	localctx = NewTypeSwitchGuardContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, OgParserRULE_typeSwitchGuard)
 
 	defer func() {
 		p.ExitRule()
@@ -7091,102 +9043,134 @@ func (p *OgParser) ExprCaseClause() (localctx IExprCaseClauseContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
+	p.SetState(656)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(654)
+			p.Match(OgParserIDENTIFIER)
+		}
+		{
+			p.SetState(655)
+			p.Match(OgParserT__29)
+		}
+
+	}
 	{
-		p.SetState(522)
-		p.ExprSwitchCase()
+		p.SetState(658)
+		p.primaryExpr(0)
 	}
 	{
-		p.SetState(523)
-		p.Match(OgParserT__40)
+		p.SetState(659)
+		p.Match(OgParserT__7)
 	}
 	{
-		p.SetState(524)
-		p.StatementList()
+		p.SetState(660)
+		p.Match(OgParserT__3)
+	}
+	{
+		p.SetState(661)
+		p.Match(OgParserT__12)
+	}
+	{
+		p.SetState(662)
+		p.Match(OgParserT__4)
 	}
 
 	return localctx
 }
 
-// IExprSwitchCaseContext is an interface to support dynamic dispatch.
-type IExprSwitchCaseContext interface {
+// ITypeCaseClauseContext is an interface to support dynamic dispatch.
+type ITypeCaseClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExprSwitchCaseContext differentiates from other interfaces.
-	IsExprSwitchCaseContext()
+	// IsTypeCaseClauseContext differentiates from other interfaces.
+	IsTypeCaseClauseContext()
 }
 
-type ExprSwitchCaseContext struct {
+type TypeCaseClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExprSwitchCaseContext() *ExprSwitchCaseContext {
-	var p = new(ExprSwitchCaseContext)
+func NewEmptyTypeCaseClauseContext() *TypeCaseClauseContext {
+	var p = new(TypeCaseClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_exprSwitchCase
+	p.RuleIndex = OgParserRULE_typeCaseClause
 	return p
 }
 
-func (*ExprSwitchCaseContext) IsExprSwitchCaseContext() {}
+func (*TypeCaseClauseContext) IsTypeCaseClauseContext() {}
 
-func NewExprSwitchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprSwitchCaseContext {
-	var p = new(ExprSwitchCaseContext)
+func NewTypeCaseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeCaseClauseContext {
+	var p = new(TypeCaseClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_exprSwitchCase
+	p.RuleIndex = OgParserRULE_typeCaseClause
 
 	return p
 }
 
-func (s *ExprSwitchCaseContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeCaseClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExprSwitchCaseContext) ExpressionList() IExpressionListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
+func (s *TypeCaseClauseContext) TypeSwitchCase() ITypeSwitchCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSwitchCaseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionListContext)
+	return t.(ITypeSwitchCaseContext)
 }
 
-func (s *ExprSwitchCaseContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeCaseClauseContext) StatementList() IStatementListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementListContext)
+}
+
+func (s *TypeCaseClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExprSwitchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeCaseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExprSwitchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeCaseClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterExprSwitchCase(s)
+		listenerT.EnterTypeCaseClause(s)
 	}
 }
 
-func (s *ExprSwitchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeCaseClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitExprSwitchCase(s)
+		listenerT.ExitTypeCaseClause(s)
 	}
 }
 
-func (s *ExprSwitchCaseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeCaseClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ExprSwitchCaseVisitor:
-		return t.VisitExprSwitchCase(s, delegate)
+	case TypeCaseClauseVisitor:
+		return t.VisitTypeCaseClause(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ExprSwitchCase() (localctx IExprSwitchCaseContext) {
-	localctx = NewExprSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, OgParserRULE_exprSwitchCase)
+func (p *OgParser) TypeCaseClause() (localctx ITypeCaseClauseContext) {
+	localctx = NewTypeCaseClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, OgParserRULE_typeCaseClause)
 
 	defer func() {
 		p.ExitRule()
@@ -7204,145 +9188,103 @@ func (p *OgParser) ExprSwitchCase() (localctx IExprSwitchCaseContext) {
 		}
 	}()
 
-	p.SetState(528)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__1, OgParserT__3, OgParserT__15, OgParserT__17, OgParserT__20, OgParserT__21, OgParserT__23, OgParserT__28, OgParserT__47, OgParserT__49, OgParserT__50, OgParserT__51, OgParserT__52, OgParserT__56, OgParserT__57, OgParserT__58, OgParserT__59, OgParserT__60, OgParserT__61, OgParserIDENTIFIER, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(526)
-			p.ExpressionList()
-		}
-
-	case OgParserT__11:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(527)
-			p.Match(OgParserT__11)
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(664)
+		p.TypeSwitchCase()
+	}
+	{
+		p.SetState(665)
+		p.Match(OgParserARROW)
+	}
+	{
+		p.SetState(666)
+		p.StatementList()
 	}
 
 	return localctx
 }
 
-// ITypeSwitchStmtContext is an interface to support dynamic dispatch.
-type ITypeSwitchStmtContext interface {
+// ITypeSwitchCaseContext is an interface to support dynamic dispatch.
+type ITypeSwitchCaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeSwitchStmtContext differentiates from other interfaces.
-	IsTypeSwitchStmtContext()
+	// IsTypeSwitchCaseContext differentiates from other interfaces.
+	IsTypeSwitchCaseContext()
 }
 
-type TypeSwitchStmtContext struct {
+type TypeSwitchCaseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeSwitchStmtContext() *TypeSwitchStmtContext {
-	var p = new(TypeSwitchStmtContext)
+func NewEmptyTypeSwitchCaseContext() *TypeSwitchCaseContext {
+	var p = new(TypeSwitchCaseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeSwitchStmt
+	p.RuleIndex = OgParserRULE_typeSwitchCase
 	return p
 }
 
-func (*TypeSwitchStmtContext) IsTypeSwitchStmtContext() {}
+func (*TypeSwitchCaseContext) IsTypeSwitchCaseContext() {}
 
-func NewTypeSwitchStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchStmtContext {
-	var p = new(TypeSwitchStmtContext)
+func NewTypeSwitchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchCaseContext {
+	var p = new(TypeSwitchCaseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeSwitchStmt
+	p.RuleIndex = OgParserRULE_typeSwitchCase
 
 	return p
 }
 
-func (s *TypeSwitchStmtContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeSwitchStmtContext) TypeSwitchGuard() ITypeSwitchGuardContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSwitchGuardContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITypeSwitchGuardContext)
-}
-
-func (s *TypeSwitchStmtContext) SimpleStmt() ISimpleStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISimpleStmtContext)
-}
-
-func (s *TypeSwitchStmtContext) AllTypeCaseClause() []ITypeCaseClauseContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITypeCaseClauseContext)(nil)).Elem())
-	var tst = make([]ITypeCaseClauseContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ITypeCaseClauseContext)
-		}
-	}
-
-	return tst
-}
+func (s *TypeSwitchCaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeSwitchStmtContext) TypeCaseClause(i int) ITypeCaseClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeCaseClauseContext)(nil)).Elem(), i)
+func (s *TypeSwitchCaseContext) TypeList() ITypeListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeCaseClauseContext)
+	return t.(ITypeListContext)
 }
 
-func (s *TypeSwitchStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeSwitchCaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeSwitchStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeSwitchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeSwitchStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeSwitchStmt(s)
+		listenerT.EnterTypeSwitchCase(s)
 	}
 }
 
-func (s *TypeSwitchStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeSwitchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeSwitchStmt(s)
+		listenerT.ExitTypeSwitchCase(s)
 	}
 }
 
-func (s *TypeSwitchStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeSwitchCaseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeSwitchStmtVisitor:
-		return t.VisitTypeSwitchStmt(s, delegate)
+	case TypeSwitchCaseVisitor:
+		return t.VisitTypeSwitchCase(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeSwitchStmt() (localctx ITypeSwitchStmtContext) {
-	localctx = NewTypeSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, OgParserRULE_typeSwitchStmt)
-	var _la int
+func (p *OgParser) TypeSwitchCase() (localctx ITypeSwitchCaseContext) {
+	localctx = NewTypeSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, OgParserRULE_typeSwitchCase)
 
 	defer func() {
 		p.ExitRule()
@@ -7360,139 +9302,125 @@ func (p *OgParser) TypeSwitchStmt() (localctx ITypeSwitchStmtContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(530)
-		p.Match(OgParserT__42)
-	}
-	p.SetState(534)
+	p.SetState(670)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(531)
-			p.SimpleStmt()
-		}
+	switch p.GetTokenStream().LA(1) {
+	case OgParserT__3, OgParserT__14, OgParserT__16, OgParserT__44, OgParserT__46, OgParserT__47, OgParserT__48, OgParserT__49, OgParserT__56, OgParserT__57, OgParserSTRUCT, OgParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(532)
-			p.Match(OgParserT__31)
+			p.SetState(668)
+			p.TypeList()
 		}
 
-	}
-	{
-		p.SetState(536)
-		p.TypeSwitchGuard()
-	}
-	{
-		p.SetState(537)
-		p.Match(OgParserT__5)
-	}
-	p.SetState(541)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__3)|(1<<OgParserT__11)|(1<<OgParserT__15)|(1<<OgParserT__17))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48)))) != 0) {
+	case OgParserBLANK:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(538)
-			p.TypeCaseClause()
+			p.SetState(669)
+			p.Match(OgParserBLANK)
 		}
 
-		p.SetState(543)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-	}
-	{
-		p.SetState(544)
-		p.Match(OgParserT__6)
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ITypeSwitchGuardContext is an interface to support dynamic dispatch.
-type ITypeSwitchGuardContext interface {
+// ITypeListContext is an interface to support dynamic dispatch.
+type ITypeListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeSwitchGuardContext differentiates from other interfaces.
-	IsTypeSwitchGuardContext()
+	// IsTypeListContext differentiates from other interfaces.
+	IsTypeListContext()
 }
 
-type TypeSwitchGuardContext struct {
+type TypeListContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeSwitchGuardContext() *TypeSwitchGuardContext {
-	var p = new(TypeSwitchGuardContext)
+func NewEmptyTypeListContext() *TypeListContext {
+	var p = new(TypeListContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeSwitchGuard
+	p.RuleIndex = OgParserRULE_typeList
 	return p
 }
 
-func (*TypeSwitchGuardContext) IsTypeSwitchGuardContext() {}
+func (*TypeListContext) IsTypeListContext() {}
 
-func NewTypeSwitchGuardContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchGuardContext {
-	var p = new(TypeSwitchGuardContext)
+func NewTypeListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeListContext {
+	var p = new(TypeListContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeSwitchGuard
+	p.RuleIndex = OgParserRULE_typeList
 
 	return p
 }
 
-func (s *TypeSwitchGuardContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeListContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeSwitchGuardContext) PrimaryExpr() IPrimaryExprContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPrimaryExprContext)(nil)).Elem(), 0)
+func (s *TypeListContext) AllType_() []IType_Context {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IType_Context)(nil)).Elem())
+	var tst = make([]IType_Context, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IType_Context)
+		}
 	}
 
-	return t.(IPrimaryExprContext)
+	return tst
 }
 
-func (s *TypeSwitchGuardContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
+func (s *TypeListContext) Type_(i int) IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IType_Context)
 }
 
-func (s *TypeSwitchGuardContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeSwitchGuardContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeSwitchGuardContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeSwitchGuard(s)
+		listenerT.EnterTypeList(s)
 	}
 }
 
-func (s *TypeSwitchGuardContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeSwitchGuard(s)
+		listenerT.ExitTypeList(s)
 	}
 }
 
-func (s *TypeSwitchGuardContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeSwitchGuardVisitor:
-		return t.VisitTypeSwitchGuard(s, delegate)
+	case TypeListVisitor:
+		return t.VisitTypeList(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeSwitchGuard() (localctx ITypeSwitchGuardContext) {
-	localctx = NewTypeSwitchGuardContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, OgParserRULE_typeSwitchGuard)
+func (p *OgParser) TypeList() (localctx ITypeListContext) {
+	localctx = NewTypeListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, OgParserRULE_typeList)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -7511,134 +9439,134 @@ func (p *OgParser) TypeSwitchGuard() (localctx ITypeSwitchGuardContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(548)
+	{
+		p.SetState(672)
+		p.Type_()
+	}
+	p.SetState(677)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 44, p.GetParserRuleContext()) == 1 {
+	for _la == OgParserT__11 {
 		{
-			p.SetState(546)
-			p.Match(OgParserIDENTIFIER)
+			p.SetState(673)
+			p.Match(OgParserT__11)
 		}
 		{
-			p.SetState(547)
-			p.Match(OgParserT__30)
+			p.SetState(674)
+			p.Type_()
 		}
 
-	}
-	{
-		p.SetState(550)
-		p.primaryExpr(0)
-	}
-	{
-		p.SetState(551)
-		p.Match(OgParserT__8)
-	}
-	{
-		p.SetState(552)
-		p.Match(OgParserT__3)
-	}
-	{
-		p.SetState(553)
-		p.Match(OgParserT__13)
-	}
-	{
-		p.SetState(554)
-		p.Match(OgParserT__4)
+		p.SetState(679)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// ITypeCaseClauseContext is an interface to support dynamic dispatch.
-type ITypeCaseClauseContext interface {
+// ISelectStmtContext is an interface to support dynamic dispatch.
+type ISelectStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeCaseClauseContext differentiates from other interfaces.
-	IsTypeCaseClauseContext()
+	// IsSelectStmtContext differentiates from other interfaces.
+	IsSelectStmtContext()
 }
 
-type TypeCaseClauseContext struct {
+type SelectStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeCaseClauseContext() *TypeCaseClauseContext {
-	var p = new(TypeCaseClauseContext)
+func NewEmptySelectStmtContext() *SelectStmtContext {
+	var p = new(SelectStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeCaseClause
+	p.RuleIndex = OgParserRULE_selectStmt
 	return p
 }
 
-func (*TypeCaseClauseContext) IsTypeCaseClauseContext() {}
+func (*SelectStmtContext) IsSelectStmtContext() {}
 
-func NewTypeCaseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeCaseClauseContext {
-	var p = new(TypeCaseClauseContext)
+func NewSelectStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectStmtContext {
+	var p = new(SelectStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeCaseClause
+	p.RuleIndex = OgParserRULE_selectStmt
 
 	return p
 }
 
-func (s *TypeCaseClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *SelectStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeCaseClauseContext) TypeSwitchCase() ITypeSwitchCaseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeSwitchCaseContext)(nil)).Elem(), 0)
+func (s *SelectStmtContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
 
-	if t == nil {
-		return nil
+func (s *SelectStmtContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *SelectStmtContext) AllCommClause() []ICommClauseContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICommClauseContext)(nil)).Elem())
+	var tst = make([]ICommClauseContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ICommClauseContext)
+		}
 	}
 
-	return t.(ITypeSwitchCaseContext)
+	return tst
 }
 
-func (s *TypeCaseClauseContext) StatementList() IStatementListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementListContext)(nil)).Elem(), 0)
+func (s *SelectStmtContext) CommClause(i int) ICommClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICommClauseContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementListContext)
+	return t.(ICommClauseContext)
 }
 
-func (s *TypeCaseClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *SelectStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeCaseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SelectStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeCaseClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SelectStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeCaseClause(s)
+		listenerT.EnterSelectStmt(s)
 	}
 }
 
-func (s *TypeCaseClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SelectStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeCaseClause(s)
+		listenerT.ExitSelectStmt(s)
 	}
 }
 
-func (s *TypeCaseClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *SelectStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeCaseClauseVisitor:
-		return t.VisitTypeCaseClause(s, delegate)
+	case SelectStmtVisitor:
+		return t.VisitSelectStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeCaseClause() (localctx ITypeCaseClauseContext) {
-	localctx = NewTypeCaseClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, OgParserRULE_typeCaseClause)
+func (p *OgParser) SelectStmt() (localctx ISelectStmtContext) {
+	localctx = NewSelectStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, OgParserRULE_selectStmt)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -7658,101 +9586,145 @@ func (p *OgParser) TypeCaseClause() (localctx ITypeCaseClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(556)
-		p.TypeSwitchCase()
+		p.SetState(680)
+		p.Match(OgParserT__41)
 	}
-	{
-		p.SetState(557)
-		p.Match(OgParserT__40)
+	p.SetState(681)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
-	{
-		p.SetState(558)
-		p.StatementList()
+	p.SetState(685)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserBLANK-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
+		{
+			p.SetState(682)
+			p.CommClause()
+		}
+
+		p.SetState(687)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	p.SetState(688)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
 	return localctx
 }
 
-// ITypeSwitchCaseContext is an interface to support dynamic dispatch.
-type ITypeSwitchCaseContext interface {
+// ICommClauseContext is an interface to support dynamic dispatch.
+type ICommClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeSwitchCaseContext differentiates from other interfaces.
-	IsTypeSwitchCaseContext()
+	// IsCommClauseContext differentiates from other interfaces.
+	IsCommClauseContext()
 }
 
-type TypeSwitchCaseContext struct {
+type CommClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeSwitchCaseContext() *TypeSwitchCaseContext {
-	var p = new(TypeSwitchCaseContext)
+func NewEmptyCommClauseContext() *CommClauseContext {
+	var p = new(CommClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeSwitchCase
+	p.RuleIndex = OgParserRULE_commClause
 	return p
 }
 
-func (*TypeSwitchCaseContext) IsTypeSwitchCaseContext() {}
+func (*CommClauseContext) IsCommClauseContext() {}
 
-func NewTypeSwitchCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSwitchCaseContext {
-	var p = new(TypeSwitchCaseContext)
+func NewCommClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CommClauseContext {
+	var p = new(CommClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeSwitchCase
+	p.RuleIndex = OgParserRULE_commClause
 
 	return p
 }
 
-func (s *TypeSwitchCaseContext) GetParser() antlr.Parser { return s.parser }
+func (s *CommClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeSwitchCaseContext) TypeList() ITypeListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeListContext)(nil)).Elem(), 0)
+func (s *CommClauseContext) CommCase() ICommCaseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICommCaseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeListContext)
+	return t.(ICommCaseContext)
 }
 
-func (s *TypeSwitchCaseContext) GetRuleContext() antlr.RuleContext {
+func (s *CommClauseContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *CommClauseContext) Statement() IStatementContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *CommClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeSwitchCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CommClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeSwitchCaseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CommClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeSwitchCase(s)
+		listenerT.EnterCommClause(s)
 	}
 }
 
-func (s *TypeSwitchCaseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CommClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeSwitchCase(s)
+		listenerT.ExitCommClause(s)
 	}
 }
 
-func (s *TypeSwitchCaseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *CommClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeSwitchCaseVisitor:
-		return t.VisitTypeSwitchCase(s, delegate)
+	case CommClauseVisitor:
+		return t.VisitCommClause(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeSwitchCase() (localctx ITypeSwitchCaseContext) {
-	localctx = NewTypeSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, OgParserRULE_typeSwitchCase)
+func (p *OgParser) CommClause() (localctx ICommClauseContext) {
+	localctx = NewCommClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, OgParserRULE_commClause)
 
 	defer func() {
 		p.ExitRule()
@@ -7770,125 +9742,125 @@ func (p *OgParser) TypeSwitchCase() (localctx ITypeSwitchCaseContext) {
 		}
 	}()
 
-	p.SetState(562)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(690)
+		p.CommCase()
+	}
+	{
+		p.SetState(691)
+		p.Match(OgParserARROW)
+	}
+	p.SetState(694)
 	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__3, OgParserT__15, OgParserT__17, OgParserT__47, OgParserT__49, OgParserT__50, OgParserT__51, OgParserT__52, OgParserT__59, OgParserT__60, OgParserT__61, OgParserIDENTIFIER:
-		p.EnterOuterAlt(localctx, 1)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(560)
-			p.TypeList()
+			p.SetState(692)
+			p.Block()
 		}
 
-	case OgParserT__11:
-		p.EnterOuterAlt(localctx, 2)
+	case 2:
 		{
-			p.SetState(561)
-			p.Match(OgParserT__11)
+			p.SetState(693)
+			p.Statement()
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ITypeListContext is an interface to support dynamic dispatch.
-type ITypeListContext interface {
+// ICommCaseContext is an interface to support dynamic dispatch.
+type ICommCaseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeListContext differentiates from other interfaces.
-	IsTypeListContext()
+	// IsCommCaseContext differentiates from other interfaces.
+	IsCommCaseContext()
 }
 
-type TypeListContext struct {
+type CommCaseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeListContext() *TypeListContext {
-	var p = new(TypeListContext)
+func NewEmptyCommCaseContext() *CommCaseContext {
+	var p = new(CommCaseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeList
+	p.RuleIndex = OgParserRULE_commCase
 	return p
 }
 
-func (*TypeListContext) IsTypeListContext() {}
+func (*CommCaseContext) IsCommCaseContext() {}
 
-func NewTypeListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeListContext {
-	var p = new(TypeListContext)
+func NewCommCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CommCaseContext {
+	var p = new(CommCaseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeList
+	p.RuleIndex = OgParserRULE_commCase
 
 	return p
 }
 
-func (s *TypeListContext) GetParser() antlr.Parser { return s.parser }
+func (s *CommCaseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeListContext) AllType_() []IType_Context {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IType_Context)(nil)).Elem())
-	var tst = make([]IType_Context, len(ts))
+func (s *CommCaseContext) SendStmt() ISendStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISendStmtContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IType_Context)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ISendStmtContext)
 }
 
-func (s *TypeListContext) Type_(i int) IType_Context {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), i)
+func (s *CommCaseContext) RecvStmt() IRecvStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRecvStmtContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IType_Context)
+	return t.(IRecvStmtContext)
 }
 
-func (s *TypeListContext) GetRuleContext() antlr.RuleContext {
+func (s *CommCaseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CommCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CommCaseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeList(s)
+		listenerT.EnterCommCase(s)
 	}
 }
 
-func (s *TypeListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CommCaseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeList(s)
+		listenerT.ExitCommCase(s)
 	}
 }
 
-func (s *TypeListContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *CommCaseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeListVisitor:
-		return t.VisitTypeList(s, delegate)
+	case CommCaseVisitor:
+		return t.VisitCommCase(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeList() (localctx ITypeListContext) {
-	localctx = NewTypeListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, OgParserRULE_typeList)
-	var _la int
+func (p *OgParser) CommCase() (localctx ICommCaseContext) {
+	localctx = NewCommCaseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, OgParserRULE_commCase)
 
 	defer func() {
 		p.ExitRule()
@@ -7906,127 +9878,140 @@ func (p *OgParser) TypeList() (localctx ITypeListContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(564)
-		p.Type_()
-	}
-	p.SetState(569)
+	p.SetState(701)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(698)
+		p.GetErrorHandler().Sync(p)
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+		case 1:
+			{
+				p.SetState(696)
+				p.SendStmt()
+			}
+
+		case 2:
+			{
+				p.SetState(697)
+				p.RecvStmt()
+			}
 
-	for _la == OgParserT__12 {
-		{
-			p.SetState(565)
-			p.Match(OgParserT__12)
 		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(566)
-			p.Type_()
+			p.SetState(700)
+			p.Match(OgParserBLANK)
 		}
 
-		p.SetState(571)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// ISelectStmtContext is an interface to support dynamic dispatch.
-type ISelectStmtContext interface {
+// IRecvStmtContext is an interface to support dynamic dispatch.
+type IRecvStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSelectStmtContext differentiates from other interfaces.
-	IsSelectStmtContext()
+	// IsRecvStmtContext differentiates from other interfaces.
+	IsRecvStmtContext()
 }
 
-type SelectStmtContext struct {
+type RecvStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySelectStmtContext() *SelectStmtContext {
-	var p = new(SelectStmtContext)
+func NewEmptyRecvStmtContext() *RecvStmtContext {
+	var p = new(RecvStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_selectStmt
+	p.RuleIndex = OgParserRULE_recvStmt
 	return p
 }
 
-func (*SelectStmtContext) IsSelectStmtContext() {}
+func (*RecvStmtContext) IsRecvStmtContext() {}
 
-func NewSelectStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SelectStmtContext {
-	var p = new(SelectStmtContext)
+func NewRecvStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecvStmtContext {
+	var p = new(RecvStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_selectStmt
+	p.RuleIndex = OgParserRULE_recvStmt
 
 	return p
 }
 
-func (s *SelectStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *RecvStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SelectStmtContext) AllCommClause() []ICommClauseContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ICommClauseContext)(nil)).Elem())
-	var tst = make([]ICommClauseContext, len(ts))
+func (s *RecvStmtContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ICommClauseContext)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(IExpressionContext)
 }
 
-func (s *SelectStmtContext) CommClause(i int) ICommClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICommClauseContext)(nil)).Elem(), i)
+func (s *RecvStmtContext) ExpressionList() IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ICommClauseContext)
+	return t.(IExpressionListContext)
 }
 
-func (s *SelectStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *RecvStmtContext) IdentifierList() IIdentifierListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *RecvStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SelectStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RecvStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SelectStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RecvStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterSelectStmt(s)
+		listenerT.EnterRecvStmt(s)
 	}
 }
 
-func (s *SelectStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RecvStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitSelectStmt(s)
+		listenerT.ExitRecvStmt(s)
 	}
 }
 
-func (s *SelectStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *RecvStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case SelectStmtVisitor:
-		return t.VisitSelectStmt(s, delegate)
+	case RecvStmtVisitor:
+		return t.VisitRecvStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) SelectStmt() (localctx ISelectStmtContext) {
-	localctx = NewSelectStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, OgParserRULE_selectStmt)
-	var _la int
+func (p *OgParser) RecvStmt() (localctx IRecvStmtContext) {
+	localctx = NewRecvStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, OgParserRULE_recvStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -8045,136 +10030,148 @@ func (p *OgParser) SelectStmt() (localctx ISelectStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(572)
-		p.Match(OgParserT__43)
-	}
-	{
-		p.SetState(573)
-		p.Match(OgParserT__5)
-	}
-	p.SetState(577)
+	p.SetState(709)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	for (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__11)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(574)
-			p.CommClause()
+			p.SetState(703)
+			p.ExpressionList()
+		}
+		{
+			p.SetState(704)
+			p.Match(OgParserT__9)
+		}
+
+	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) == 2 {
+		{
+			p.SetState(706)
+			p.IdentifierList()
+		}
+		{
+			p.SetState(707)
+			p.Match(OgParserT__29)
 		}
 
-		p.SetState(579)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(580)
-		p.Match(OgParserT__6)
+		p.SetState(711)
+		p.expression(0)
 	}
 
 	return localctx
 }
 
-// ICommClauseContext is an interface to support dynamic dispatch.
-type ICommClauseContext interface {
+// IForStmtContext is an interface to support dynamic dispatch.
+type IForStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCommClauseContext differentiates from other interfaces.
-	IsCommClauseContext()
+	// IsForStmtContext differentiates from other interfaces.
+	IsForStmtContext()
 }
 
-type CommClauseContext struct {
+type ForStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCommClauseContext() *CommClauseContext {
-	var p = new(CommClauseContext)
+func NewEmptyForStmtContext() *ForStmtContext {
+	var p = new(ForStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_commClause
+	p.RuleIndex = OgParserRULE_forStmt
 	return p
 }
 
-func (*CommClauseContext) IsCommClauseContext() {}
+func (*ForStmtContext) IsForStmtContext() {}
 
-func NewCommClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CommClauseContext {
-	var p = new(CommClauseContext)
+func NewForStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForStmtContext {
+	var p = new(ForStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_commClause
+	p.RuleIndex = OgParserRULE_forStmt
 
 	return p
 }
 
-func (s *CommClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *ForStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CommClauseContext) CommCase() ICommCaseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICommCaseContext)(nil)).Elem(), 0)
+func (s *ForStmtContext) Block() IBlockContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ICommCaseContext)
+	return t.(IBlockContext)
 }
 
-func (s *CommClauseContext) Block() IBlockContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+func (s *ForStmtContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IBlockContext)
+	return t.(IExpressionContext)
 }
 
-func (s *CommClauseContext) Statement() IStatementContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStatementContext)(nil)).Elem(), 0)
+func (s *ForStmtContext) RangeClause() IRangeClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRangeClauseContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IStatementContext)
+	return t.(IRangeClauseContext)
 }
 
-func (s *CommClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *ForStmtContext) ForClause() IForClauseContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForClauseContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IForClauseContext)
+}
+
+func (s *ForStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CommClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ForStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CommClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ForStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterCommClause(s)
+		listenerT.EnterForStmt(s)
 	}
 }
 
-func (s *CommClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ForStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitCommClause(s)
+		listenerT.ExitForStmt(s)
 	}
 }
 
-func (s *CommClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ForStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case CommClauseVisitor:
-		return t.VisitCommClause(s, delegate)
+	case ForStmtVisitor:
+		return t.VisitForStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) CommClause() (localctx ICommClauseContext) {
-	localctx = NewCommClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, OgParserRULE_commClause)
+func (p *OgParser) ForStmt() (localctx IForStmtContext) {
+	localctx = NewForStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, OgParserRULE_forStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -8194,124 +10191,148 @@ func (p *OgParser) CommClause() (localctx ICommClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(582)
-		p.CommCase()
-	}
-	{
-		p.SetState(583)
-		p.Match(OgParserT__40)
+		p.SetState(713)
+		p.Match(OgParserFOR)
 	}
-	p.SetState(586)
+	p.SetState(717)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) {
-	case 1:
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(584)
-			p.Block()
+			p.SetState(714)
+			p.expression(0)
 		}
 
-	case 2:
+	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) == 2 {
 		{
-			p.SetState(585)
-			p.Statement()
+			p.SetState(715)
+			p.RangeClause()
+		}
+
+	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) == 3 {
+		{
+			p.SetState(716)
+			p.ForClause()
 		}
 
 	}
+	{
+		p.SetState(719)
+		p.Match(OgParserSEMI)
+	}
+	{
+		p.SetState(720)
+		p.Block()
+	}
 
 	return localctx
 }
 
-// ICommCaseContext is an interface to support dynamic dispatch.
-type ICommCaseContext interface {
+// IForClauseContext is an interface to support dynamic dispatch.
+type IForClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsCommCaseContext differentiates from other interfaces.
-	IsCommCaseContext()
+	// IsForClauseContext differentiates from other interfaces.
+	IsForClauseContext()
 }
 
-type CommCaseContext struct {
+type ForClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyCommCaseContext() *CommCaseContext {
-	var p = new(CommCaseContext)
+func NewEmptyForClauseContext() *ForClauseContext {
+	var p = new(ForClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_commCase
+	p.RuleIndex = OgParserRULE_forClause
 	return p
 }
 
-func (*CommCaseContext) IsCommCaseContext() {}
+func (*ForClauseContext) IsForClauseContext() {}
 
-func NewCommCaseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CommCaseContext {
-	var p = new(CommCaseContext)
+func NewForClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForClauseContext {
+	var p = new(ForClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_commCase
+	p.RuleIndex = OgParserRULE_forClause
 
 	return p
 }
 
-func (s *CommCaseContext) GetParser() antlr.Parser { return s.parser }
+func (s *ForClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *CommCaseContext) SendStmt() ISendStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISendStmtContext)(nil)).Elem(), 0)
+func (s *ForClauseContext) AllSimpleStmt() []ISimpleStmtContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem())
+	var tst = make([]ISimpleStmtContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISimpleStmtContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ForClauseContext) SimpleStmt(i int) ISimpleStmtContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISendStmtContext)
+	return t.(ISimpleStmtContext)
 }
 
-func (s *CommCaseContext) RecvStmt() IRecvStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRecvStmtContext)(nil)).Elem(), 0)
+func (s *ForClauseContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IRecvStmtContext)
+	return t.(IExpressionContext)
 }
 
-func (s *CommCaseContext) GetRuleContext() antlr.RuleContext {
+func (s *ForClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *CommCaseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ForClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *CommCaseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ForClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterCommCase(s)
+		listenerT.EnterForClause(s)
 	}
 }
 
-func (s *CommCaseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ForClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitCommCase(s)
+		listenerT.ExitForClause(s)
 	}
 }
 
-func (s *CommCaseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ForClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case CommCaseVisitor:
-		return t.VisitCommCase(s, delegate)
+	case ForClauseVisitor:
+		return t.VisitForClause(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) CommCase() (localctx ICommCaseContext) {
-	localctx = NewCommCaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, OgParserRULE_commCase)
-
+func (p *OgParser) ForClause() (localctx IForClauseContext) {
+	localctx = NewForClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, OgParserRULE_forClause)
+	var _la int
+
 	defer func() {
 		p.ExitRule()
 	}()
@@ -8328,33 +10349,43 @@ func (p *OgParser) CommCase() (localctx ICommCaseContext) {
 		}
 	}()
 
-	p.SetState(593)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(723)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 50, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		p.SetState(590)
-		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) {
-		case 1:
-			{
-				p.SetState(588)
-				p.SendStmt()
-			}
 
-		case 2:
-			{
-				p.SetState(589)
-				p.RecvStmt()
-			}
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(722)
+			p.SimpleStmt()
+		}
+
+	}
+	{
+		p.SetState(725)
+		p.Match(OgParserSEMI)
+	}
+	p.SetState(727)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
+		{
+			p.SetState(726)
+			p.expression(0)
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
+	}
+	{
+		p.SetState(729)
+		p.Match(OgParserSEMI)
+	}
+	p.SetState(731)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(592)
-			p.Match(OgParserT__11)
+			p.SetState(730)
+			p.SimpleStmt()
 		}
 
 	}
@@ -8362,45 +10393,45 @@ func (p *OgParser) CommCase() (localctx ICommCaseContext) {
 	return localctx
 }
 
-// IRecvStmtContext is an interface to support dynamic dispatch.
-type IRecvStmtContext interface {
+// IRangeClauseContext is an interface to support dynamic dispatch.
+type IRangeClauseContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRecvStmtContext differentiates from other interfaces.
-	IsRecvStmtContext()
+	// IsRangeClauseContext differentiates from other interfaces.
+	IsRangeClauseContext()
 }
 
-type RecvStmtContext struct {
+type RangeClauseContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRecvStmtContext() *RecvStmtContext {
-	var p = new(RecvStmtContext)
+func NewEmptyRangeClauseContext() *RangeClauseContext {
+	var p = new(RangeClauseContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_recvStmt
+	p.RuleIndex = OgParserRULE_rangeClause
 	return p
 }
 
-func (*RecvStmtContext) IsRecvStmtContext() {}
+func (*RangeClauseContext) IsRangeClauseContext() {}
 
-func NewRecvStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RecvStmtContext {
-	var p = new(RecvStmtContext)
+func NewRangeClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RangeClauseContext {
+	var p = new(RangeClauseContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_recvStmt
+	p.RuleIndex = OgParserRULE_rangeClause
 
 	return p
 }
 
-func (s *RecvStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *RangeClauseContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RecvStmtContext) Expression() IExpressionContext {
+func (s *RangeClauseContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -8410,58 +10441,58 @@ func (s *RecvStmtContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *RecvStmtContext) ExpressionList() IExpressionListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
+func (s *RangeClauseContext) IdentifierList() IIdentifierListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionListContext)
+	return t.(IIdentifierListContext)
 }
 
-func (s *RecvStmtContext) IdentifierList() IIdentifierListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
+func (s *RangeClauseContext) ExpressionList() IExpressionListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIdentifierListContext)
+	return t.(IExpressionListContext)
 }
 
-func (s *RecvStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *RangeClauseContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RecvStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *RangeClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RecvStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *RangeClauseContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterRecvStmt(s)
+		listenerT.EnterRangeClause(s)
 	}
 }
 
-func (s *RecvStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *RangeClauseContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitRecvStmt(s)
+		listenerT.ExitRangeClause(s)
 	}
 }
 
-func (s *RecvStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *RangeClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case RecvStmtVisitor:
-		return t.VisitRecvStmt(s, delegate)
+	case RangeClauseVisitor:
+		return t.VisitRangeClause(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) RecvStmt() (localctx IRecvStmtContext) {
-	localctx = NewRecvStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, OgParserRULE_recvStmt)
+func (p *OgParser) RangeClause() (localctx IRangeClauseContext) {
+	localctx = NewRangeClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, OgParserRULE_rangeClause)
 
 	defer func() {
 		p.ExitRule()
@@ -8480,87 +10511,83 @@ func (p *OgParser) RecvStmt() (localctx IRecvStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(601)
+	p.SetState(735)
 	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(595)
-			p.ExpressionList()
-		}
-		{
-			p.SetState(596)
-			p.Match(OgParserT__10)
-		}
-
-	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) == 2 {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(598)
+			p.SetState(733)
 			p.IdentifierList()
 		}
+
+	case 2:
 		{
-			p.SetState(599)
-			p.Match(OgParserT__30)
+			p.SetState(734)
+			p.ExpressionList()
 		}
 
 	}
 	{
-		p.SetState(603)
+		p.SetState(737)
+		p.Match(OgParserT__42)
+	}
+	{
+		p.SetState(738)
 		p.expression(0)
 	}
 
 	return localctx
 }
 
-// IForStmtContext is an interface to support dynamic dispatch.
-type IForStmtContext interface {
+// IGoStmtContext is an interface to support dynamic dispatch.
+type IGoStmtContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsForStmtContext differentiates from other interfaces.
-	IsForStmtContext()
+	// IsGoStmtContext differentiates from other interfaces.
+	IsGoStmtContext()
 }
 
-type ForStmtContext struct {
+type GoStmtContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyForStmtContext() *ForStmtContext {
-	var p = new(ForStmtContext)
+func NewEmptyGoStmtContext() *GoStmtContext {
+	var p = new(GoStmtContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_forStmt
+	p.RuleIndex = OgParserRULE_goStmt
 	return p
 }
 
-func (*ForStmtContext) IsForStmtContext() {}
+func (*GoStmtContext) IsGoStmtContext() {}
 
-func NewForStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForStmtContext {
-	var p = new(ForStmtContext)
+func NewGoStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GoStmtContext {
+	var p = new(GoStmtContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_forStmt
+	p.RuleIndex = OgParserRULE_goStmt
 
 	return p
 }
 
-func (s *ForStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *GoStmtContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ForStmtContext) Block() IBlockContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IBlockContext)(nil)).Elem(), 0)
+func (s *GoStmtContext) Function() IFunctionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IBlockContext)
+	return t.(IFunctionContext)
 }
 
-func (s *ForStmtContext) Expression() IExpressionContext {
+func (s *GoStmtContext) Expression() IExpressionContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -8570,58 +10597,38 @@ func (s *ForStmtContext) Expression() IExpressionContext {
 	return t.(IExpressionContext)
 }
 
-func (s *ForStmtContext) RangeClause() IRangeClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IRangeClauseContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IRangeClauseContext)
-}
-
-func (s *ForStmtContext) ForClause() IForClauseContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IForClauseContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IForClauseContext)
-}
-
-func (s *ForStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *GoStmtContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ForStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *GoStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ForStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *GoStmtContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterForStmt(s)
+		listenerT.EnterGoStmt(s)
 	}
 }
 
-func (s *ForStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *GoStmtContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitForStmt(s)
+		listenerT.ExitGoStmt(s)
 	}
 }
 
-func (s *ForStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *GoStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ForStmtVisitor:
-		return t.VisitForStmt(s, delegate)
+	case GoStmtVisitor:
+		return t.VisitGoStmt(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ForStmt() (localctx IForStmtContext) {
-	localctx = NewForStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, OgParserRULE_forStmt)
+func (p *OgParser) GoStmt() (localctx IGoStmtContext) {
+	localctx = NewGoStmtContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, OgParserRULE_goStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -8641,147 +10648,129 @@ func (p *OgParser) ForStmt() (localctx IForStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(605)
-		p.Match(OgParserT__44)
+		p.SetState(740)
+		p.Match(OgParserT__43)
 	}
-	p.SetState(609)
+	p.SetState(743)
 	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(606)
-			p.expression(0)
-		}
-
-	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) == 2 {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
+	case 1:
 		{
-			p.SetState(607)
-			p.RangeClause()
+			p.SetState(741)
+			p.Function()
 		}
 
-	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) == 3 {
+	case 2:
 		{
-			p.SetState(608)
-			p.ForClause()
+			p.SetState(742)
+			p.expression(0)
 		}
 
 	}
-	{
-		p.SetState(611)
-		p.Match(OgParserT__31)
-	}
-	{
-		p.SetState(612)
-		p.Block()
-	}
 
 	return localctx
 }
 
-// IForClauseContext is an interface to support dynamic dispatch.
-type IForClauseContext interface {
+// IType_Context is an interface to support dynamic dispatch.
+type IType_Context interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsForClauseContext differentiates from other interfaces.
-	IsForClauseContext()
+	// IsType_Context differentiates from other interfaces.
+	IsType_Context()
 }
 
-type ForClauseContext struct {
+type Type_Context struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyForClauseContext() *ForClauseContext {
-	var p = new(ForClauseContext)
+func NewEmptyType_Context() *Type_Context {
+	var p = new(Type_Context)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_forClause
+	p.RuleIndex = OgParserRULE_type_
 	return p
 }
 
-func (*ForClauseContext) IsForClauseContext() {}
+func (*Type_Context) IsType_Context() {}
 
-func NewForClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ForClauseContext {
-	var p = new(ForClauseContext)
+func NewType_Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Type_Context {
+	var p = new(Type_Context)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_forClause
+	p.RuleIndex = OgParserRULE_type_
 
 	return p
 }
 
-func (s *ForClauseContext) GetParser() antlr.Parser { return s.parser }
+func (s *Type_Context) GetParser() antlr.Parser { return s.parser }
 
-func (s *ForClauseContext) AllSimpleStmt() []ISimpleStmtContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem())
-	var tst = make([]ISimpleStmtContext, len(ts))
+func (s *Type_Context) TypeName() ITypeNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeNameContext)(nil)).Elem(), 0)
 
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ISimpleStmtContext)
-		}
+	if t == nil {
+		return nil
 	}
 
-	return tst
+	return t.(ITypeNameContext)
 }
 
-func (s *ForClauseContext) SimpleStmt(i int) ISimpleStmtContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISimpleStmtContext)(nil)).Elem(), i)
-
+func (s *Type_Context) TypeLit() ITypeLitContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeLitContext)(nil)).Elem(), 0)
+
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISimpleStmtContext)
+	return t.(ITypeLitContext)
 }
 
-func (s *ForClauseContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *Type_Context) Type_() IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IType_Context)
 }
 
-func (s *ForClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *Type_Context) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ForClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *Type_Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ForClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *Type_Context) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterForClause(s)
+		listenerT.EnterType_(s)
 	}
 }
 
-func (s *ForClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *Type_Context) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitForClause(s)
+		listenerT.ExitType_(s)
 	}
 }
 
-func (s *ForClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *Type_Context) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ForClauseVisitor:
-		return t.VisitForClause(s, delegate)
+	case Type_Visitor:
+		return t.VisitType_(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ForClause() (localctx IForClauseContext) {
-	localctx = NewForClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, OgParserRULE_forClause)
-	var _la int
+func (p *OgParser) Type_() (localctx IType_Context) {
+	localctx = NewType_Context(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, OgParserRULE_type_)
 
 	defer func() {
 		p.ExitRule()
@@ -8799,150 +10788,130 @@ func (p *OgParser) ForClause() (localctx IForClauseContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(615)
+	p.SetState(751)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) == 1 {
+	switch p.GetTokenStream().LA(1) {
+	case OgParserT__56, OgParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(614)
-			p.SimpleStmt()
+			p.SetState(745)
+			p.TypeName()
 		}
 
-	}
-	{
-		p.SetState(617)
-		p.Match(OgParserT__31)
-	}
-	p.SetState(619)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
+	case OgParserT__14, OgParserT__16, OgParserT__44, OgParserT__46, OgParserT__47, OgParserT__48, OgParserT__49, OgParserT__57, OgParserSTRUCT:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(618)
-			p.expression(0)
+			p.SetState(746)
+			p.TypeLit()
 		}
 
-	}
-	{
-		p.SetState(621)
-		p.Match(OgParserT__31)
-	}
-	p.SetState(623)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) == 1 {
+	case OgParserT__3:
+		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(622)
-			p.SimpleStmt()
+			p.SetState(747)
+			p.Match(OgParserT__3)
+		}
+		{
+			p.SetState(748)
+			p.Type_()
+		}
+		{
+			p.SetState(749)
+			p.Match(OgParserT__4)
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IRangeClauseContext is an interface to support dynamic dispatch.
-type IRangeClauseContext interface {
+// ITypeNameContext is an interface to support dynamic dispatch.
+type ITypeNameContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsRangeClauseContext differentiates from other interfaces.
-	IsRangeClauseContext()
+	// IsTypeNameContext differentiates from other interfaces.
+	IsTypeNameContext()
 }
 
-type RangeClauseContext struct {
+type TypeNameContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyRangeClauseContext() *RangeClauseContext {
-	var p = new(RangeClauseContext)
+func NewEmptyTypeNameContext() *TypeNameContext {
+	var p = new(TypeNameContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_rangeClause
+	p.RuleIndex = OgParserRULE_typeName
 	return p
 }
 
-func (*RangeClauseContext) IsRangeClauseContext() {}
+func (*TypeNameContext) IsTypeNameContext() {}
 
-func NewRangeClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *RangeClauseContext {
-	var p = new(RangeClauseContext)
+func NewTypeNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeNameContext {
+	var p = new(TypeNameContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_rangeClause
+	p.RuleIndex = OgParserRULE_typeName
 
 	return p
 }
 
-func (s *RangeClauseContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *RangeClauseContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionContext)
-}
+func (s *TypeNameContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *RangeClauseContext) IdentifierList() IIdentifierListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIdentifierListContext)(nil)).Elem(), 0)
+func (s *TypeNameContext) QualifiedIdent() IQualifiedIdentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IQualifiedIdentContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IIdentifierListContext)
+	return t.(IQualifiedIdentContext)
 }
 
-func (s *RangeClauseContext) ExpressionList() IExpressionListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionListContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IExpressionListContext)
+func (s *TypeNameContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
 }
 
-func (s *RangeClauseContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeNameContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *RangeClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *RangeClauseContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeNameContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterRangeClause(s)
+		listenerT.EnterTypeName(s)
 	}
 }
 
-func (s *RangeClauseContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeNameContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitRangeClause(s)
+		listenerT.ExitTypeName(s)
 	}
 }
 
-func (s *RangeClauseContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeNameContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case RangeClauseVisitor:
-		return t.VisitRangeClause(s, delegate)
+	case TypeNameVisitor:
+		return t.VisitTypeName(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) RangeClause() (localctx IRangeClauseContext) {
-	localctx = NewRangeClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, OgParserRULE_rangeClause)
+func (p *OgParser) TypeName() (localctx ITypeNameContext) {
+	localctx = NewTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, OgParserRULE_typeName)
 
 	defer func() {
 		p.ExitRule()
@@ -8960,125 +10929,178 @@ func (p *OgParser) RangeClause() (localctx IRangeClauseContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(627)
+	p.SetState(755)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 56, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) {
 	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(625)
-			p.IdentifierList()
+			p.SetState(753)
+			p.QualifiedIdent()
 		}
 
 	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(626)
-			p.ExpressionList()
+			p.SetState(754)
+			p.Match(OgParserIDENTIFIER)
 		}
 
 	}
-	{
-		p.SetState(629)
-		p.Match(OgParserT__45)
-	}
-	{
-		p.SetState(630)
-		p.expression(0)
-	}
 
 	return localctx
 }
 
-// IGoStmtContext is an interface to support dynamic dispatch.
-type IGoStmtContext interface {
+// ITypeLitContext is an interface to support dynamic dispatch.
+type ITypeLitContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsGoStmtContext differentiates from other interfaces.
-	IsGoStmtContext()
+	// IsTypeLitContext differentiates from other interfaces.
+	IsTypeLitContext()
 }
 
-type GoStmtContext struct {
+type TypeLitContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGoStmtContext() *GoStmtContext {
-	var p = new(GoStmtContext)
+func NewEmptyTypeLitContext() *TypeLitContext {
+	var p = new(TypeLitContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_goStmt
+	p.RuleIndex = OgParserRULE_typeLit
 	return p
 }
 
-func (*GoStmtContext) IsGoStmtContext() {}
+func (*TypeLitContext) IsTypeLitContext() {}
 
-func NewGoStmtContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GoStmtContext {
-	var p = new(GoStmtContext)
+func NewTypeLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeLitContext {
+	var p = new(TypeLitContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_goStmt
+	p.RuleIndex = OgParserRULE_typeLit
 
 	return p
 }
 
-func (s *GoStmtContext) GetParser() antlr.Parser { return s.parser }
+func (s *TypeLitContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GoStmtContext) Function() IFunctionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionContext)(nil)).Elem(), 0)
+func (s *TypeLitContext) ArrayType() IArrayTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayTypeContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IFunctionContext)
+	return t.(IArrayTypeContext)
 }
 
-func (s *GoStmtContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *TypeLitContext) StructType() IStructTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStructTypeContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IStructTypeContext)
+}
+
+func (s *TypeLitContext) PointerType() IPointerTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPointerTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPointerTypeContext)
+}
+
+func (s *TypeLitContext) FunctionType() IFunctionTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFunctionTypeContext)
+}
+
+func (s *TypeLitContext) InterfaceType() IInterfaceTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInterfaceTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IInterfaceTypeContext)
+}
+
+func (s *TypeLitContext) SliceType() ISliceTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISliceTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISliceTypeContext)
+}
+
+func (s *TypeLitContext) MapType() IMapTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMapTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMapTypeContext)
+}
+
+func (s *TypeLitContext) ChannelType() IChannelTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IChannelTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IChannelTypeContext)
 }
 
-func (s *GoStmtContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeLitContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GoStmtContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GoStmtContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeLitContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterGoStmt(s)
+		listenerT.EnterTypeLit(s)
 	}
 }
 
-func (s *GoStmtContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeLitContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitGoStmt(s)
+		listenerT.ExitTypeLit(s)
 	}
 }
 
-func (s *GoStmtContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TypeLitContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case GoStmtVisitor:
-		return t.VisitGoStmt(s, delegate)
+	case TypeLitVisitor:
+		return t.VisitTypeLit(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) GoStmt() (localctx IGoStmtContext) {
-	localctx = NewGoStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, OgParserRULE_goStmt)
+func (p *OgParser) TypeLit() (localctx ITypeLitContext) {
+	localctx = NewTypeLitContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, OgParserRULE_typeLit)
 
 	defer func() {
 		p.ExitRule()
@@ -9096,24 +11118,63 @@ func (p *OgParser) GoStmt() (localctx IGoStmtContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(632)
-		p.Match(OgParserT__46)
-	}
-	p.SetState(635)
+	p.SetState(765)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) {
 	case 1:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(633)
-			p.Function()
+			p.SetState(757)
+			p.ArrayType()
 		}
 
 	case 2:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(634)
-			p.expression(0)
+			p.SetState(758)
+			p.StructType()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(759)
+			p.PointerType()
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(760)
+			p.FunctionType()
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(761)
+			p.InterfaceType()
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(762)
+			p.SliceType()
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(763)
+			p.MapType()
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(764)
+			p.ChannelType()
 		}
 
 	}
@@ -9121,106 +11182,96 @@ func (p *OgParser) GoStmt() (localctx IGoStmtContext) {
 	return localctx
 }
 
-// IType_Context is an interface to support dynamic dispatch.
-type IType_Context interface {
+// IArrayTypeContext is an interface to support dynamic dispatch.
+type IArrayTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsType_Context differentiates from other interfaces.
-	IsType_Context()
+	// IsArrayTypeContext differentiates from other interfaces.
+	IsArrayTypeContext()
 }
 
-type Type_Context struct {
+type ArrayTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyType_Context() *Type_Context {
-	var p = new(Type_Context)
+func NewEmptyArrayTypeContext() *ArrayTypeContext {
+	var p = new(ArrayTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_type_
+	p.RuleIndex = OgParserRULE_arrayType
 	return p
 }
 
-func (*Type_Context) IsType_Context() {}
+func (*ArrayTypeContext) IsArrayTypeContext() {}
 
-func NewType_Context(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *Type_Context {
-	var p = new(Type_Context)
+func NewArrayTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayTypeContext {
+	var p = new(ArrayTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_type_
+	p.RuleIndex = OgParserRULE_arrayType
 
 	return p
 }
 
-func (s *Type_Context) GetParser() antlr.Parser { return s.parser }
-
-func (s *Type_Context) TypeName() ITypeNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeNameContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ITypeNameContext)
-}
+func (s *ArrayTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *Type_Context) TypeLit() ITypeLitContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeLitContext)(nil)).Elem(), 0)
+func (s *ArrayTypeContext) ArrayLength() IArrayLengthContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayLengthContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeLitContext)
+	return t.(IArrayLengthContext)
 }
 
-func (s *Type_Context) Type_() IType_Context {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
+func (s *ArrayTypeContext) ElementType() IElementTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IType_Context)
+	return t.(IElementTypeContext)
 }
 
-func (s *Type_Context) GetRuleContext() antlr.RuleContext {
+func (s *ArrayTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *Type_Context) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrayTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *Type_Context) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterType_(s)
+		listenerT.EnterArrayType(s)
 	}
 }
 
-func (s *Type_Context) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitType_(s)
+		listenerT.ExitArrayType(s)
 	}
 }
 
-func (s *Type_Context) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case Type_Visitor:
-		return t.VisitType_(s, delegate)
+	case ArrayTypeVisitor:
+		return t.VisitArrayType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Type_() (localctx IType_Context) {
-	localctx = NewType_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, OgParserRULE_type_)
+func (p *OgParser) ArrayType() (localctx IArrayTypeContext) {
+	localctx = NewArrayTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, OgParserRULE_arrayType)
 
 	defer func() {
 		p.ExitRule()
@@ -9238,130 +11289,107 @@ func (p *OgParser) Type_() (localctx IType_Context) {
 		}
 	}()
 
-	p.SetState(643)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case OgParserT__59, OgParserIDENTIFIER:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(637)
-			p.TypeName()
-		}
-
-	case OgParserT__15, OgParserT__17, OgParserT__47, OgParserT__49, OgParserT__50, OgParserT__51, OgParserT__52, OgParserT__60, OgParserT__61:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(638)
-			p.TypeLit()
-		}
-
-	case OgParserT__3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(639)
-			p.Match(OgParserT__3)
-		}
-		{
-			p.SetState(640)
-			p.Type_()
-		}
-		{
-			p.SetState(641)
-			p.Match(OgParserT__4)
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(767)
+		p.Match(OgParserT__44)
+	}
+	{
+		p.SetState(768)
+		p.ArrayLength()
+	}
+	{
+		p.SetState(769)
+		p.Match(OgParserT__45)
+	}
+	{
+		p.SetState(770)
+		p.ElementType()
 	}
 
 	return localctx
 }
 
-// ITypeNameContext is an interface to support dynamic dispatch.
-type ITypeNameContext interface {
+// IArrayLengthContext is an interface to support dynamic dispatch.
+type IArrayLengthContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeNameContext differentiates from other interfaces.
-	IsTypeNameContext()
+	// IsArrayLengthContext differentiates from other interfaces.
+	IsArrayLengthContext()
 }
 
-type TypeNameContext struct {
+type ArrayLengthContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeNameContext() *TypeNameContext {
-	var p = new(TypeNameContext)
+func NewEmptyArrayLengthContext() *ArrayLengthContext {
+	var p = new(ArrayLengthContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeName
+	p.RuleIndex = OgParserRULE_arrayLength
 	return p
 }
 
-func (*TypeNameContext) IsTypeNameContext() {}
+func (*ArrayLengthContext) IsArrayLengthContext() {}
 
-func NewTypeNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeNameContext {
-	var p = new(TypeNameContext)
+func NewArrayLengthContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayLengthContext {
+	var p = new(ArrayLengthContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeName
+	p.RuleIndex = OgParserRULE_arrayLength
 
 	return p
 }
 
-func (s *TypeNameContext) GetParser() antlr.Parser { return s.parser }
+func (s *ArrayLengthContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeNameContext) QualifiedIdent() IQualifiedIdentContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IQualifiedIdentContext)(nil)).Elem(), 0)
+func (s *ArrayLengthContext) Expression() IExpressionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IQualifiedIdentContext)
-}
-
-func (s *TypeNameContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
+	return t.(IExpressionContext)
 }
 
-func (s *TypeNameContext) GetRuleContext() antlr.RuleContext {
+func (s *ArrayLengthContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ArrayLengthContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeNameContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ArrayLengthContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeName(s)
+		listenerT.EnterArrayLength(s)
 	}
 }
 
-func (s *TypeNameContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ArrayLengthContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeName(s)
+		listenerT.ExitArrayLength(s)
 	}
 }
 
-func (s *TypeNameContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ArrayLengthContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeNameVisitor:
-		return t.VisitTypeName(s, delegate)
+	case ArrayLengthVisitor:
+		return t.VisitArrayLength(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeName() (localctx ITypeNameContext) {
-	localctx = NewTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, OgParserRULE_typeName)
+func (p *OgParser) ArrayLength() (localctx IArrayLengthContext) {
+	localctx = NewArrayLengthContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 134, OgParserRULE_arrayLength)
 
 	defer func() {
 		p.ExitRule()
@@ -9379,178 +11407,95 @@ func (p *OgParser) TypeName() (localctx ITypeNameContext) {
 		}
 	}()
 
-	p.SetState(647)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(645)
-			p.QualifiedIdent()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(646)
-			p.Match(OgParserIDENTIFIER)
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(772)
+		p.expression(0)
 	}
 
 	return localctx
 }
 
-// ITypeLitContext is an interface to support dynamic dispatch.
-type ITypeLitContext interface {
+// IElementTypeContext is an interface to support dynamic dispatch.
+type IElementTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeLitContext differentiates from other interfaces.
-	IsTypeLitContext()
+	// IsElementTypeContext differentiates from other interfaces.
+	IsElementTypeContext()
 }
 
-type TypeLitContext struct {
+type ElementTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeLitContext() *TypeLitContext {
-	var p = new(TypeLitContext)
+func NewEmptyElementTypeContext() *ElementTypeContext {
+	var p = new(ElementTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_typeLit
+	p.RuleIndex = OgParserRULE_elementType
 	return p
 }
 
-func (*TypeLitContext) IsTypeLitContext() {}
+func (*ElementTypeContext) IsElementTypeContext() {}
 
-func NewTypeLitContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeLitContext {
-	var p = new(TypeLitContext)
+func NewElementTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElementTypeContext {
+	var p = new(ElementTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_typeLit
+	p.RuleIndex = OgParserRULE_elementType
 
 	return p
 }
 
-func (s *TypeLitContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeLitContext) ArrayType() IArrayTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IArrayTypeContext)
-}
-
-func (s *TypeLitContext) StructType() IStructTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IStructTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IStructTypeContext)
-}
-
-func (s *TypeLitContext) PointerType() IPointerTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPointerTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IPointerTypeContext)
-}
-
-func (s *TypeLitContext) FunctionType() IFunctionTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFunctionTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IFunctionTypeContext)
-}
-
-func (s *TypeLitContext) InterfaceType() IInterfaceTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IInterfaceTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IInterfaceTypeContext)
-}
-
-func (s *TypeLitContext) SliceType() ISliceTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISliceTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(ISliceTypeContext)
-}
-
-func (s *TypeLitContext) MapType() IMapTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMapTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IMapTypeContext)
-}
+func (s *ElementTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeLitContext) ChannelType() IChannelTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IChannelTypeContext)(nil)).Elem(), 0)
+func (s *ElementTypeContext) Type_() IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IChannelTypeContext)
+	return t.(IType_Context)
 }
 
-func (s *TypeLitContext) GetRuleContext() antlr.RuleContext {
+func (s *ElementTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeLitContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ElementTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeLitContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ElementTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTypeLit(s)
+		listenerT.EnterElementType(s)
 	}
 }
 
-func (s *TypeLitContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ElementTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTypeLit(s)
+		listenerT.ExitElementType(s)
 	}
 }
 
-func (s *TypeLitContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ElementTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TypeLitVisitor:
-		return t.VisitTypeLit(s, delegate)
+	case ElementTypeVisitor:
+		return t.VisitElementType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TypeLit() (localctx ITypeLitContext) {
-	localctx = NewTypeLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, OgParserRULE_typeLit)
+func (p *OgParser) ElementType() (localctx IElementTypeContext) {
+	localctx = NewElementTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 136, OgParserRULE_elementType)
 
 	defer func() {
 		p.ExitRule()
@@ -9568,160 +11513,95 @@ func (p *OgParser) TypeLit() (localctx ITypeLitContext) {
 		}
 	}()
 
-	p.SetState(657)
-	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(649)
-			p.ArrayType()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(650)
-			p.StructType()
-		}
-
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(651)
-			p.PointerType()
-		}
-
-	case 4:
-		p.EnterOuterAlt(localctx, 4)
-		{
-			p.SetState(652)
-			p.FunctionType()
-		}
-
-	case 5:
-		p.EnterOuterAlt(localctx, 5)
-		{
-			p.SetState(653)
-			p.InterfaceType()
-		}
-
-	case 6:
-		p.EnterOuterAlt(localctx, 6)
-		{
-			p.SetState(654)
-			p.SliceType()
-		}
-
-	case 7:
-		p.EnterOuterAlt(localctx, 7)
-		{
-			p.SetState(655)
-			p.MapType()
-		}
-
-	case 8:
-		p.EnterOuterAlt(localctx, 8)
-		{
-			p.SetState(656)
-			p.ChannelType()
-		}
-
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(774)
+		p.Type_()
 	}
 
 	return localctx
 }
 
-// IArrayTypeContext is an interface to support dynamic dispatch.
-type IArrayTypeContext interface {
+// IPointerTypeContext is an interface to support dynamic dispatch.
+type IPointerTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsArrayTypeContext differentiates from other interfaces.
-	IsArrayTypeContext()
+	// IsPointerTypeContext differentiates from other interfaces.
+	IsPointerTypeContext()
 }
 
-type ArrayTypeContext struct {
+type PointerTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArrayTypeContext() *ArrayTypeContext {
-	var p = new(ArrayTypeContext)
+func NewEmptyPointerTypeContext() *PointerTypeContext {
+	var p = new(PointerTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_arrayType
+	p.RuleIndex = OgParserRULE_pointerType
 	return p
 }
 
-func (*ArrayTypeContext) IsArrayTypeContext() {}
+func (*PointerTypeContext) IsPointerTypeContext() {}
 
-func NewArrayTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayTypeContext {
-	var p = new(ArrayTypeContext)
+func NewPointerTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PointerTypeContext {
+	var p = new(PointerTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_arrayType
+	p.RuleIndex = OgParserRULE_pointerType
 
 	return p
 }
 
-func (s *ArrayTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ArrayTypeContext) ArrayLength() IArrayLengthContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IArrayLengthContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IArrayLengthContext)
-}
+func (s *PointerTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArrayTypeContext) ElementType() IElementTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
+func (s *PointerTypeContext) Type_() IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IElementTypeContext)
+	return t.(IType_Context)
 }
 
-func (s *ArrayTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *PointerTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrayTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *PointerTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArrayTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *PointerTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterArrayType(s)
+		listenerT.EnterPointerType(s)
 	}
 }
 
-func (s *ArrayTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *PointerTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitArrayType(s)
+		listenerT.ExitPointerType(s)
 	}
 }
 
-func (s *ArrayTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *PointerTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ArrayTypeVisitor:
-		return t.VisitArrayType(s, delegate)
+	case PointerTypeVisitor:
+		return t.VisitPointerType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ArrayType() (localctx IArrayTypeContext) {
-	localctx = NewArrayTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, OgParserRULE_arrayType)
+func (p *OgParser) PointerType() (localctx IPointerTypeContext) {
+	localctx = NewPointerTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 138, OgParserRULE_pointerType)
 
 	defer func() {
 		p.ExitRule()
@@ -9741,105 +11621,146 @@ func (p *OgParser) ArrayType() (localctx IArrayTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(659)
-		p.Match(OgParserT__47)
-	}
-	{
-		p.SetState(660)
-		p.ArrayLength()
-	}
-	{
-		p.SetState(661)
-		p.Match(OgParserT__48)
+		p.SetState(776)
+		p.Match(OgParserT__14)
 	}
-	{
-		p.SetState(662)
-		p.ElementType()
+	{
+		p.SetState(777)
+		p.Type_()
 	}
 
 	return localctx
 }
 
-// IArrayLengthContext is an interface to support dynamic dispatch.
-type IArrayLengthContext interface {
+// IInterfaceTypeContext is an interface to support dynamic dispatch.
+type IInterfaceTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsArrayLengthContext differentiates from other interfaces.
-	IsArrayLengthContext()
+	// IsInterfaceTypeContext differentiates from other interfaces.
+	IsInterfaceTypeContext()
 }
 
-type ArrayLengthContext struct {
+type InterfaceTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyArrayLengthContext() *ArrayLengthContext {
-	var p = new(ArrayLengthContext)
+func NewEmptyInterfaceTypeContext() *InterfaceTypeContext {
+	var p = new(InterfaceTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_arrayLength
+	p.RuleIndex = OgParserRULE_interfaceType
 	return p
 }
 
-func (*ArrayLengthContext) IsArrayLengthContext() {}
+func (*InterfaceTypeContext) IsInterfaceTypeContext() {}
 
-func NewArrayLengthContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArrayLengthContext {
-	var p = new(ArrayLengthContext)
+func NewInterfaceTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceTypeContext {
+	var p = new(InterfaceTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_arrayLength
+	p.RuleIndex = OgParserRULE_interfaceType
 
 	return p
 }
 
-func (s *ArrayLengthContext) GetParser() antlr.Parser { return s.parser }
+func (s *InterfaceTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ArrayLengthContext) Expression() IExpressionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IExpressionContext)(nil)).Elem(), 0)
+func (s *InterfaceTypeContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
+}
+
+func (s *InterfaceTypeContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *InterfaceTypeContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
+func (s *InterfaceTypeContext) AllMethodSpec() []IMethodSpecContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMethodSpecContext)(nil)).Elem())
+	var tst = make([]IMethodSpecContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMethodSpecContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *InterfaceTypeContext) MethodSpec(i int) IMethodSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethodSpecContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IExpressionContext)
+	return t.(IMethodSpecContext)
 }
 
-func (s *ArrayLengthContext) GetRuleContext() antlr.RuleContext {
+func (s *InterfaceTypeContext) AllEos() []IEosContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
+	var tst = make([]IEosContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IEosContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *InterfaceTypeContext) Eos(i int) IEosContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEosContext)
+}
+
+func (s *InterfaceTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ArrayLengthContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *InterfaceTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ArrayLengthContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterArrayLength(s)
+		listenerT.EnterInterfaceType(s)
 	}
 }
 
-func (s *ArrayLengthContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *InterfaceTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitArrayLength(s)
+		listenerT.ExitInterfaceType(s)
 	}
 }
 
-func (s *ArrayLengthContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *InterfaceTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ArrayLengthVisitor:
-		return t.VisitArrayLength(s, delegate)
+	case InterfaceTypeVisitor:
+		return t.VisitInterfaceType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ArrayLength() (localctx IArrayLengthContext) {
-	localctx = NewArrayLengthContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, OgParserRULE_arrayLength)
+func (p *OgParser) InterfaceType() (localctx IInterfaceTypeContext) {
+	localctx = NewInterfaceTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 140, OgParserRULE_interfaceType)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -9857,95 +11778,151 @@ func (p *OgParser) ArrayLength() (localctx IArrayLengthContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(664)
-		p.expression(0)
+		p.SetState(779)
+		p.Match(OgParserT__46)
+	}
+	p.SetState(781)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(780)
+			p.Match(OgParserIDENTIFIER)
+		}
+
+	}
+	p.SetState(793)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
+		p.SetState(783)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+		p.SetState(789)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(784)
+					p.MethodSpec()
+				}
+				{
+					p.SetState(785)
+					p.Eos()
+				}
+
+			}
+			p.SetState(791)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
+		}
+		p.SetState(792)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+
 	}
 
 	return localctx
 }
 
-// IElementTypeContext is an interface to support dynamic dispatch.
-type IElementTypeContext interface {
+// ISliceTypeContext is an interface to support dynamic dispatch.
+type ISliceTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsElementTypeContext differentiates from other interfaces.
-	IsElementTypeContext()
+	// IsSliceTypeContext differentiates from other interfaces.
+	IsSliceTypeContext()
 }
 
-type ElementTypeContext struct {
+type SliceTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyElementTypeContext() *ElementTypeContext {
-	var p = new(ElementTypeContext)
+func NewEmptySliceTypeContext() *SliceTypeContext {
+	var p = new(SliceTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_elementType
+	p.RuleIndex = OgParserRULE_sliceType
 	return p
 }
 
-func (*ElementTypeContext) IsElementTypeContext() {}
+func (*SliceTypeContext) IsSliceTypeContext() {}
 
-func NewElementTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElementTypeContext {
-	var p = new(ElementTypeContext)
+func NewSliceTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SliceTypeContext {
+	var p = new(SliceTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_elementType
+	p.RuleIndex = OgParserRULE_sliceType
 
 	return p
 }
 
-func (s *ElementTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *SliceTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ElementTypeContext) Type_() IType_Context {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
+func (s *SliceTypeContext) ElementType() IElementTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IType_Context)
+	return t.(IElementTypeContext)
 }
 
-func (s *ElementTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *SliceTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ElementTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SliceTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ElementTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SliceTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterElementType(s)
+		listenerT.EnterSliceType(s)
 	}
 }
 
-func (s *ElementTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SliceTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitElementType(s)
+		listenerT.ExitSliceType(s)
 	}
 }
 
-func (s *ElementTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *SliceTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ElementTypeVisitor:
-		return t.VisitElementType(s, delegate)
+	case SliceTypeVisitor:
+		return t.VisitSliceType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ElementType() (localctx IElementTypeContext) {
-	localctx = NewElementTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, OgParserRULE_elementType)
+func (p *OgParser) SliceType() (localctx ISliceTypeContext) {
+	localctx = NewSliceTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 142, OgParserRULE_sliceType)
 
 	defer func() {
 		p.ExitRule()
@@ -9965,52 +11942,60 @@ func (p *OgParser) ElementType() (localctx IElementTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(666)
-		p.Type_()
+		p.SetState(795)
+		p.Match(OgParserT__44)
+	}
+	{
+		p.SetState(796)
+		p.Match(OgParserT__45)
+	}
+	{
+		p.SetState(797)
+		p.ElementType()
 	}
 
 	return localctx
 }
 
-// IPointerTypeContext is an interface to support dynamic dispatch.
-type IPointerTypeContext interface {
+// IMapTypeContext is an interface to support dynamic dispatch.
+type IMapTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPointerTypeContext differentiates from other interfaces.
-	IsPointerTypeContext()
+	// IsMapTypeContext differentiates from other interfaces.
+	IsMapTypeContext()
 }
 
-type PointerTypeContext struct {
+type MapTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPointerTypeContext() *PointerTypeContext {
-	var p = new(PointerTypeContext)
+func NewEmptyMapTypeContext() *MapTypeContext {
+	var p = new(MapTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_pointerType
+	p.RuleIndex = OgParserRULE_mapType
 	return p
 }
 
-func (*PointerTypeContext) IsPointerTypeContext() {}
+func (*MapTypeContext) IsMapTypeContext() {}
 
-func NewPointerTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PointerTypeContext {
-	var p = new(PointerTypeContext)
+func NewMapTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapTypeContext {
+	var p = new(MapTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_pointerType
+	p.RuleIndex = OgParserRULE_mapType
 
 	return p
 }
 
-func (s *PointerTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *MapTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PointerTypeContext) Type_() IType_Context {
+func (s *MapTypeContext) Type_() IType_Context {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
 
 	if t == nil {
@@ -10020,38 +12005,48 @@ func (s *PointerTypeContext) Type_() IType_Context {
 	return t.(IType_Context)
 }
 
-func (s *PointerTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *MapTypeContext) ElementType() IElementTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElementTypeContext)
+}
+
+func (s *MapTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PointerTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MapTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PointerTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MapTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterPointerType(s)
+		listenerT.EnterMapType(s)
 	}
 }
 
-func (s *PointerTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MapTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitPointerType(s)
+		listenerT.ExitMapType(s)
 	}
 }
 
-func (s *PointerTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *MapTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case PointerTypeVisitor:
-		return t.VisitPointerType(s, delegate)
+	case MapTypeVisitor:
+		return t.VisitMapType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) PointerType() (localctx IPointerTypeContext) {
-	localctx = NewPointerTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, OgParserRULE_pointerType)
+func (p *OgParser) MapType() (localctx IMapTypeContext) {
+	localctx = NewMapTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 144, OgParserRULE_mapType)
 
 	defer func() {
 		p.ExitRule()
@@ -10071,137 +12066,119 @@ func (p *OgParser) PointerType() (localctx IPointerTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(668)
-		p.Match(OgParserT__15)
+		p.SetState(799)
+		p.Match(OgParserT__47)
+	}
+	{
+		p.SetState(800)
+		p.Match(OgParserT__44)
 	}
 	{
-		p.SetState(669)
+		p.SetState(801)
 		p.Type_()
 	}
+	{
+		p.SetState(802)
+		p.Match(OgParserT__45)
+	}
+	{
+		p.SetState(803)
+		p.ElementType()
+	}
 
 	return localctx
 }
 
-// IInterfaceTypeContext is an interface to support dynamic dispatch.
-type IInterfaceTypeContext interface {
+// IChannelTypeContext is an interface to support dynamic dispatch.
+type IChannelTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsInterfaceTypeContext differentiates from other interfaces.
-	IsInterfaceTypeContext()
+	// IsChannelTypeContext differentiates from other interfaces.
+	IsChannelTypeContext()
 }
 
-type InterfaceTypeContext struct {
+type ChannelTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInterfaceTypeContext() *InterfaceTypeContext {
-	var p = new(InterfaceTypeContext)
+func NewEmptyChannelTypeContext() *ChannelTypeContext {
+	var p = new(ChannelTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_interfaceType
+	p.RuleIndex = OgParserRULE_channelType
 	return p
 }
 
-func (*InterfaceTypeContext) IsInterfaceTypeContext() {}
+func (*ChannelTypeContext) IsChannelTypeContext() {}
 
-func NewInterfaceTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InterfaceTypeContext {
-	var p = new(InterfaceTypeContext)
+func NewChannelTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChannelTypeContext {
+	var p = new(ChannelTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_interfaceType
+	p.RuleIndex = OgParserRULE_channelType
 
 	return p
 }
 
-func (s *InterfaceTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *InterfaceTypeContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
-}
-
-func (s *InterfaceTypeContext) AllMethodSpec() []IMethodSpecContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMethodSpecContext)(nil)).Elem())
-	var tst = make([]IMethodSpecContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IMethodSpecContext)
-		}
-	}
-
-	return tst
-}
+func (s *ChannelTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InterfaceTypeContext) MethodSpec(i int) IMethodSpecContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMethodSpecContext)(nil)).Elem(), i)
+func (s *ChannelTypeContext) ChannelDecl() IChannelDeclContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IChannelDeclContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IMethodSpecContext)
-}
-
-func (s *InterfaceTypeContext) AllEos() []IEosContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IEosContext)(nil)).Elem())
-	var tst = make([]IEosContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(IEosContext)
-		}
-	}
-
-	return tst
+	return t.(IChannelDeclContext)
 }
 
-func (s *InterfaceTypeContext) Eos(i int) IEosContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IEosContext)(nil)).Elem(), i)
+func (s *ChannelTypeContext) ElementType() IElementTypeContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IEosContext)
+	return t.(IElementTypeContext)
 }
 
-func (s *InterfaceTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *ChannelTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InterfaceTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ChannelTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InterfaceTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ChannelTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterInterfaceType(s)
+		listenerT.EnterChannelType(s)
 	}
 }
 
-func (s *InterfaceTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ChannelTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitInterfaceType(s)
+		listenerT.ExitChannelType(s)
 	}
 }
 
-func (s *InterfaceTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ChannelTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case InterfaceTypeVisitor:
-		return t.VisitInterfaceType(s, delegate)
+	case ChannelTypeVisitor:
+		return t.VisitChannelType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) InterfaceType() (localctx IInterfaceTypeContext) {
-	localctx = NewInterfaceTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, OgParserRULE_interfaceType)
+func (p *OgParser) ChannelType() (localctx IChannelTypeContext) {
+	localctx = NewChannelTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 146, OgParserRULE_channelType)
 
 	defer func() {
 		p.ExitRule()
@@ -10219,141 +12196,88 @@ func (p *OgParser) InterfaceType() (localctx IInterfaceTypeContext) {
 		}
 	}()
 
-	var _alt int
-
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(671)
-		p.Match(OgParserT__49)
-	}
-	p.SetState(673)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(672)
-			p.Match(OgParserIDENTIFIER)
-		}
-
+		p.SetState(805)
+		p.ChannelDecl()
 	}
-	p.SetState(685)
-	p.GetErrorHandler().Sync(p)
-
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(675)
-			p.Match(OgParserT__5)
-		}
-		p.SetState(681)
-		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext())
-
-		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
-			if _alt == 1 {
-				{
-					p.SetState(676)
-					p.MethodSpec()
-				}
-				{
-					p.SetState(677)
-					p.Eos()
-				}
-
-			}
-			p.SetState(683)
-			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext())
-		}
-		{
-			p.SetState(684)
-			p.Match(OgParserT__6)
-		}
-
+	{
+		p.SetState(806)
+		p.ElementType()
 	}
 
 	return localctx
 }
 
-// ISliceTypeContext is an interface to support dynamic dispatch.
-type ISliceTypeContext interface {
+// IChannelDeclContext is an interface to support dynamic dispatch.
+type IChannelDeclContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSliceTypeContext differentiates from other interfaces.
-	IsSliceTypeContext()
+	// IsChannelDeclContext differentiates from other interfaces.
+	IsChannelDeclContext()
 }
 
-type SliceTypeContext struct {
+type ChannelDeclContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySliceTypeContext() *SliceTypeContext {
-	var p = new(SliceTypeContext)
+func NewEmptyChannelDeclContext() *ChannelDeclContext {
+	var p = new(ChannelDeclContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_sliceType
+	p.RuleIndex = OgParserRULE_channelDecl
 	return p
 }
 
-func (*SliceTypeContext) IsSliceTypeContext() {}
+func (*ChannelDeclContext) IsChannelDeclContext() {}
 
-func NewSliceTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SliceTypeContext {
-	var p = new(SliceTypeContext)
+func NewChannelDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChannelDeclContext {
+	var p = new(ChannelDeclContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_sliceType
+	p.RuleIndex = OgParserRULE_channelDecl
 
 	return p
 }
 
-func (s *SliceTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SliceTypeContext) ElementType() IElementTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IElementTypeContext)
-}
-
-func (s *SliceTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *ChannelDeclContext) GetParser() antlr.Parser { return s.parser }
+func (s *ChannelDeclContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SliceTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ChannelDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SliceTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ChannelDeclContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterSliceType(s)
+		listenerT.EnterChannelDecl(s)
 	}
 }
 
-func (s *SliceTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ChannelDeclContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitSliceType(s)
+		listenerT.ExitChannelDecl(s)
 	}
 }
 
-func (s *SliceTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ChannelDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case SliceTypeVisitor:
-		return t.VisitSliceType(s, delegate)
+	case ChannelDeclVisitor:
+		return t.VisitChannelDecl(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) SliceType() (localctx ISliceTypeContext) {
-	localctx = NewSliceTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, OgParserRULE_sliceType)
+func (p *OgParser) ChannelDecl() (localctx IChannelDeclContext) {
+	localctx = NewChannelDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 148, OgParserRULE_channelDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -10372,112 +12296,144 @@ func (p *OgParser) SliceType() (localctx ISliceTypeContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(687)
-		p.Match(OgParserT__47)
-	}
-	{
-		p.SetState(688)
-		p.Match(OgParserT__48)
-	}
-	{
-		p.SetState(689)
-		p.ElementType()
+	p.SetState(813)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) {
+	case 1:
+		{
+			p.SetState(808)
+			p.Match(OgParserT__48)
+		}
+
+	case 2:
+		{
+			p.SetState(809)
+			p.Match(OgParserT__48)
+		}
+		{
+			p.SetState(810)
+			p.Match(OgParserT__16)
+		}
+
+	case 3:
+		{
+			p.SetState(811)
+			p.Match(OgParserT__16)
+		}
+		{
+			p.SetState(812)
+			p.Match(OgParserT__48)
+		}
+
 	}
 
 	return localctx
 }
 
-// IMapTypeContext is an interface to support dynamic dispatch.
-type IMapTypeContext interface {
+// IMethodSpecContext is an interface to support dynamic dispatch.
+type IMethodSpecContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMapTypeContext differentiates from other interfaces.
-	IsMapTypeContext()
+	// IsMethodSpecContext differentiates from other interfaces.
+	IsMethodSpecContext()
 }
 
-type MapTypeContext struct {
+type MethodSpecContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMapTypeContext() *MapTypeContext {
-	var p = new(MapTypeContext)
+func NewEmptyMethodSpecContext() *MethodSpecContext {
+	var p = new(MethodSpecContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_mapType
+	p.RuleIndex = OgParserRULE_methodSpec
 	return p
 }
 
-func (*MapTypeContext) IsMapTypeContext() {}
+func (*MethodSpecContext) IsMethodSpecContext() {}
 
-func NewMapTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MapTypeContext {
-	var p = new(MapTypeContext)
+func NewMethodSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethodSpecContext {
+	var p = new(MethodSpecContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_mapType
+	p.RuleIndex = OgParserRULE_methodSpec
 
 	return p
 }
 
-func (s *MapTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *MethodSpecContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MapTypeContext) Type_() IType_Context {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
+func (s *MethodSpecContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(OgParserIDENTIFIER, 0)
+}
+
+func (s *MethodSpecContext) Parameters() IParametersContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParametersContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IType_Context)
+	return t.(IParametersContext)
 }
 
-func (s *MapTypeContext) ElementType() IElementTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
+func (s *MethodSpecContext) Result() IResultContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResultContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IElementTypeContext)
+	return t.(IResultContext)
 }
 
-func (s *MapTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *MethodSpecContext) TypeName() ITypeNameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeNameContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeNameContext)
+}
+
+func (s *MethodSpecContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MapTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MethodSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MapTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MethodSpecContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterMapType(s)
+		listenerT.EnterMethodSpec(s)
 	}
 }
 
-func (s *MapTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MethodSpecContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitMapType(s)
+		listenerT.ExitMethodSpec(s)
 	}
 }
 
-func (s *MapTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *MethodSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case MapTypeVisitor:
-		return t.VisitMapType(s, delegate)
+	case MethodSpecVisitor:
+		return t.VisitMethodSpec(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) MapType() (localctx IMapTypeContext) {
-	localctx = NewMapTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, OgParserRULE_mapType)
+func (p *OgParser) MethodSpec() (localctx IMethodSpecContext) {
+	localctx = NewMethodSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 150, OgParserRULE_methodSpec)
 
 	defer func() {
 		p.ExitRule()
@@ -10495,121 +12451,136 @@ func (p *OgParser) MapType() (localctx IMapTypeContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(691)
-		p.Match(OgParserT__50)
-	}
-	{
-		p.SetState(692)
-		p.Match(OgParserT__47)
-	}
-	{
-		p.SetState(693)
-		p.Type_()
-	}
-	{
-		p.SetState(694)
-		p.Match(OgParserT__48)
-	}
-	{
-		p.SetState(695)
-		p.ElementType()
+	p.SetState(824)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(815)
+
+		if !(p.noTerminatorAfterParams(2)) {
+			panic(antlr.NewFailedPredicateException(p, "p.noTerminatorAfterParams(2)", ""))
+		}
+		{
+			p.SetState(816)
+			p.Match(OgParserIDENTIFIER)
+		}
+		{
+			p.SetState(817)
+			p.Parameters()
+		}
+		{
+			p.SetState(818)
+			p.Match(OgParserCOLON)
+		}
+		{
+			p.SetState(819)
+			p.Result()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(821)
+			p.TypeName()
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(822)
+			p.Match(OgParserIDENTIFIER)
+		}
+		{
+			p.SetState(823)
+			p.Parameters()
+		}
+
 	}
 
 	return localctx
 }
 
-// IChannelTypeContext is an interface to support dynamic dispatch.
-type IChannelTypeContext interface {
+// IFunctionTypeContext is an interface to support dynamic dispatch.
+type IFunctionTypeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsChannelTypeContext differentiates from other interfaces.
-	IsChannelTypeContext()
+	// IsFunctionTypeContext differentiates from other interfaces.
+	IsFunctionTypeContext()
 }
 
-type ChannelTypeContext struct {
+type FunctionTypeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyChannelTypeContext() *ChannelTypeContext {
-	var p = new(ChannelTypeContext)
+func NewEmptyFunctionTypeContext() *FunctionTypeContext {
+	var p = new(FunctionTypeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_channelType
+	p.RuleIndex = OgParserRULE_functionType
 	return p
 }
 
-func (*ChannelTypeContext) IsChannelTypeContext() {}
+func (*FunctionTypeContext) IsFunctionTypeContext() {}
 
-func NewChannelTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChannelTypeContext {
-	var p = new(ChannelTypeContext)
+func NewFunctionTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionTypeContext {
+	var p = new(FunctionTypeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_channelType
+	p.RuleIndex = OgParserRULE_functionType
 
 	return p
 }
 
-func (s *ChannelTypeContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ChannelTypeContext) ChannelDecl() IChannelDeclContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IChannelDeclContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IChannelDeclContext)
-}
+func (s *FunctionTypeContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ChannelTypeContext) ElementType() IElementTypeContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementTypeContext)(nil)).Elem(), 0)
+func (s *FunctionTypeContext) Signature() ISignatureContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IElementTypeContext)
+	return t.(ISignatureContext)
 }
 
-func (s *ChannelTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *FunctionTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ChannelTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FunctionTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ChannelTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FunctionTypeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterChannelType(s)
+		listenerT.EnterFunctionType(s)
 	}
 }
 
-func (s *ChannelTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FunctionTypeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitChannelType(s)
+		listenerT.ExitFunctionType(s)
 	}
 }
 
-func (s *ChannelTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *FunctionTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ChannelTypeVisitor:
-		return t.VisitChannelType(s, delegate)
+	case FunctionTypeVisitor:
+		return t.VisitFunctionType(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ChannelType() (localctx IChannelTypeContext) {
-	localctx = NewChannelTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, OgParserRULE_channelType)
+func (p *OgParser) FunctionType() (localctx IFunctionTypeContext) {
+	localctx = NewFunctionTypeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 152, OgParserRULE_functionType)
 
 	defer func() {
 		p.ExitRule()
@@ -10629,86 +12600,118 @@ func (p *OgParser) ChannelType() (localctx IChannelTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(697)
-		p.ChannelDecl()
+		p.SetState(826)
+		p.Match(OgParserT__49)
 	}
 	{
-		p.SetState(698)
-		p.ElementType()
+		p.SetState(827)
+		p.Signature()
 	}
 
 	return localctx
 }
 
-// IChannelDeclContext is an interface to support dynamic dispatch.
-type IChannelDeclContext interface {
+// ISignatureContext is an interface to support dynamic dispatch.
+type ISignatureContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsChannelDeclContext differentiates from other interfaces.
-	IsChannelDeclContext()
+	// IsSignatureContext differentiates from other interfaces.
+	IsSignatureContext()
 }
 
-type ChannelDeclContext struct {
+type SignatureContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyChannelDeclContext() *ChannelDeclContext {
-	var p = new(ChannelDeclContext)
+func NewEmptySignatureContext() *SignatureContext {
+	var p = new(SignatureContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_channelDecl
+	p.RuleIndex = OgParserRULE_signature
 	return p
 }
 
-func (*ChannelDeclContext) IsChannelDeclContext() {}
+func (*SignatureContext) IsSignatureContext() {}
 
-func NewChannelDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ChannelDeclContext {
-	var p = new(ChannelDeclContext)
+func NewSignatureContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SignatureContext {
+	var p = new(SignatureContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_channelDecl
+	p.RuleIndex = OgParserRULE_signature
 
 	return p
 }
 
-func (s *ChannelDeclContext) GetParser() antlr.Parser { return s.parser }
-func (s *ChannelDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *SignatureContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SignatureContext) Parameters() IParametersContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParametersContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IParametersContext)
+}
+
+func (s *SignatureContext) Result() IResultContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResultContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IResultContext)
+}
+
+func (s *SignatureContext) TemplateSpec() ITemplateSpecContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITemplateSpecContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITemplateSpecContext)
+}
+
+func (s *SignatureContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ChannelDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SignatureContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ChannelDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SignatureContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterChannelDecl(s)
+		listenerT.EnterSignature(s)
 	}
 }
 
-func (s *ChannelDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SignatureContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitChannelDecl(s)
+		listenerT.ExitSignature(s)
 	}
 }
 
-func (s *ChannelDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *SignatureContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case ChannelDeclVisitor:
-		return t.VisitChannelDecl(s, delegate)
+	case SignatureVisitor:
+		return t.VisitSignature(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) ChannelDecl() (localctx IChannelDeclContext) {
-	localctx = NewChannelDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, OgParserRULE_channelDecl)
+func (p *OgParser) Signature() (localctx ISignatureContext) {
+	localctx = NewSignatureContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 154, OgParserRULE_signature)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -10726,34 +12729,55 @@ func (p *OgParser) ChannelDecl() (localctx IChannelDeclContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	p.SetState(705)
+	p.SetState(841)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
 	case 1:
-		{
-			p.SetState(700)
-			p.Match(OgParserT__51)
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(829)
+
+		if !(p.noTerminatorAfterParams(1)) {
+			panic(antlr.NewFailedPredicateException(p, "p.noTerminatorAfterParams(1)", ""))
 		}
+		p.SetState(831)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 
-	case 2:
+		if _la == OgParserT__50 {
+			{
+				p.SetState(830)
+				p.TemplateSpec()
+			}
+
+		}
 		{
-			p.SetState(701)
-			p.Match(OgParserT__51)
+			p.SetState(833)
+			p.Parameters()
 		}
 		{
-			p.SetState(702)
-			p.Match(OgParserT__17)
+			p.SetState(834)
+			p.Match(OgParserCOLON)
 		}
-
-	case 3:
 		{
-			p.SetState(703)
-			p.Match(OgParserT__17)
+			p.SetState(835)
+			p.Result()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(838)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(837)
+				p.TemplateSpec()
+			}
+
 		}
 		{
-			p.SetState(704)
-			p.Match(OgParserT__51)
+			p.SetState(840)
+			p.Parameters()
 		}
 
 	}
@@ -10761,110 +12785,100 @@ func (p *OgParser) ChannelDecl() (localctx IChannelDeclContext) {
 	return localctx
 }
 
-// IMethodSpecContext is an interface to support dynamic dispatch.
-type IMethodSpecContext interface {
+// ITemplateSpecContext is an interface to support dynamic dispatch.
+type ITemplateSpecContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsMethodSpecContext differentiates from other interfaces.
-	IsMethodSpecContext()
+	// IsTemplateSpecContext differentiates from other interfaces.
+	IsTemplateSpecContext()
 }
 
-type MethodSpecContext struct {
+type TemplateSpecContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyMethodSpecContext() *MethodSpecContext {
-	var p = new(MethodSpecContext)
+func NewEmptyTemplateSpecContext() *TemplateSpecContext {
+	var p = new(TemplateSpecContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_methodSpec
+	p.RuleIndex = OgParserRULE_templateSpec
 	return p
 }
 
-func (*MethodSpecContext) IsMethodSpecContext() {}
+func (*TemplateSpecContext) IsTemplateSpecContext() {}
 
-func NewMethodSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MethodSpecContext {
-	var p = new(MethodSpecContext)
+func NewTemplateSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TemplateSpecContext {
+	var p = new(TemplateSpecContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_methodSpec
+	p.RuleIndex = OgParserRULE_templateSpec
 
 	return p
 }
 
-func (s *MethodSpecContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *MethodSpecContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(OgParserIDENTIFIER, 0)
-}
-
-func (s *MethodSpecContext) Parameters() IParametersContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParametersContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IParametersContext)
-}
+func (s *TemplateSpecContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *MethodSpecContext) Result() IResultContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResultContext)(nil)).Elem(), 0)
+func (s *TemplateSpecContext) AllTemplateParam() []ITemplateParamContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITemplateParamContext)(nil)).Elem())
+	var tst = make([]ITemplateParamContext, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITemplateParamContext)
+		}
 	}
 
-	return t.(IResultContext)
+	return tst
 }
 
-func (s *MethodSpecContext) TypeName() ITypeNameContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITypeNameContext)(nil)).Elem(), 0)
+func (s *TemplateSpecContext) TemplateParam(i int) ITemplateParamContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITemplateParamContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITypeNameContext)
+	return t.(ITemplateParamContext)
 }
 
-func (s *MethodSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *TemplateSpecContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *MethodSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TemplateSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *MethodSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TemplateSpecContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterMethodSpec(s)
+		listenerT.EnterTemplateSpec(s)
 	}
 }
 
-func (s *MethodSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TemplateSpecContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitMethodSpec(s)
+		listenerT.ExitTemplateSpec(s)
 	}
 }
 
-func (s *MethodSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TemplateSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case MethodSpecVisitor:
-		return t.VisitMethodSpec(s, delegate)
+	case TemplateSpecVisitor:
+		return t.VisitTemplateSpec(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) MethodSpec() (localctx IMethodSpecContext) {
-	localctx = NewMethodSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, OgParserRULE_methodSpec)
+func (p *OgParser) TemplateSpec() (localctx ITemplateSpecContext) {
+	localctx = NewTemplateSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 156, OgParserRULE_templateSpec)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -10882,136 +12896,132 @@ func (p *OgParser) MethodSpec() (localctx IMethodSpecContext) {
 		}
 	}()
 
-	p.SetState(716)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(843)
+		p.Match(OgParserT__50)
+	}
+	{
+		p.SetState(844)
+		p.TemplateParam()
+	}
+	p.SetState(849)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 65, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		p.SetState(707)
-
-		if !(p.noTerminatorAfterParams(2)) {
-			panic(antlr.NewFailedPredicateException(p, "p.noTerminatorAfterParams(2)", ""))
-		}
-		{
-			p.SetState(708)
-			p.Match(OgParserIDENTIFIER)
-		}
-		{
-			p.SetState(709)
-			p.Parameters()
-		}
-		{
-			p.SetState(710)
-			p.Match(OgParserT__7)
-		}
-		{
-			p.SetState(711)
-			p.Result()
-		}
-
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(713)
-			p.TypeName()
-		}
+	_la = p.GetTokenStream().LA(1)
 
-	case 3:
-		p.EnterOuterAlt(localctx, 3)
+	for _la == OgParserT__11 {
 		{
-			p.SetState(714)
-			p.Match(OgParserIDENTIFIER)
+			p.SetState(845)
+			p.Match(OgParserT__11)
 		}
 		{
-			p.SetState(715)
-			p.Parameters()
+			p.SetState(846)
+			p.TemplateParam()
 		}
 
+		p.SetState(851)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(852)
+		p.Match(OgParserT__51)
 	}
 
 	return localctx
 }
 
-// IFunctionTypeContext is an interface to support dynamic dispatch.
-type IFunctionTypeContext interface {
+// ITemplateParamContext is an interface to support dynamic dispatch.
+type ITemplateParamContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsFunctionTypeContext differentiates from other interfaces.
-	IsFunctionTypeContext()
+	// IsTemplateParamContext differentiates from other interfaces.
+	IsTemplateParamContext()
 }
 
-type FunctionTypeContext struct {
+type TemplateParamContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyFunctionTypeContext() *FunctionTypeContext {
-	var p = new(FunctionTypeContext)
+func NewEmptyTemplateParamContext() *TemplateParamContext {
+	var p = new(TemplateParamContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_functionType
+	p.RuleIndex = OgParserRULE_templateParam
 	return p
 }
 
-func (*FunctionTypeContext) IsFunctionTypeContext() {}
+func (*TemplateParamContext) IsTemplateParamContext() {}
 
-func NewFunctionTypeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FunctionTypeContext {
-	var p = new(FunctionTypeContext)
+func NewTemplateParamContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TemplateParamContext {
+	var p = new(TemplateParamContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_functionType
+	p.RuleIndex = OgParserRULE_templateParam
 
 	return p
 }
 
-func (s *FunctionTypeContext) GetParser() antlr.Parser { return s.parser }
+func (s *TemplateParamContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *FunctionTypeContext) Signature() ISignatureContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignatureContext)(nil)).Elem(), 0)
+func (s *TemplateParamContext) Type_() IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ISignatureContext)
+	return t.(IType_Context)
 }
 
-func (s *FunctionTypeContext) GetRuleContext() antlr.RuleContext {
+func (s *TemplateParamContext) Constraint() IConstraintContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstraintContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IConstraintContext)
+}
+
+func (s *TemplateParamContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *FunctionTypeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TemplateParamContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *FunctionTypeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TemplateParamContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterFunctionType(s)
+		listenerT.EnterTemplateParam(s)
 	}
 }
 
-func (s *FunctionTypeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TemplateParamContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitFunctionType(s)
+		listenerT.ExitTemplateParam(s)
 	}
 }
 
-func (s *FunctionTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *TemplateParamContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case FunctionTypeVisitor:
-		return t.VisitFunctionType(s, delegate)
+	case TemplateParamVisitor:
+		return t.VisitTemplateParam(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) FunctionType() (localctx IFunctionTypeContext) {
-	localctx = NewFunctionTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, OgParserRULE_functionType)
+func (p *OgParser) TemplateParam() (localctx ITemplateParamContext) {
+	localctx = NewTemplateParamContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 158, OgParserRULE_templateParam)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -11031,117 +13041,121 @@ func (p *OgParser) FunctionType() (localctx IFunctionTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(718)
-		p.Match(OgParserT__52)
+		p.SetState(854)
+		p.Type_()
 	}
-	{
-		p.SetState(719)
-		p.Signature()
+	p.SetState(857)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == OgParserCOLON {
+		{
+			p.SetState(855)
+			p.Match(OgParserCOLON)
+		}
+		{
+			p.SetState(856)
+			p.Constraint()
+		}
+
 	}
 
 	return localctx
 }
 
-// ISignatureContext is an interface to support dynamic dispatch.
-type ISignatureContext interface {
+// IConstraintContext is an interface to support dynamic dispatch.
+type IConstraintContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsSignatureContext differentiates from other interfaces.
-	IsSignatureContext()
+	// IsConstraintContext differentiates from other interfaces.
+	IsConstraintContext()
 }
 
-type SignatureContext struct {
+type ConstraintContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptySignatureContext() *SignatureContext {
-	var p = new(SignatureContext)
+func NewEmptyConstraintContext() *ConstraintContext {
+	var p = new(ConstraintContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_signature
+	p.RuleIndex = OgParserRULE_constraint
 	return p
 }
 
-func (*SignatureContext) IsSignatureContext() {}
+func (*ConstraintContext) IsConstraintContext() {}
 
-func NewSignatureContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SignatureContext {
-	var p = new(SignatureContext)
+func NewConstraintContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstraintContext {
+	var p = new(ConstraintContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_signature
+	p.RuleIndex = OgParserRULE_constraint
 
 	return p
 }
 
-func (s *SignatureContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *SignatureContext) Parameters() IParametersContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IParametersContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IParametersContext)
-}
+func (s *ConstraintContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *SignatureContext) Result() IResultContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResultContext)(nil)).Elem(), 0)
+func (s *ConstraintContext) AllConstraintTerm() []IConstraintTermContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IConstraintTermContext)(nil)).Elem())
+	var tst = make([]IConstraintTermContext, len(ts))
 
-	if t == nil {
-		return nil
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IConstraintTermContext)
+		}
 	}
 
-	return t.(IResultContext)
+	return tst
 }
 
-func (s *SignatureContext) TemplateSpec() ITemplateSpecContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITemplateSpecContext)(nil)).Elem(), 0)
+func (s *ConstraintContext) ConstraintTerm(i int) IConstraintTermContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IConstraintTermContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITemplateSpecContext)
+	return t.(IConstraintTermContext)
 }
 
-func (s *SignatureContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstraintContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *SignatureContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstraintContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *SignatureContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstraintContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterSignature(s)
+		listenerT.EnterConstraint(s)
 	}
 }
 
-func (s *SignatureContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstraintContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitSignature(s)
+		listenerT.ExitConstraint(s)
 	}
 }
 
-func (s *SignatureContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
-	switch t := delegate.(type) {
-	case SignatureVisitor:
-		return t.VisitSignature(s, delegate)
+func (s *ConstraintContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+	switch t := delegate.(type) {
+	case ConstraintVisitor:
+		return t.VisitConstraint(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) Signature() (localctx ISignatureContext) {
-	localctx = NewSignatureContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 140, OgParserRULE_signature)
+func (p *OgParser) Constraint() (localctx IConstraintContext) {
+	localctx = NewConstraintContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 160, OgParserRULE_constraint)
 	var _la int
 
 	defer func() {
@@ -11160,142 +13174,114 @@ func (p *OgParser) Signature() (localctx ISignatureContext) {
 		}
 	}()
 
-	p.SetState(733)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(859)
+		p.ConstraintTerm()
+	}
+	p.SetState(864)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		p.SetState(721)
-
-		if !(p.noTerminatorAfterParams(1)) {
-			panic(antlr.NewFailedPredicateException(p, "p.noTerminatorAfterParams(1)", ""))
-		}
-		p.SetState(723)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		if _la == OgParserT__53 {
-			{
-				p.SetState(722)
-				p.TemplateSpec()
-			}
+	_la = p.GetTokenStream().LA(1)
 
-		}
-		{
-			p.SetState(725)
-			p.Parameters()
-		}
+	for _la == OgParserT__21 {
 		{
-			p.SetState(726)
-			p.Match(OgParserT__7)
+			p.SetState(860)
+			p.Match(OgParserT__21)
 		}
 		{
-			p.SetState(727)
-			p.Result()
+			p.SetState(861)
+			p.ConstraintTerm()
 		}
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		p.SetState(730)
+		p.SetState(866)
 		p.GetErrorHandler().Sync(p)
-
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
-			{
-				p.SetState(729)
-				p.TemplateSpec()
-			}
-
-		}
-		{
-			p.SetState(732)
-			p.Parameters()
-		}
-
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
 }
 
-// ITemplateSpecContext is an interface to support dynamic dispatch.
-type ITemplateSpecContext interface {
+// IConstraintTermContext is an interface to support dynamic dispatch.
+type IConstraintTermContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTemplateSpecContext differentiates from other interfaces.
-	IsTemplateSpecContext()
+	// IsConstraintTermContext differentiates from other interfaces.
+	IsConstraintTermContext()
 }
 
-type TemplateSpecContext struct {
+type ConstraintTermContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTemplateSpecContext() *TemplateSpecContext {
-	var p = new(TemplateSpecContext)
+func NewEmptyConstraintTermContext() *ConstraintTermContext {
+	var p = new(ConstraintTermContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = OgParserRULE_templateSpec
+	p.RuleIndex = OgParserRULE_constraintTerm
 	return p
 }
 
-func (*TemplateSpecContext) IsTemplateSpecContext() {}
+func (*ConstraintTermContext) IsConstraintTermContext() {}
 
-func NewTemplateSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TemplateSpecContext {
-	var p = new(TemplateSpecContext)
+func NewConstraintTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ConstraintTermContext {
+	var p = new(ConstraintTermContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = OgParserRULE_templateSpec
+	p.RuleIndex = OgParserRULE_constraintTerm
 
 	return p
 }
 
-func (s *TemplateSpecContext) GetParser() antlr.Parser { return s.parser }
+func (s *ConstraintTermContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TemplateSpecContext) Result() IResultContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IResultContext)(nil)).Elem(), 0)
+func (s *ConstraintTermContext) Type_() IType_Context {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IType_Context)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IResultContext)
+	return t.(IType_Context)
 }
 
-func (s *TemplateSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *ConstraintTermContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TemplateSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ConstraintTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TemplateSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ConstraintTermContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.EnterTemplateSpec(s)
+		listenerT.EnterConstraintTerm(s)
 	}
 }
 
-func (s *TemplateSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ConstraintTermContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(OgListener); ok {
-		listenerT.ExitTemplateSpec(s)
+		listenerT.ExitConstraintTerm(s)
 	}
 }
 
-func (s *TemplateSpecContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
+func (s *ConstraintTermContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 	switch t := delegate.(type) {
-	case TemplateSpecVisitor:
-		return t.VisitTemplateSpec(s, delegate)
+	case ConstraintTermVisitor:
+		return t.VisitConstraintTerm(s, delegate)
 	default:
 		return delegate.VisitChildren(s, delegate)
 	}
 }
 
-func (p *OgParser) TemplateSpec() (localctx ITemplateSpecContext) {
-	localctx = NewTemplateSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 142, OgParserRULE_templateSpec)
+func (p *OgParser) ConstraintTerm() (localctx IConstraintTermContext) {
+	localctx = NewConstraintTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 162, OgParserRULE_constraintTerm)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -11314,17 +13300,20 @@ func (p *OgParser) TemplateSpec() (localctx ITemplateSpecContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(735)
-		p.Match(OgParserT__53)
-	}
-	{
-		p.SetState(736)
-		p.Result()
+	p.SetState(868)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == OgParserT__30 {
+		{
+			p.SetState(867)
+			p.Match(OgParserT__30)
+		}
+
 	}
 	{
-		p.SetState(737)
-		p.Match(OgParserT__54)
+		p.SetState(870)
+		p.Type_()
 	}
 
 	return localctx
@@ -11422,7 +13411,7 @@ func (s *ResultContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Result() (localctx IResultContext) {
 	localctx = NewResultContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, OgParserRULE_result)
+	p.EnterRule(localctx, 164, OgParserRULE_result)
 
 	defer func() {
 		p.ExitRule()
@@ -11444,28 +13433,28 @@ func (p *OgParser) Result() (localctx IResultContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(739)
+		p.SetState(872)
 		p.Type_()
 	}
-	p.SetState(744)
+	p.SetState(877)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(740)
-				p.Match(OgParserT__12)
+				p.SetState(873)
+				p.Match(OgParserT__11)
 			}
 			{
-				p.SetState(741)
+				p.SetState(874)
 				p.Type_()
 			}
 
 		}
-		p.SetState(746)
+		p.SetState(879)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 69, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11550,7 +13539,7 @@ func (s *ParametersContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) Parameters() (localctx IParametersContext) {
 	localctx = NewParametersContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, OgParserRULE_parameters)
+	p.EnterRule(localctx, 166, OgParserRULE_parameters)
 	var _la int
 
 	defer func() {
@@ -11570,38 +13559,38 @@ func (p *OgParser) Parameters() (localctx IParametersContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(755)
+	p.SetState(888)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(747)
+			p.SetState(880)
 			p.Match(OgParserT__3)
 		}
-		p.SetState(752)
+		p.SetState(885)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__3)|(1<<OgParserT__11)|(1<<OgParserT__15)|(1<<OgParserT__17))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__55-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__52-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserBLANK-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) {
 			{
-				p.SetState(748)
+				p.SetState(881)
 				p.ParameterList()
 			}
-			p.SetState(750)
+			p.SetState(883)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
-			if _la == OgParserT__12 {
+			if _la == OgParserT__11 {
 				{
-					p.SetState(749)
-					p.Match(OgParserT__12)
+					p.SetState(882)
+					p.Match(OgParserT__11)
 				}
 
 			}
 
 		}
 		{
-			p.SetState(754)
+			p.SetState(887)
 			p.Match(OgParserT__4)
 		}
 
@@ -11702,7 +13691,7 @@ func (s *ParameterListContext) Accept(delegate antlr.ParseTreeVisitor) interface
 
 func (p *OgParser) ParameterList() (localctx IParameterListContext) {
 	localctx = NewParameterListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, OgParserRULE_parameterList)
+	p.EnterRule(localctx, 168, OgParserRULE_parameterList)
 
 	defer func() {
 		p.ExitRule()
@@ -11724,28 +13713,28 @@ func (p *OgParser) ParameterList() (localctx IParameterListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(757)
+		p.SetState(890)
 		p.ParameterDecl()
 	}
-	p.SetState(762)
+	p.SetState(895)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(758)
-				p.Match(OgParserT__12)
+				p.SetState(891)
+				p.Match(OgParserT__11)
 			}
 			{
-				p.SetState(759)
+				p.SetState(892)
 				p.ParameterDecl()
 			}
 
 		}
-		p.SetState(764)
+		p.SetState(897)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -11850,7 +13839,7 @@ func (s *ParameterDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface
 
 func (p *OgParser) ParameterDecl() (localctx IParameterDeclContext) {
 	localctx = NewParameterDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, OgParserRULE_parameterDecl)
+	p.EnterRule(localctx, 170, OgParserRULE_parameterDecl)
 	var _la int
 
 	defer func() {
@@ -11870,29 +13859,29 @@ func (p *OgParser) ParameterDecl() (localctx IParameterDeclContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(766)
+	p.SetState(899)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 89, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(765)
+			p.SetState(898)
 			p.IdentifierList()
 		}
 
 	}
-	p.SetState(769)
+	p.SetState(902)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == OgParserT__55 {
+	if _la == OgParserT__52 {
 		{
-			p.SetState(768)
+			p.SetState(901)
 			p.RestOp()
 		}
 
 	}
 	{
-		p.SetState(771)
+		p.SetState(904)
 		p.Type_()
 	}
 
@@ -11967,7 +13956,7 @@ func (s *RestOpContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) RestOp() (localctx IRestOpContext) {
 	localctx = NewRestOpContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, OgParserRULE_restOp)
+	p.EnterRule(localctx, 172, OgParserRULE_restOp)
 
 	defer func() {
 		p.ExitRule()
@@ -11987,8 +13976,8 @@ func (p *OgParser) RestOp() (localctx IRestOpContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(773)
-		p.Match(OgParserT__55)
+		p.SetState(906)
+		p.Match(OgParserT__52)
 	}
 
 	return localctx
@@ -12103,7 +14092,7 @@ func (s *OperandContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Operand() (localctx IOperandContext) {
 	localctx = NewOperandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, OgParserRULE_operand)
+	p.EnterRule(localctx, 174, OgParserRULE_operand)
 
 	defer func() {
 		p.ExitRule()
@@ -12121,42 +14110,42 @@ func (p *OgParser) Operand() (localctx IOperandContext) {
 		}
 	}()
 
-	p.SetState(782)
+	p.SetState(915)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(775)
+			p.SetState(908)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(776)
+			p.SetState(909)
 			p.OperandName()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(777)
+			p.SetState(910)
 			p.MethodExpr()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(778)
+			p.SetState(911)
 			p.Match(OgParserT__3)
 		}
 		{
-			p.SetState(779)
+			p.SetState(912)
 			p.expression(0)
 		}
 		{
-			p.SetState(780)
+			p.SetState(913)
 			p.Match(OgParserT__4)
 		}
 
@@ -12264,7 +14253,7 @@ func (s *LiteralContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Literal() (localctx ILiteralContext) {
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, OgParserRULE_literal)
+	p.EnterRule(localctx, 176, OgParserRULE_literal)
 
 	defer func() {
 		p.ExitRule()
@@ -12282,28 +14271,28 @@ func (p *OgParser) Literal() (localctx ILiteralContext) {
 		}
 	}()
 
-	p.SetState(787)
+	p.SetState(920)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case OgParserT__56, OgParserT__57, OgParserT__58, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
+	case OgParserT__53, OgParserT__54, OgParserT__55, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(784)
+			p.SetState(917)
 			p.BasicLit()
 		}
 
-	case OgParserT__47, OgParserT__50, OgParserT__59, OgParserT__60, OgParserT__61, OgParserIDENTIFIER:
+	case OgParserT__44, OgParserT__47, OgParserT__56, OgParserT__57, OgParserSTRUCT, OgParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(785)
+			p.SetState(918)
 			p.CompositeLit()
 		}
 
-	case OgParserT__52:
+	case OgParserT__49:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(786)
+			p.SetState(919)
 			p.FunctionLit()
 		}
 
@@ -12403,7 +14392,7 @@ func (s *BasicLitContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) BasicLit() (localctx IBasicLitContext) {
 	localctx = NewBasicLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, OgParserRULE_basicLit)
+	p.EnterRule(localctx, 178, OgParserRULE_basicLit)
 	var _la int
 
 	defer func() {
@@ -12422,62 +14411,62 @@ func (p *OgParser) BasicLit() (localctx IBasicLitContext) {
 		}
 	}()
 
-	p.SetState(796)
+	p.SetState(929)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case OgParserINT_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(789)
+			p.SetState(922)
 			p.Match(OgParserINT_LIT)
 		}
 
 	case OgParserFLOAT_LIT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(790)
+			p.SetState(923)
 			p.Match(OgParserFLOAT_LIT)
 		}
 
 	case OgParserIMAGINARY_LIT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(791)
+			p.SetState(924)
 			p.Match(OgParserIMAGINARY_LIT)
 		}
 
 	case OgParserRUNE_LIT:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(792)
+			p.SetState(925)
 			p.Match(OgParserRUNE_LIT)
 		}
 
 	case OgParserSTRING_LIT:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(793)
+			p.SetState(926)
 			p.Match(OgParserSTRING_LIT)
 		}
 
-	case OgParserT__56, OgParserT__57:
+	case OgParserT__53, OgParserT__54:
 		p.EnterOuterAlt(localctx, 6)
-		p.SetState(794)
+		p.SetState(927)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == OgParserT__56 || _la == OgParserT__57) {
+		if !(_la == OgParserT__53 || _la == OgParserT__54) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
 			p.Consume()
 		}
 
-	case OgParserT__58:
+	case OgParserT__55:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(795)
-			p.Match(OgParserT__58)
+			p.SetState(928)
+			p.Match(OgParserT__55)
 		}
 
 	default:
@@ -12580,7 +14569,7 @@ func (s *OperandNameContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) OperandName() (localctx IOperandNameContext) {
 	localctx = NewOperandNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, OgParserRULE_operandName)
+	p.EnterRule(localctx, 180, OgParserRULE_operandName)
 
 	defer func() {
 		p.ExitRule()
@@ -12598,27 +14587,27 @@ func (p *OgParser) OperandName() (localctx IOperandNameContext) {
 		}
 	}()
 
-	p.SetState(801)
+	p.SetState(934)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(798)
+			p.SetState(931)
 			p.Match(OgParserIDENTIFIER)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(799)
+			p.SetState(932)
 			p.QualifiedIdent()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(800)
+			p.SetState(933)
 			p.This_()
 		}
 
@@ -12695,7 +14684,7 @@ func (s *This_Context) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) This_() (localctx IThis_Context) {
 	localctx = NewThis_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, OgParserRULE_this_)
+	p.EnterRule(localctx, 182, OgParserRULE_this_)
 
 	defer func() {
 		p.ExitRule()
@@ -12715,8 +14704,8 @@ func (p *OgParser) This_() (localctx IThis_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(803)
-		p.Match(OgParserT__59)
+		p.SetState(936)
+		p.Match(OgParserT__56)
 	}
 
 	return localctx
@@ -12809,7 +14798,7 @@ func (s *QualifiedIdentContext) Accept(delegate antlr.ParseTreeVisitor) interfac
 
 func (p *OgParser) QualifiedIdent() (localctx IQualifiedIdentContext) {
 	localctx = NewQualifiedIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, OgParserRULE_qualifiedIdent)
+	p.EnterRule(localctx, 184, OgParserRULE_qualifiedIdent)
 
 	defer func() {
 		p.ExitRule()
@@ -12827,33 +14816,33 @@ func (p *OgParser) QualifiedIdent() (localctx IQualifiedIdentContext) {
 		}
 	}()
 
-	p.SetState(811)
+	p.SetState(944)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case OgParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(805)
+			p.SetState(938)
 			p.Match(OgParserIDENTIFIER)
 		}
 		{
-			p.SetState(806)
-			p.Match(OgParserT__8)
+			p.SetState(939)
+			p.Match(OgParserT__7)
 		}
 		{
-			p.SetState(807)
+			p.SetState(940)
 			p.Match(OgParserIDENTIFIER)
 		}
 
-	case OgParserT__59:
+	case OgParserT__56:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(808)
+			p.SetState(941)
 			p.This_()
 		}
 		{
-			p.SetState(809)
+			p.SetState(942)
 			p.Match(OgParserIDENTIFIER)
 		}
 
@@ -12963,7 +14952,7 @@ func (s *CompositeLitContext) Accept(delegate antlr.ParseTreeVisitor) interface{
 
 func (p *OgParser) CompositeLit() (localctx ICompositeLitContext) {
 	localctx = NewCompositeLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, OgParserRULE_compositeLit)
+	p.EnterRule(localctx, 186, OgParserRULE_compositeLit)
 	var _la int
 
 	defer func() {
@@ -12984,22 +14973,22 @@ func (p *OgParser) CompositeLit() (localctx ICompositeLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(813)
+		p.SetState(946)
 		p.LiteralType()
 	}
-	p.SetState(815)
+	p.SetState(948)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == OgParserT__53 {
+	if _la == OgParserT__50 {
 		{
-			p.SetState(814)
+			p.SetState(947)
 			p.TemplateSpec()
 		}
 
 	}
 	{
-		p.SetState(817)
+		p.SetState(950)
 		p.LiteralValue()
 	}
 
@@ -13135,7 +15124,7 @@ func (s *LiteralTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) LiteralType() (localctx ILiteralTypeContext) {
 	localctx = NewLiteralTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, OgParserRULE_literalType)
+	p.EnterRule(localctx, 188, OgParserRULE_literalType)
 
 	defer func() {
 		p.ExitRule()
@@ -13153,60 +15142,60 @@ func (p *OgParser) LiteralType() (localctx ILiteralTypeContext) {
 		}
 	}()
 
-	p.SetState(828)
+	p.SetState(961)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 97, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(819)
+			p.SetState(952)
 			p.StructType()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(820)
+			p.SetState(953)
 			p.ArrayType()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(821)
-			p.Match(OgParserT__47)
+			p.SetState(954)
+			p.Match(OgParserT__44)
 		}
 		{
-			p.SetState(822)
-			p.Match(OgParserT__55)
+			p.SetState(955)
+			p.Match(OgParserT__52)
 		}
 		{
-			p.SetState(823)
-			p.Match(OgParserT__48)
+			p.SetState(956)
+			p.Match(OgParserT__45)
 		}
 		{
-			p.SetState(824)
+			p.SetState(957)
 			p.ElementType()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(825)
+			p.SetState(958)
 			p.SliceType()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(826)
+			p.SetState(959)
 			p.MapType()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(827)
+			p.SetState(960)
 			p.TypeName()
 		}
 
@@ -13253,6 +15242,14 @@ func NewLiteralValueContext(parser antlr.Parser, parent antlr.ParserRuleContext,
 
 func (s *LiteralValueContext) GetParser() antlr.Parser { return s.parser }
 
+func (s *LiteralValueContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *LiteralValueContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
 func (s *LiteralValueContext) ElementList() IElementListContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IElementListContext)(nil)).Elem(), 0)
 
@@ -13294,7 +15291,7 @@ func (s *LiteralValueContext) Accept(delegate antlr.ParseTreeVisitor) interface{
 
 func (p *OgParser) LiteralValue() (localctx ILiteralValueContext) {
 	localctx = NewLiteralValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, OgParserRULE_literalValue)
+	p.EnterRule(localctx, 190, OgParserRULE_literalValue)
 	var _la int
 
 	defer func() {
@@ -13314,35 +15311,45 @@ func (p *OgParser) LiteralValue() (localctx ILiteralValueContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(830)
-		p.Match(OgParserT__5)
+	p.SetState(963)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
-	p.SetState(835)
+	p.SetState(968)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__5)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__5)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77))|(1<<(OgParserINDENT-77)))) != 0) {
 		{
-			p.SetState(831)
+			p.SetState(964)
 			p.ElementList()
 		}
-		p.SetState(833)
+		p.SetState(966)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == OgParserT__12 {
+		if _la == OgParserT__11 {
 			{
-				p.SetState(832)
-				p.Match(OgParserT__12)
+				p.SetState(965)
+				p.Match(OgParserT__11)
 			}
 
 		}
 
 	}
-	{
-		p.SetState(837)
-		p.Match(OgParserT__6)
+	p.SetState(970)
+	_la = p.GetTokenStream().LA(1)
+
+	if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+		p.GetErrorHandler().RecoverInline(p)
+	} else {
+		p.GetErrorHandler().ReportMatch(p)
+		p.Consume()
 	}
 
 	return localctx
@@ -13440,7 +15447,7 @@ func (s *ElementListContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) ElementList() (localctx IElementListContext) {
 	localctx = NewElementListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, OgParserRULE_elementList)
+	p.EnterRule(localctx, 192, OgParserRULE_elementList)
 	var _la int
 
 	defer func() {
@@ -13463,35 +15470,35 @@ func (p *OgParser) ElementList() (localctx IElementListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(839)
+		p.SetState(972)
 		p.KeyedElement()
 	}
-	p.SetState(846)
+	p.SetState(979)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 86, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
-			p.SetState(841)
+			p.SetState(974)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 
-			if _la == OgParserT__12 {
+			if _la == OgParserT__11 {
 				{
-					p.SetState(840)
-					p.Match(OgParserT__12)
+					p.SetState(973)
+					p.Match(OgParserT__11)
 				}
 
 			}
 			{
-				p.SetState(843)
+				p.SetState(976)
 				p.KeyedElement()
 			}
 
 		}
-		p.SetState(848)
+		p.SetState(981)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 86, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13586,7 +15593,7 @@ func (s *KeyedElementContext) Accept(delegate antlr.ParseTreeVisitor) interface{
 
 func (p *OgParser) KeyedElement() (localctx IKeyedElementContext) {
 	localctx = NewKeyedElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, OgParserRULE_keyedElement)
+	p.EnterRule(localctx, 194, OgParserRULE_keyedElement)
 
 	defer func() {
 		p.ExitRule()
@@ -13605,22 +15612,22 @@ func (p *OgParser) KeyedElement() (localctx IKeyedElementContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(852)
+	p.SetState(985)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 102, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(849)
+			p.SetState(982)
 			p.Key()
 		}
 		{
-			p.SetState(850)
-			p.Match(OgParserT__7)
+			p.SetState(983)
+			p.Match(OgParserCOLON)
 		}
 
 	}
 	{
-		p.SetState(854)
+		p.SetState(987)
 		p.Element()
 	}
 
@@ -13720,7 +15727,7 @@ func (s *KeyContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Key() (localctx IKeyContext) {
 	localctx = NewKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, OgParserRULE_key)
+	p.EnterRule(localctx, 196, OgParserRULE_key)
 
 	defer func() {
 		p.ExitRule()
@@ -13738,27 +15745,27 @@ func (p *OgParser) Key() (localctx IKeyContext) {
 		}
 	}()
 
-	p.SetState(859)
+	p.SetState(992)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(856)
+			p.SetState(989)
 			p.Match(OgParserIDENTIFIER)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(857)
+			p.SetState(990)
 			p.expression(0)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(858)
+			p.SetState(991)
 			p.LiteralValue()
 		}
 
@@ -13856,7 +15863,7 @@ func (s *ElementContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Element() (localctx IElementContext) {
 	localctx = NewElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, OgParserRULE_element)
+	p.EnterRule(localctx, 198, OgParserRULE_element)
 
 	defer func() {
 		p.ExitRule()
@@ -13874,21 +15881,21 @@ func (p *OgParser) Element() (localctx IElementContext) {
 		}
 	}()
 
-	p.SetState(863)
+	p.SetState(996)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case OgParserT__1, OgParserT__3, OgParserT__15, OgParserT__17, OgParserT__20, OgParserT__21, OgParserT__23, OgParserT__28, OgParserT__47, OgParserT__49, OgParserT__50, OgParserT__51, OgParserT__52, OgParserT__56, OgParserT__57, OgParserT__58, OgParserT__59, OgParserT__60, OgParserT__61, OgParserIDENTIFIER, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
+	case OgParserT__1, OgParserT__3, OgParserT__14, OgParserT__16, OgParserT__19, OgParserT__20, OgParserT__22, OgParserT__27, OgParserT__44, OgParserT__46, OgParserT__47, OgParserT__48, OgParserT__49, OgParserT__53, OgParserT__54, OgParserT__55, OgParserT__56, OgParserT__57, OgParserSTRUCT, OgParserIDENTIFIER, OgParserINT_LIT, OgParserFLOAT_LIT, OgParserIMAGINARY_LIT, OgParserRUNE_LIT, OgParserSTRING_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(861)
+			p.SetState(994)
 			p.expression(0)
 		}
 
-	case OgParserT__5:
+	case OgParserT__5, OgParserINDENT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(862)
+			p.SetState(995)
 			p.LiteralValue()
 		}
 
@@ -13951,6 +15958,14 @@ func (s *StructTypeContext) TemplateSpec() ITemplateSpecContext {
 	return t.(ITemplateSpecContext)
 }
 
+func (s *StructTypeContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserINDENT, 0)
+}
+
+func (s *StructTypeContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(OgParserDEDENT, 0)
+}
+
 func (s *StructTypeContext) AllFieldDecl() []IFieldDeclContext {
 	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IFieldDeclContext)(nil)).Elem())
 	var tst = make([]IFieldDeclContext, len(ts))
@@ -14028,7 +16043,7 @@ func (s *StructTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) StructType() (localctx IStructTypeContext) {
 	localctx = NewStructTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, OgParserRULE_structType)
+	p.EnterRule(localctx, 200, OgParserRULE_structType)
 	var _la int
 
 	defer func() {
@@ -14050,66 +16065,76 @@ func (p *OgParser) StructType() (localctx IStructTypeContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(865)
+	p.SetState(998)
 	_la = p.GetTokenStream().LA(1)
 
-	if !(_la == OgParserT__60 || _la == OgParserT__61) {
+	if !(_la == OgParserT__57 || _la == OgParserSTRUCT) {
 		p.GetErrorHandler().RecoverInline(p)
 	} else {
 		p.GetErrorHandler().ReportMatch(p)
 		p.Consume()
 	}
-	p.SetState(867)
+	p.SetState(1000)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(866)
+			p.SetState(999)
 			p.Match(OgParserIDENTIFIER)
 		}
 
 	}
-	p.SetState(870)
+	p.SetState(1003)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(869)
+			p.SetState(1002)
 			p.TemplateSpec()
 		}
 
 	}
-	p.SetState(882)
+	p.SetState(1015)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext()) == 1 {
-		{
-			p.SetState(872)
-			p.Match(OgParserT__5)
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 108, p.GetParserRuleContext()) == 1 {
+		p.SetState(1005)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__5 || _la == OgParserINDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
-		p.SetState(878)
+		p.SetState(1011)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 107, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(873)
+					p.SetState(1006)
 					p.FieldDecl()
 				}
 				{
-					p.SetState(874)
+					p.SetState(1007)
 					p.Eos()
 				}
 
 			}
-			p.SetState(880)
+			p.SetState(1013)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 107, p.GetParserRuleContext())
 		}
-		{
-			p.SetState(881)
-			p.Match(OgParserT__6)
+		p.SetState(1014)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == OgParserT__6 || _la == OgParserDEDENT) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
 		}
 
 	}
@@ -14230,7 +16255,7 @@ func (s *FieldDeclContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) FieldDecl() (localctx IFieldDeclContext) {
 	localctx = NewFieldDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, OgParserRULE_fieldDecl)
+	p.EnterRule(localctx, 202, OgParserRULE_fieldDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -14248,42 +16273,42 @@ func (p *OgParser) FieldDecl() (localctx IFieldDeclContext) {
 		}
 	}()
 
-	p.SetState(895)
+	p.SetState(1028)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 96, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 111, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
-		p.SetState(889)
+		p.SetState(1022)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 109, p.GetParserRuleContext()) {
 		case 1:
-			p.SetState(884)
+			p.SetState(1017)
 
 			if !(p.noTerminatorBetween(2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.noTerminatorBetween(2)", ""))
 			}
 			{
-				p.SetState(885)
+				p.SetState(1018)
 				p.IdentifierList()
 			}
 			{
-				p.SetState(886)
+				p.SetState(1019)
 				p.Type_()
 			}
 
 		case 2:
 			{
-				p.SetState(888)
+				p.SetState(1021)
 				p.AnonymousField()
 			}
 
 		}
-		p.SetState(892)
+		p.SetState(1025)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 95, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 110, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(891)
+				p.SetState(1024)
 				p.Match(OgParserSTRING_LIT)
 			}
 
@@ -14292,7 +16317,7 @@ func (p *OgParser) FieldDecl() (localctx IFieldDeclContext) {
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(894)
+			p.SetState(1027)
 			p.InlineStructMethod()
 		}
 
@@ -14380,7 +16405,7 @@ func (s *InlineStructMethodContext) Accept(delegate antlr.ParseTreeVisitor) inte
 
 func (p *OgParser) InlineStructMethod() (localctx IInlineStructMethodContext) {
 	localctx = NewInlineStructMethodContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, OgParserRULE_inlineStructMethod)
+	p.EnterRule(localctx, 204, OgParserRULE_inlineStructMethod)
 	var _la int
 
 	defer func() {
@@ -14400,19 +16425,19 @@ func (p *OgParser) InlineStructMethod() (localctx IInlineStructMethodContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(898)
+	p.SetState(1031)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == OgParserT__15 {
+	if _la == OgParserT__14 {
 		{
-			p.SetState(897)
-			p.Match(OgParserT__15)
+			p.SetState(1030)
+			p.Match(OgParserT__14)
 		}
 
 	}
 	{
-		p.SetState(900)
+		p.SetState(1033)
 		p.FunctionDecl()
 	}
 
@@ -14498,7 +16523,7 @@ func (s *AnonymousFieldContext) Accept(delegate antlr.ParseTreeVisitor) interfac
 
 func (p *OgParser) AnonymousField() (localctx IAnonymousFieldContext) {
 	localctx = NewAnonymousFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, OgParserRULE_anonymousField)
+	p.EnterRule(localctx, 206, OgParserRULE_anonymousField)
 	var _la int
 
 	defer func() {
@@ -14518,19 +16543,19 @@ func (p *OgParser) AnonymousField() (localctx IAnonymousFieldContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(903)
+	p.SetState(1036)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == OgParserT__15 {
+	if _la == OgParserT__14 {
 		{
-			p.SetState(902)
-			p.Match(OgParserT__15)
+			p.SetState(1035)
+			p.Match(OgParserT__14)
 		}
 
 	}
 	{
-		p.SetState(905)
+		p.SetState(1038)
 		p.TypeName()
 	}
 
@@ -14616,7 +16641,7 @@ func (s *FunctionLitContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) FunctionLit() (localctx IFunctionLitContext) {
 	localctx = NewFunctionLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, OgParserRULE_functionLit)
+	p.EnterRule(localctx, 208, OgParserRULE_functionLit)
 
 	defer func() {
 		p.ExitRule()
@@ -14636,11 +16661,11 @@ func (p *OgParser) FunctionLit() (localctx IFunctionLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(907)
-		p.Match(OgParserT__52)
+		p.SetState(1040)
+		p.Match(OgParserT__49)
 	}
 	{
-		p.SetState(908)
+		p.SetState(1041)
 		p.Function()
 	}
 
@@ -14764,8 +16789,8 @@ func (p *OgParser) primaryExpr(_p int) (localctx IPrimaryExprContext) {
 	localctx = NewPrimaryExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IPrimaryExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 190
-	p.EnterRecursionRule(localctx, 190, OgParserRULE_primaryExpr, _p)
+	_startState := 210
+	p.EnterRecursionRule(localctx, 210, OgParserRULE_primaryExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -14786,26 +16811,26 @@ func (p *OgParser) primaryExpr(_p int) (localctx IPrimaryExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(913)
+	p.SetState(1046)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 99, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(911)
+			p.SetState(1044)
 			p.Operand()
 		}
 
 	case 2:
 		{
-			p.SetState(912)
+			p.SetState(1045)
 			p.Conversion()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(919)
+	p.SetState(1052)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 100, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 115, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -14815,20 +16840,20 @@ func (p *OgParser) primaryExpr(_p int) (localctx IPrimaryExprContext) {
 			_prevctx = localctx
 			localctx = NewPrimaryExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, OgParserRULE_primaryExpr)
-			p.SetState(915)
+			p.SetState(1048)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
 			{
-				p.SetState(916)
+				p.SetState(1049)
 				p.SecondaryExpr()
 			}
 
 		}
-		p.SetState(921)
+		p.SetState(1054)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 100, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 115, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -14922,6 +16947,10 @@ func (s *SecondaryExprContext) Arguments() IArgumentsContext {
 	return t.(IArgumentsContext)
 }
 
+func (s *SecondaryExprContext) QUESTION() antlr.TerminalNode {
+	return s.GetToken(OgParserQUESTION, 0)
+}
+
 func (s *SecondaryExprContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -14953,7 +16982,7 @@ func (s *SecondaryExprContext) Accept(delegate antlr.ParseTreeVisitor) interface
 
 func (p *OgParser) SecondaryExpr() (localctx ISecondaryExprContext) {
 	localctx = NewSecondaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, OgParserRULE_secondaryExpr)
+	p.EnterRule(localctx, 212, OgParserRULE_secondaryExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -14971,44 +17000,51 @@ func (p *OgParser) SecondaryExpr() (localctx ISecondaryExprContext) {
 		}
 	}()
 
-	p.SetState(927)
+	p.SetState(1061)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 101, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(922)
+			p.SetState(1055)
 			p.Selector()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(923)
+			p.SetState(1056)
 			p.Index()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(924)
+			p.SetState(1057)
 			p.Slice()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(925)
+			p.SetState(1058)
 			p.TypeAssertion()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(926)
+			p.SetState(1059)
 			p.Arguments()
 		}
 
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(1060)
+			p.Match(OgParserQUESTION)
+		}
+
 	}
 
 	return localctx
@@ -15087,7 +17123,7 @@ func (s *SelectorContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Selector() (localctx ISelectorContext) {
 	localctx = NewSelectorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, OgParserRULE_selector)
+	p.EnterRule(localctx, 214, OgParserRULE_selector)
 
 	defer func() {
 		p.ExitRule()
@@ -15107,11 +17143,11 @@ func (p *OgParser) Selector() (localctx ISelectorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(929)
-		p.Match(OgParserT__8)
+		p.SetState(1063)
+		p.Match(OgParserT__7)
 	}
 	{
-		p.SetState(930)
+		p.SetState(1064)
 		p.Match(OgParserIDENTIFIER)
 	}
 
@@ -15197,7 +17233,7 @@ func (s *IndexContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Index() (localctx IIndexContext) {
 	localctx = NewIndexContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 196, OgParserRULE_index)
+	p.EnterRule(localctx, 216, OgParserRULE_index)
 
 	defer func() {
 		p.ExitRule()
@@ -15217,16 +17253,16 @@ func (p *OgParser) Index() (localctx IIndexContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(932)
-		p.Match(OgParserT__47)
+		p.SetState(1066)
+		p.Match(OgParserT__44)
 	}
 	{
-		p.SetState(933)
+		p.SetState(1067)
 		p.expression(0)
 	}
 	{
-		p.SetState(934)
-		p.Match(OgParserT__48)
+		p.SetState(1068)
+		p.Match(OgParserT__45)
 	}
 
 	return localctx
@@ -15324,7 +17360,7 @@ func (s *SliceContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Slice() (localctx ISliceContext) {
 	localctx = NewSliceContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 198, OgParserRULE_slice)
+	p.EnterRule(localctx, 218, OgParserRULE_slice)
 	var _la int
 
 	defer func() {
@@ -15345,73 +17381,73 @@ func (p *OgParser) Slice() (localctx ISliceContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(936)
-		p.Match(OgParserT__47)
+		p.SetState(1070)
+		p.Match(OgParserT__44)
 	}
-	p.SetState(952)
+	p.SetState(1086)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 120, p.GetParserRuleContext()) {
 	case 1:
-		p.SetState(938)
+		p.SetState(1072)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
 			{
-				p.SetState(937)
+				p.SetState(1071)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(940)
-			p.Match(OgParserT__7)
+			p.SetState(1074)
+			p.Match(OgParserCOLON)
 		}
-		p.SetState(942)
+		p.SetState(1076)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
 			{
-				p.SetState(941)
+				p.SetState(1075)
 				p.expression(0)
 			}
 
 		}
 
 	case 2:
-		p.SetState(945)
+		p.SetState(1079)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
+		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
 			{
-				p.SetState(944)
+				p.SetState(1078)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(947)
-			p.Match(OgParserT__7)
+			p.SetState(1081)
+			p.Match(OgParserCOLON)
 		}
 		{
-			p.SetState(948)
+			p.SetState(1082)
 			p.expression(0)
 		}
 		{
-			p.SetState(949)
-			p.Match(OgParserT__7)
+			p.SetState(1083)
+			p.Match(OgParserCOLON)
 		}
 		{
-			p.SetState(950)
+			p.SetState(1084)
 			p.expression(0)
 		}
 
 	}
 	{
-		p.SetState(954)
-		p.Match(OgParserT__48)
+		p.SetState(1088)
+		p.Match(OgParserT__45)
 	}
 
 	return localctx
@@ -15496,7 +17532,7 @@ func (s *TypeAssertionContext) Accept(delegate antlr.ParseTreeVisitor) interface
 
 func (p *OgParser) TypeAssertion() (localctx ITypeAssertionContext) {
 	localctx = NewTypeAssertionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 200, OgParserRULE_typeAssertion)
+	p.EnterRule(localctx, 220, OgParserRULE_typeAssertion)
 
 	defer func() {
 		p.ExitRule()
@@ -15516,19 +17552,19 @@ func (p *OgParser) TypeAssertion() (localctx ITypeAssertionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(956)
-		p.Match(OgParserT__8)
+		p.SetState(1090)
+		p.Match(OgParserT__7)
 	}
 	{
-		p.SetState(957)
+		p.SetState(1091)
 		p.Match(OgParserT__3)
 	}
 	{
-		p.SetState(958)
+		p.SetState(1092)
 		p.Type_()
 	}
 	{
-		p.SetState(959)
+		p.SetState(1093)
 		p.Match(OgParserT__4)
 	}
 
@@ -15644,7 +17680,7 @@ func (s *ArgumentsContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Arguments() (localctx IArgumentsContext) {
 	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 202, OgParserRULE_arguments)
+	p.EnterRule(localctx, 222, OgParserRULE_arguments)
 	var _la int
 
 	defer func() {
@@ -15664,82 +17700,82 @@ func (p *OgParser) Arguments() (localctx IArgumentsContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(962)
+	p.SetState(1096)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == OgParserT__53 {
+	if _la == OgParserT__50 {
 		{
-			p.SetState(961)
+			p.SetState(1095)
 			p.TemplateSpec()
 		}
 
 	}
 	{
-		p.SetState(964)
+		p.SetState(1098)
 		p.Match(OgParserT__3)
 	}
-	p.SetState(979)
+	p.SetState(1113)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) || (((_la-48)&-(0x1f+1)) == 0 && ((1<<uint((_la-48)))&((1<<(OgParserT__47-48))|(1<<(OgParserT__49-48))|(1<<(OgParserT__50-48))|(1<<(OgParserT__51-48))|(1<<(OgParserT__52-48))|(1<<(OgParserT__56-48))|(1<<(OgParserT__57-48))|(1<<(OgParserT__58-48))|(1<<(OgParserT__59-48))|(1<<(OgParserT__60-48))|(1<<(OgParserT__61-48))|(1<<(OgParserIDENTIFIER-48))|(1<<(OgParserINT_LIT-48))|(1<<(OgParserFLOAT_LIT-48))|(1<<(OgParserIMAGINARY_LIT-48))|(1<<(OgParserRUNE_LIT-48))|(1<<(OgParserSTRING_LIT-48)))) != 0) {
-		p.SetState(971)
+	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__3)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) || (((_la-45)&-(0x1f+1)) == 0 && ((1<<uint((_la-45)))&((1<<(OgParserT__44-45))|(1<<(OgParserT__46-45))|(1<<(OgParserT__47-45))|(1<<(OgParserT__48-45))|(1<<(OgParserT__49-45))|(1<<(OgParserT__53-45))|(1<<(OgParserT__54-45))|(1<<(OgParserT__55-45))|(1<<(OgParserT__56-45))|(1<<(OgParserT__57-45))|(1<<(OgParserSTRUCT-45))|(1<<(OgParserIDENTIFIER-45)))) != 0) || (((_la-77)&-(0x1f+1)) == 0 && ((1<<uint((_la-77)))&((1<<(OgParserINT_LIT-77))|(1<<(OgParserFLOAT_LIT-77))|(1<<(OgParserIMAGINARY_LIT-77))|(1<<(OgParserRUNE_LIT-77))|(1<<(OgParserSTRING_LIT-77)))) != 0) {
+		p.SetState(1105)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 108, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(965)
+				p.SetState(1099)
 				p.ExpressionList()
 			}
 
 		case 2:
 			{
-				p.SetState(966)
+				p.SetState(1100)
 				p.Type_()
 			}
-			p.SetState(969)
+			p.SetState(1103)
 			p.GetErrorHandler().Sync(p)
 
-			if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 107, p.GetParserRuleContext()) == 1 {
+			if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 122, p.GetParserRuleContext()) == 1 {
 				{
-					p.SetState(967)
-					p.Match(OgParserT__12)
+					p.SetState(1101)
+					p.Match(OgParserT__11)
 				}
 				{
-					p.SetState(968)
+					p.SetState(1102)
 					p.ExpressionList()
 				}
 
 			}
 
 		}
-		p.SetState(974)
+		p.SetState(1108)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == OgParserT__55 {
+		if _la == OgParserT__52 {
 			{
-				p.SetState(973)
+				p.SetState(1107)
 				p.RestOp()
 			}
 
 		}
-		p.SetState(977)
+		p.SetState(1111)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if _la == OgParserT__12 {
+		if _la == OgParserT__11 {
 			{
-				p.SetState(976)
-				p.Match(OgParserT__12)
+				p.SetState(1110)
+				p.Match(OgParserT__11)
 			}
 
 		}
 
 	}
 	{
-		p.SetState(981)
+		p.SetState(1115)
 		p.Match(OgParserT__4)
 	}
 
@@ -15829,7 +17865,7 @@ func (s *MethodExprContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) MethodExpr() (localctx IMethodExprContext) {
 	localctx = NewMethodExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 204, OgParserRULE_methodExpr)
+	p.EnterRule(localctx, 224, OgParserRULE_methodExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -15849,15 +17885,15 @@ func (p *OgParser) MethodExpr() (localctx IMethodExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(983)
+		p.SetState(1117)
 		p.ReceiverType()
 	}
 	{
-		p.SetState(984)
-		p.Match(OgParserT__8)
+		p.SetState(1118)
+		p.Match(OgParserT__7)
 	}
 	{
-		p.SetState(985)
+		p.SetState(1119)
 		p.Match(OgParserIDENTIFIER)
 	}
 
@@ -15953,7 +17989,7 @@ func (s *ReceiverTypeContext) Accept(delegate antlr.ParseTreeVisitor) interface{
 
 func (p *OgParser) ReceiverType() (localctx IReceiverTypeContext) {
 	localctx = NewReceiverTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 206, OgParserRULE_receiverType)
+	p.EnterRule(localctx, 226, OgParserRULE_receiverType)
 
 	defer func() {
 		p.ExitRule()
@@ -15971,47 +18007,47 @@ func (p *OgParser) ReceiverType() (localctx IReceiverTypeContext) {
 		}
 	}()
 
-	p.SetState(997)
+	p.SetState(1131)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 112, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(987)
+			p.SetState(1121)
 			p.TypeName()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(988)
+			p.SetState(1122)
 			p.Match(OgParserT__3)
 		}
 		{
-			p.SetState(989)
-			p.Match(OgParserT__15)
+			p.SetState(1123)
+			p.Match(OgParserT__14)
 		}
 		{
-			p.SetState(990)
+			p.SetState(1124)
 			p.TypeName()
 		}
 		{
-			p.SetState(991)
+			p.SetState(1125)
 			p.Match(OgParserT__4)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(993)
+			p.SetState(1127)
 			p.Match(OgParserT__3)
 		}
 		{
-			p.SetState(994)
+			p.SetState(1128)
 			p.ReceiverType()
 		}
 		{
-			p.SetState(995)
+			p.SetState(1129)
 			p.Match(OgParserT__4)
 		}
 
@@ -16130,8 +18166,8 @@ func (p *OgParser) expression(_p int) (localctx IExpressionContext) {
 	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IExpressionContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 208
-	p.EnterRecursionRule(localctx, 208, OgParserRULE_expression, _p)
+	_startState := 228
+	p.EnterRecursionRule(localctx, 228, OgParserRULE_expression, _p)
 	var _la int
 
 	defer func() {
@@ -16154,14 +18190,14 @@ func (p *OgParser) expression(_p int) (localctx IExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1000)
+		p.SetState(1134)
 		p.UnaryExpr()
 	}
 
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(1007)
+	p.SetState(1142)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 113, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 128, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -16171,29 +18207,34 @@ func (p *OgParser) expression(_p int) (localctx IExpressionContext) {
 			_prevctx = localctx
 			localctx = NewExpressionContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, OgParserRULE_expression)
-			p.SetState(1002)
+			p.SetState(1136)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 			}
-			p.SetState(1003)
+			p.SetState(1137)
+
+			if !(p.noTerminatorBetween(1)) {
+				panic(antlr.NewFailedPredicateException(p, "p.noTerminatorBetween(1)", ""))
+			}
+			p.SetState(1138)
 			_la = p.GetTokenStream().LA(1)
 
-			if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__15)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__22)|(1<<OgParserT__23)|(1<<OgParserT__24)|(1<<OgParserT__25)|(1<<OgParserT__26)|(1<<OgParserT__27)|(1<<OgParserT__28)|(1<<OgParserT__29))) != 0) || (((_la-54)&-(0x1f+1)) == 0 && ((1<<uint((_la-54)))&((1<<(OgParserT__53-54))|(1<<(OgParserT__54-54))|(1<<(OgParserT__62-54))|(1<<(OgParserT__63-54))|(1<<(OgParserT__64-54))|(1<<(OgParserT__65-54))|(1<<(OgParserT__66-54))|(1<<(OgParserT__67-54)))) != 0)) {
+			if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__14)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__22)|(1<<OgParserT__23)|(1<<OgParserT__24)|(1<<OgParserT__25)|(1<<OgParserT__26)|(1<<OgParserT__27)|(1<<OgParserT__28))) != 0) || (((_la-51)&-(0x1f+1)) == 0 && ((1<<uint((_la-51)))&((1<<(OgParserT__50-51))|(1<<(OgParserT__51-51))|(1<<(OgParserT__58-51))|(1<<(OgParserT__59-51))|(1<<(OgParserT__60-51))|(1<<(OgParserT__61-51))|(1<<(OgParserT__62-51))|(1<<(OgParserT__63-51)))) != 0)) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
 				p.Consume()
 			}
 			{
-				p.SetState(1004)
+				p.SetState(1139)
 				p.expression(3)
 			}
 
 		}
-		p.SetState(1009)
+		p.SetState(1144)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 113, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 128, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -16288,7 +18329,7 @@ func (s *UnaryExprContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) UnaryExpr() (localctx IUnaryExprContext) {
 	localctx = NewUnaryExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 210, OgParserRULE_unaryExpr)
+	p.EnterRule(localctx, 230, OgParserRULE_unaryExpr)
 	var _la int
 
 	defer func() {
@@ -16307,29 +18348,29 @@ func (p *OgParser) UnaryExpr() (localctx IUnaryExprContext) {
 		}
 	}()
 
-	p.SetState(1013)
+	p.SetState(1148)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 129, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1010)
+			p.SetState(1145)
 			p.primaryExpr(0)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
-		p.SetState(1011)
+		p.SetState(1146)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__15)|(1<<OgParserT__17)|(1<<OgParserT__20)|(1<<OgParserT__21)|(1<<OgParserT__23)|(1<<OgParserT__28))) != 0) {
+		if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<OgParserT__1)|(1<<OgParserT__14)|(1<<OgParserT__16)|(1<<OgParserT__19)|(1<<OgParserT__20)|(1<<OgParserT__22)|(1<<OgParserT__27))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
 			p.Consume()
 		}
 		{
-			p.SetState(1012)
+			p.SetState(1147)
 			p.UnaryExpr()
 		}
 
@@ -16427,7 +18468,7 @@ func (s *ConversionContext) Accept(delegate antlr.ParseTreeVisitor) interface{}
 
 func (p *OgParser) Conversion() (localctx IConversionContext) {
 	localctx = NewConversionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 212, OgParserRULE_conversion)
+	p.EnterRule(localctx, 232, OgParserRULE_conversion)
 	var _la int
 
 	defer func() {
@@ -16448,30 +18489,30 @@ func (p *OgParser) Conversion() (localctx IConversionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(1015)
+		p.SetState(1150)
 		p.Type_()
 	}
 	{
-		p.SetState(1016)
+		p.SetState(1151)
 		p.Match(OgParserT__3)
 	}
 	{
-		p.SetState(1017)
+		p.SetState(1152)
 		p.expression(0)
 	}
-	p.SetState(1019)
+	p.SetState(1154)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == OgParserT__12 {
+	if _la == OgParserT__11 {
 		{
-			p.SetState(1018)
-			p.Match(OgParserT__12)
+			p.SetState(1153)
+			p.Match(OgParserT__11)
 		}
 
 	}
 	{
-		p.SetState(1021)
+		p.SetState(1156)
 		p.Match(OgParserT__4)
 	}
 
@@ -16551,7 +18592,7 @@ func (s *EosContext) Accept(delegate antlr.ParseTreeVisitor) interface{} {
 
 func (p *OgParser) Eos() (localctx IEosContext) {
 	localctx = NewEosContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 214, OgParserRULE_eos)
+	p.EnterRule(localctx, 234, OgParserRULE_eos)
 
 	defer func() {
 		p.ExitRule()
@@ -16569,26 +18610,26 @@ func (p *OgParser) Eos() (localctx IEosContext) {
 		}
 	}()
 
-	p.SetState(1027)
+	p.SetState(1162)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 131, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(1023)
-			p.Match(OgParserT__31)
+			p.SetState(1158)
+			p.Match(OgParserSEMI)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(1024)
+			p.SetState(1159)
 			p.Match(OgParserEOF)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
-		p.SetState(1025)
+		p.SetState(1160)
 
 		if !(p.lineTerminatorAhead()) {
 			panic(antlr.NewFailedPredicateException(p, "p.lineTerminatorAhead()", ""))
@@ -16596,7 +18637,7 @@ func (p *OgParser) Eos() (localctx IEosContext) {
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
-		p.SetState(1026)
+		p.SetState(1161)
 
 		if !(p.GetTokenStream().LT(1).GetText() == "}") {
 			panic(antlr.NewFailedPredicateException(p, "p.GetTokenStream().LT(1).GetText() == \"}\"", ""))
@@ -16609,42 +18650,49 @@ func (p *OgParser) Eos() (localctx IEosContext) {
 
 func (p *OgParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 68:
+	case 16:
+		var t *DataDeclContext = nil
+		if localctx != nil {
+			t = localctx.(*DataDeclContext)
+		}
+		return p.DataDecl_Sempred(t, predIndex)
+
+	case 75:
 		var t *MethodSpecContext = nil
 		if localctx != nil {
 			t = localctx.(*MethodSpecContext)
 		}
 		return p.MethodSpec_Sempred(t, predIndex)
 
-	case 70:
+	case 77:
 		var t *SignatureContext = nil
 		if localctx != nil {
 			t = localctx.(*SignatureContext)
 		}
 		return p.Signature_Sempred(t, predIndex)
 
-	case 91:
+	case 101:
 		var t *FieldDeclContext = nil
 		if localctx != nil {
 			t = localctx.(*FieldDeclContext)
 		}
 		return p.FieldDecl_Sempred(t, predIndex)
 
-	case 95:
+	case 105:
 		var t *PrimaryExprContext = nil
 		if localctx != nil {
 			t = localctx.(*PrimaryExprContext)
 		}
 		return p.PrimaryExpr_Sempred(t, predIndex)
 
-	case 104:
+	case 114:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
 		}
 		return p.Expression_Sempred(t, predIndex)
 
-	case 107:
+	case 117:
 		var t *EosContext = nil
 		if localctx != nil {
 			t = localctx.(*EosContext)
@@ -16656,9 +18704,19 @@ func (p *OgParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int)
 	}
 }
 
-func (p *OgParser) MethodSpec_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+func (p *OgParser) DataDecl_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
 	case 0:
+		return p.isData()
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
+
+func (p *OgParser) MethodSpec_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	switch predIndex {
+	case 1:
 		return p.noTerminatorAfterParams(2)
 
 	default:
@@ -16668,7 +18726,7 @@ func (p *OgParser) MethodSpec_Sempred(localctx antlr.RuleContext, predIndex int)
 
 func (p *OgParser) Signature_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 1:
+	case 2:
 		return p.noTerminatorAfterParams(1)
 
 	default:
@@ -16678,7 +18736,7 @@ func (p *OgParser) Signature_Sempred(localctx antlr.RuleContext, predIndex int)
 
 func (p *OgParser) FieldDecl_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 2:
+	case 3:
 		return p.noTerminatorBetween(2)
 
 	default:
@@ -16688,7 +18746,7 @@ func (p *OgParser) FieldDecl_Sempred(localctx antlr.RuleContext, predIndex int)
 
 func (p *OgParser) PrimaryExpr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 3:
+	case 4:
 		return p.Precpred(p.GetParserRuleContext(), 1)
 
 	default:
@@ -16698,9 +18756,12 @@ func (p *OgParser) PrimaryExpr_Sempred(localctx antlr.RuleContext, predIndex int
 
 func (p *OgParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 4:
+	case 5:
 		return p.Precpred(p.GetParserRuleContext(), 2)
 
+	case 6:
+		return p.noTerminatorBetween(1)
+
 	default:
 		panic("No predicate with index: " + fmt.Sprint(predIndex))
 	}
@@ -16708,10 +18769,10 @@ func (p *OgParser) Expression_Sempred(localctx antlr.RuleContext, predIndex int)
 
 func (p *OgParser) Eos_Sempred(localctx antlr.RuleContext, predIndex int) bool {
 	switch predIndex {
-	case 5:
+	case 7:
 		return p.lineTerminatorAhead()
 
-	case 6:
+	case 8:
 		return p.GetTokenStream().LT(1).GetText() == "}"
 
 	default:
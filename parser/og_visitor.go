@@ -42,6 +42,12 @@ type ConstDeclVisitor interface {
 type ConstSpecVisitor interface {
 	VisitConstSpec(ctx *ConstSpecContext, delegate antlr.ParseTreeVisitor) interface{}
 }
+type EnumDeclVisitor interface {
+	VisitEnumDecl(ctx *EnumDeclContext, delegate antlr.ParseTreeVisitor) interface{}
+}
+type EnumSpecVisitor interface {
+	VisitEnumSpec(ctx *EnumSpecContext, delegate antlr.ParseTreeVisitor) interface{}
+}
 type IdentifierListVisitor interface {
 	VisitIdentifierList(ctx *IdentifierListContext, delegate antlr.ParseTreeVisitor) interface{}
 }
@@ -51,6 +57,12 @@ type ExpressionListVisitor interface {
 type TypeDeclVisitor interface {
 	VisitTypeDecl(ctx *TypeDeclContext, delegate antlr.ParseTreeVisitor) interface{}
 }
+type DataDeclVisitor interface {
+	VisitDataDecl(ctx *DataDeclContext, delegate antlr.ParseTreeVisitor) interface{}
+}
+type VariantVisitor interface {
+	VisitVariant(ctx *VariantContext, delegate antlr.ParseTreeVisitor) interface{}
+}
 type TypeSpecVisitor interface {
 	VisitTypeSpec(ctx *TypeSpecContext, delegate antlr.ParseTreeVisitor) interface{}
 }
@@ -138,6 +150,15 @@ type ExprCaseClauseVisitor interface {
 type ExprSwitchCaseVisitor interface {
 	VisitExprSwitchCase(ctx *ExprSwitchCaseContext, delegate antlr.ParseTreeVisitor) interface{}
 }
+type MatchStmtVisitor interface {
+	VisitMatchStmt(ctx *MatchStmtContext, delegate antlr.ParseTreeVisitor) interface{}
+}
+type MatchArmVisitor interface {
+	VisitMatchArm(ctx *MatchArmContext, delegate antlr.ParseTreeVisitor) interface{}
+}
+type PatternVisitor interface {
+	VisitPattern(ctx *PatternContext, delegate antlr.ParseTreeVisitor) interface{}
+}
 type TypeSwitchStmtVisitor interface {
 	VisitTypeSwitchStmt(ctx *TypeSwitchStmtContext, delegate antlr.ParseTreeVisitor) interface{}
 }
@@ -225,6 +246,15 @@ type SignatureVisitor interface {
 type TemplateSpecVisitor interface {
 	VisitTemplateSpec(ctx *TemplateSpecContext, delegate antlr.ParseTreeVisitor) interface{}
 }
+type TemplateParamVisitor interface {
+	VisitTemplateParam(ctx *TemplateParamContext, delegate antlr.ParseTreeVisitor) interface{}
+}
+type ConstraintVisitor interface {
+	VisitConstraint(ctx *ConstraintContext, delegate antlr.ParseTreeVisitor) interface{}
+}
+type ConstraintTermVisitor interface {
+	VisitConstraintTerm(ctx *ConstraintTermContext, delegate antlr.ParseTreeVisitor) interface{}
+}
 type ResultVisitor interface {
 	VisitResult(ctx *ResultContext, delegate antlr.ParseTreeVisitor) interface{}
 }
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+)
+
+func TestCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "og_cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	source := filepath.Join(dir, "main.og")
+	ioutil.WriteFile(source, []byte("!main\n"), 0644)
+
+	// Template blob of an imported package
+	blob := filepath.Join(dir, "dep", ".og", "template")
+	os.MkdirAll(filepath.Dir(blob), 0755)
+	ioutil.WriteFile(blob, []byte("v1"), 0644)
+
+	file := common.NewFile(source, filepath.Join(dir, "main.go"))
+	file.Imports = map[string]string{"dep": filepath.Join(dir, "dep")}
+
+	config := common.NewOgConfig()
+	cache := og.LoadCache(config)
+
+	if cache.IsFresh(source) {
+		t.Fatal("An unknown file cannot be fresh")
+	}
+
+	cache.Set(file)
+
+	if !cache.IsFresh(source) {
+		t.Fatal("Expected a fresh file")
+	}
+
+	if err := cache.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer og.CleanCache()
+
+	if !og.LoadCache(config).IsFresh(source) {
+		t.Fatal("The cache has not been saved")
+	}
+
+	// The modification time is not used
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(source, later, later)
+
+	if !cache.IsFresh(source) {
+		t.Fatal("A touched file is still fresh")
+	}
+
+	ioutil.WriteFile(blob, []byte("v2"), 0644)
+
+	if cache.IsFresh(source) {
+		t.Fatal("Expected a stale file after a template change")
+	}
+
+	ioutil.WriteFile(blob, []byte("v1"), 0644)
+	ioutil.WriteFile(source, []byte("!main\n\nfoo -> 1\n"), 0644)
+
+	if cache.IsFresh(source) {
+		t.Fatal("Expected a stale file after a source change")
+	}
+
+	ioutil.WriteFile(source, []byte("!main\n"), 0644)
+
+	// Other options give another output
+	config.LineDirectives = true
+
+	if og.LoadCache(config).IsFresh(source) {
+		t.Fatal("Expected a stale file with other options")
+	}
+
+	if err := og.CleanCache(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := os.Stat(og.CachePath); !os.IsNotExist(err) {
+		t.Fatal("The cache has not been removed")
+	}
+}
+
+// A file that uses a template of its own package is compiled again when
+// the file that declares the template changes
+func TestCacheOwnTemplates(t *testing.T) {
+	dir := tempFiles(t, "og_cache", map[string]string{
+		"a.og": "!lib\n\nid<T>(x T): T -> x\n",
+		"b.og": "!lib\n\nuse: int -> id<int>(1)\n",
+	})
+
+	compile := func() string {
+		config := common.NewOgConfig()
+
+		config.Quiet = true
+		config.NoBuild = true
+		config.Paths = []string{"."}
+
+		runIn(t, dir, config, 0)
+
+		output, err := ioutil.ReadFile(filepath.Join(dir, "b.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return string(output)
+	}
+
+	if output := compile(); !strings.Contains(output, "return x\n") {
+		t.Fatal("Bad template instance:\n" + output)
+	}
+
+	ioutil.WriteFile(filepath.Join(dir, "a.og"), []byte("!lib\n\nid<T>(x T): T -> x + x\n"), 0644)
+
+	if output := compile(); !strings.Contains(output, "return x + x\n") {
+		t.Fatal("The template instance has not been compiled again:\n" + output)
+	}
+}
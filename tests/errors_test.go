@@ -0,0 +1,240 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+)
+
+type expectedError struct {
+	path   string
+	line   int
+	column int
+	msg    string
+	msg2   string
+}
+
+func compileErrors(t *testing.T, paths ...string) common.Errors {
+	return compileErrorsWith(t, common.NewOgConfig(), paths...)
+}
+
+func compileErrorsWith(t *testing.T, config *common.OgConfig, paths ...string) common.Errors {
+	config.Force = true
+	config.Quiet = true
+	config.Workers = 1
+
+	for _, p := range paths {
+		config.Paths = append(config.Paths, "./exemples/errors/"+p+".og")
+	}
+
+	common.Print = common.NewPrinter(config)
+	compiler := og.NewOgCompiler(config)
+
+	err := compiler.Compile()
+	if err == nil {
+		t.Fatal("Expected the compilation to fail")
+	}
+
+	errs, ok := err.(common.Errors)
+	if !ok {
+		t.Fatal(fmt.Sprintf("Expected common.Errors, got %T: %s", err, err))
+	}
+
+	return errs
+}
+
+func checkErrors(t *testing.T, errs common.Errors, expected []expectedError) {
+	if len(errs) != len(expected) {
+		t.Fatal(fmt.Sprint("Expected ", len(expected), " errors, got ", len(errs), ":\n", errs))
+	}
+
+	for _, exp := range expected {
+		found := false
+
+		for _, err := range errs {
+			if err.Path == "./exemples/errors/"+exp.path+".og" && err.Line == exp.line && err.Column == exp.column && err.Msg == exp.msg && err.Msg2 == exp.msg2 && err.Severity == common.SeverityError {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Fatal(fmt.Sprint("Missing error: ", exp, "\nGot:\n", errs))
+		}
+	}
+}
+
+func TestSyntaxErrors(t *testing.T) {
+	errs := compileErrors(t, "syntax", "syntax2")
+
+	checkErrors(t, errs, []expectedError{
+		{"syntax", 4, 7, "Unexpected", ")"},
+		{"syntax2", 5, 18, "Unexpected", "("},
+	})
+}
+
+func TestMultipleSyntaxErrors(t *testing.T) {
+	errs := compileErrors(t, "multi")
+
+	checkErrors(t, errs, []expectedError{
+		{"multi", 4, 7, "Unexpected", ")"},
+		{"multi", 7, 9, "Unexpected", "]"},
+		{"multi", 12, 0, "Unexpected", "struct"},
+		{"multi", 16, 24, "Unexpected", "("},
+	})
+}
+
+func TestUnknownTemplateError(t *testing.T) {
+	errs := compileErrors(t, "unknown_template")
+
+	checkErrors(t, errs, []expectedError{
+		{"unknown_template", 4, 2, "Unknown template name", "unknown"},
+	})
+}
+
+func TestTemplateInferenceError(t *testing.T) {
+	errs := compileErrors(t, "infer")
+
+	checkErrors(t, errs, []expectedError{
+		{"infer", 15, 2, "Ambiguous template type T, it is float64 or int", "max"},
+		{"infer", 16, 2, "Ambiguous template type T, it is int or string", "max"},
+		{"infer", 17, 2, "Cannot infer the template type T", "zero"},
+	})
+}
+
+func TestTemplateConstraintError(t *testing.T) {
+	errs := compileErrors(t, "constraint")
+
+	checkErrors(t, errs, []expectedError{
+		{"constraint", 17, 2, "bool does not satisfy ordered", "max"},
+		{"constraint", 18, 2, "[]int does not satisfy ordered", "max"},
+		{"constraint", 19, 2, "Rock does not satisfy Named", "hello"},
+		{"constraint", 20, 2, "Pebble does not satisfy fmt.Stringer", "describe"},
+		{"constraint", 22, 2, "Cannot verify that Rock satisfies io.Reader", "read"},
+	})
+}
+
+// The types of the other files of the package are known, an unknown one cannot be verified
+func TestTemplateConstraintPackageError(t *testing.T) {
+	errs := compileErrors(t, "constraints/show", "constraints/other")
+
+	checkErrors(t, errs, []expectedError{
+		{"constraints/show", 6, 2, "Other does not satisfy fmt.Stringer", "show"},
+	})
+
+	errs = compileErrors(t, "constraints/show")
+
+	checkErrors(t, errs, []expectedError{
+		{"constraints/show", 6, 2, "Cannot verify that Other satisfies fmt.Stringer", "show"},
+		{"constraints/show", 7, 2, "Cannot verify that Named satisfies fmt.Stringer", "show"},
+	})
+}
+
+func TestVariantErrors(t *testing.T) {
+	errs := compileErrors(t, "variant")
+
+	checkErrors(t, errs, []expectedError{
+		{"variant", 5, 2, "A variant is a name, alone or followed by the block of its fields", ""},
+		{"variant", 6, 2, "A variant is a name, alone or followed by the block of its fields", ""},
+	})
+}
+
+func TestIndentationError(t *testing.T) {
+	errs := compileErrors(t, "indent")
+
+	checkErrors(t, errs, []expectedError{
+		{"indent", 5, 2, "Inconsistent indentation", ""},
+	})
+}
+
+func TestBubbleErrors(t *testing.T) {
+	errs := compileErrors(t, "bubble")
+
+	checkErrors(t, errs, []expectedError{
+		{"bubble", 4, 15, "The ? operator needs a function that returns an error", "?"},
+		{"bubble", 8, 8, "The ? operator only applies to a call", "?"},
+		{"bubble", 12, 13, "The ? operator cannot be used here", "?"},
+		{"bubble", 21, 27, "The ? operator must come before the other calls of its statement", "?"},
+		{"bubble", 25, 5, "The ? operator cannot know the values of this call, they must be assigned", "?"},
+	})
+}
+
+func TestTypeErrors(t *testing.T) {
+	errs := compileErrors(t, "types/undefined", "types/redeclared", "types/mismatch", "types/arity", "types/exhaustive", "types/destructure")
+
+	checkErrors(t, errs, []expectedError{
+		{"types/undefined", 11, 14, "Undefined name", "a"},
+		{"types/undefined", 11, 17, "Undefined name", "b"},
+		{"types/undefined", 12, 2, "Undefined name", "fmtt"},
+		{"types/undefined", 12, 15, "Undefined name", "unknown"},
+		{"types/redeclared", 5, 2, "No new variables on the left of :=", ""},
+		{"types/redeclared", 8, 6, "Already declared", "b"},
+		{"types/redeclared", 12, 0, "Already declared", "redeclared"},
+		{"types/mismatch", 6, 2, "Assignment mismatch: 2 variables but single returns 1 value", ""},
+		{"types/mismatch", 7, 2, "Assignment mismatch: 2 variables but 3 values", ""},
+		{"types/mismatch", 9, 6, "Cannot assign string to int", "e"},
+		{"types/mismatch", 12, 2, "Cannot assign string to int", "f"},
+		{"types/mismatch", 14, 2, "Cannot infer the type of a switch, use var g T = switch", ""},
+		{"types/arity", 8, 2, "Not enough arguments in call", "sum"},
+		{"types/arity", 9, 2, "Too many arguments in call", "sum"},
+		{"types/arity", 10, 2, "Not enough arguments in call", "rest"},
+		{"types/exhaustive", 11, 2, "Non-exhaustive switch on Shape, missing Empty", ""},
+		{"types/exhaustive", 16, 2, "Non-exhaustive match on Shape, missing Square", ""},
+		{"types/destructure", 14, 4, "A field is given a name only in a pattern alone in its arm", ""},
+		{"types/destructure", 14, 23, "A field is given a name only in a pattern alone in its arm", ""},
+	})
+}
+
+func TestCheckErrors(t *testing.T) {
+	config := common.NewOgConfig()
+	config.Check = true
+
+	errs := compileErrorsWith(t, config, "check/check")
+
+	checkErrors(t, errs, []expectedError{
+		{"check/check", 11, 16, "p.Y undefined (type Point has no field or method Y)", "Y"},
+		{"check/check", 12, 14, "invalid operation: p.X + \"a\" (mismatched types int and untyped string)", "p"},
+	})
+
+	if _, err := os.Stat("./exemples/errors/check/check.go"); err == nil {
+		t.Fatal("Expected --check to write nothing")
+	}
+}
+
+// The templates of a checked package are not written either
+func TestCheckTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "og_check")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "lib.og")
+	ioutil.WriteFile(file, []byte("!lib\n\nid<T>(x T): T -> x\n\nuse: int -> id<int>(1)\n"), 0644)
+
+	config := common.NewOgConfig()
+
+	config.Quiet = true
+	config.Check = true
+	config.Paths = []string{file}
+
+	common.Print = common.NewPrinter(config)
+
+	if err := og.NewOgCompiler(config).Compile(); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(files) != 1 {
+		t.Fatal("Expected --check to write nothing, got", len(files), "files")
+	}
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+)
+
+// Go code generated for an Og source, without its source map markers and
+// its comments, that the formatter can move around with the imports
+func generate(t *testing.T, filePath string, source string) string {
+	file := &common.File{
+		Path:   filePath,
+		Name:   filepath.Base(filePath),
+		Source: []byte(source),
+	}
+
+	if err := og.NewOgParser(common.NewOgConfig()).Parse(file); err != nil {
+		t.Fatal(filePath, err)
+	}
+
+	res := common.StripMarkers(file.Ast.Eval())
+
+	fset := token.NewFileSet()
+
+	tree, err := parser.ParseFile(fset, filePath, res, 0)
+	if err != nil {
+		return res
+	}
+
+	// The formatter sorts the imports
+	ast.SortImports(fset, tree)
+
+	var buf bytes.Buffer
+
+	if err := format.Node(&buf, fset, tree); err != nil {
+		return res
+	}
+
+	return buf.String()
+}
+
+func ogSources(t *testing.T, dirs ...string) []string {
+	res := []string{}
+
+	for _, dir := range dirs {
+		err := filepath.Walk(dir, func(filePath string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if info.IsDir() && (info.Name() == "errors" || info.Name() == ".og") {
+				return filepath.SkipDir
+			}
+
+			if !info.IsDir() && filepath.Ext(filePath) == ".og" {
+				res = append(res, filePath)
+			}
+
+			return nil
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return res
+}
+
+// The formatted sources generate the same code, and are formatted already
+func TestFormatRoundTrip(t *testing.T) {
+	for _, filePath := range ogSources(t, "./exemples", "../lib") {
+		source, err := ioutil.ReadFile(filePath)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		formatted, err := og.FormatSource(filePath, source)
+
+		if err != nil {
+			t.Fatal(filePath, err)
+		}
+
+		if generate(t, filePath, string(source)) != generate(t, filePath, formatted) {
+			t.Error("The formatted source generates other code", filePath)
+			continue
+		}
+
+		again, err := og.FormatSource(filePath, []byte(formatted))
+
+		if err != nil {
+			t.Fatal(filePath, err)
+		}
+
+		if again != formatted {
+			t.Error("The formatted source is formatted again differently", filePath)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	source := `package main
+import
+	"github.com/champii/og/lib/common"
+	fmt
+// Does nothing
+struct Foo
+	A int // first
+	Bar string
+	*Baz -> if @A == 0 => return
+main ->
+	if true => fmt.Println("a")
+	else => fmt.Println("b")
+`
+
+	expected := `!main
+
+import
+  fmt
+
+  "github.com/champii/og/lib/common"
+
+// Does nothing
+struct Foo
+  A   int // first
+  Bar string
+  *Baz -> if @A == 0 => return
+main ->
+  if true => fmt.Println("a")
+  else    => fmt.Println("b")
+`
+
+	res, err := og.FormatSource("foo.og", []byte(source))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res != expected {
+		t.Fatal("Bad format:\n" + res)
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+)
+
+func TestGenerics(t *testing.T) {
+	expected := `package og
+
+import (
+	"github.com/champii/og/tests/exemples/bar"
+)
+
+func a[T any](t T) T {
+	return t
+}
+
+type Foo[T any] struct {
+	bar T
+}
+
+func main() {
+	fmt.Println(a[int](1))
+	fmt.Println(a[string]("a"))
+	a := Foo[int]{bar: 1}
+}
+
+//  b := bar.Foo<int>
+//    bar: 1
+`
+
+	config := common.NewOgConfig()
+
+	config.Force = true
+	config.NoCheck = true
+	config.Generics = true
+	config.Paths = []string{"./exemples/generics.og"}
+
+	common.Print = common.NewPrinter(config)
+	compiler := og.NewOgCompiler(config)
+
+	if err := compiler.Compile(); err != nil {
+		t.Fatal(err)
+	}
+
+	if output := compiler.Files[0].Output; output != expected {
+		t.Fatal("Got: \n---\n", output, "\n---\nExpected: \n---\n", expected, "\n---\n")
+	}
+}
+
+func TestGenericsConstraints(t *testing.T) {
+	config := common.NewOgConfig()
+
+	config.Force = true
+	config.NoCheck = true
+	config.Generics = true
+	config.Paths = []string{"./exemples/template_constraint.og"}
+
+	common.Print = common.NewPrinter(config)
+	compiler := og.NewOgCompiler(config)
+
+	if err := compiler.Compile(); err != nil {
+		t.Fatal(err)
+	}
+
+	output := compiler.Files[0].Output
+
+	for _, decl := range []string{
+		"func max[T ~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr | ~float32 | ~float64 | ~string](a, b T) T {",
+		"func show[T ~int | ~string, U any](t T, u U) string {",
+		"type Set[T comparable] struct {",
+	} {
+		if !strings.Contains(output, decl) {
+			t.Fatal("Missing", decl, "in:\n", output)
+		}
+	}
+}
+
+func TestRunGenerics(t *testing.T) {
+	dir := tempFiles(t, "og_generics", map[string]string{
+		"prog.og": "!main\n\nimport\n  os\n\nstruct Box<T>\n  Value T\n\n  Get: T -> @Value\n\nfirst<T>(arr []T): T -> arr[0]\n\nmain ->\n  b := Box<[]int>\n    Value: []int{3, 4}\n  os.Exit(first<int>(b.Get()))\n",
+	})
+
+	config := runConfig("prog.og")
+
+	config.Generics = true
+
+	runIn(t, dir, config, 3)
+
+	// A single definition, without any template blob next to it
+	if _, err := os.Stat(filepath.Join(dir, ".og", "template")); err == nil {
+		t.Fatal("Unexpected template blob")
+	}
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/champii/og/lib/lsp"
+)
+
+const lspSource = `!main
+
+struct Foo
+	bar int
+	Bar: int -> @bar
+
+interface Getter
+	Bar: int
+
+Foo::Baz: int -> @bar
+
+main ->
+	foo := Foo{}
+	fmt.Println(foo.Baz(), helper())
+
+helper: int -> 1
+
+shadow: int ->
+	helper := 2
+	if true
+		helper := 3
+		return helper
+	helper
+
+struct Other
+	Baz: int -> 2
+
+other ->
+	o := Other{}
+	o.Baz()
+	fmt.Println("é😀", helper())
+
+baz(g Getter): int -> g.Baz()
+`
+
+// Fake LSP client talking to the server through pipes
+type lspClient struct {
+	t   *testing.T
+	in  io.Writer
+	out *bufio.Reader
+	id  int
+}
+
+type lspResponse struct {
+	ID     *int            `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+func (c *lspClient) send(msg map[string]interface{}) {
+	msg["jsonrpc"] = "2.0"
+
+	if err := lsp.WriteMessage(c.in, msg); err != nil {
+		c.t.Fatal(err)
+	}
+}
+
+func (c *lspClient) notify(method string, params interface{}) {
+	c.send(map[string]interface{}{"method": method, "params": params})
+}
+
+func (c *lspClient) request(method string, params interface{}, result interface{}) {
+	c.id++
+	c.send(map[string]interface{}{"id": c.id, "method": method, "params": params})
+
+	for {
+		res, err := readResponse(c.out)
+		if err != nil {
+			c.t.Fatal(err)
+		}
+
+		// Skip the notifications
+		if res.ID == nil {
+			continue
+		}
+
+		if *res.ID != c.id {
+			c.t.Fatal(fmt.Sprint("Unexpected response id ", *res.ID, " for ", method))
+		}
+
+		if res.Error != nil {
+			c.t.Fatal(fmt.Sprint("Error response for ", method, ": ", res.Error.Message))
+		}
+
+		if err := json.Unmarshal(res.Result, result); err != nil {
+			c.t.Fatal(err)
+		}
+
+		return
+	}
+}
+
+func (c *lspClient) diagnostics() []lsp.Diagnostic {
+	res, err := readResponse(c.out)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+
+	if res.Method != "textDocument/publishDiagnostics" {
+		c.t.Fatal(fmt.Sprint("Expected diagnostics, got ", res.Method))
+	}
+
+	params := lsp.PublishDiagnosticsParams{}
+	json.Unmarshal(res.Params, &params)
+
+	return params.Diagnostics
+}
+
+func readResponse(r *bufio.Reader) (lspResponse, error) {
+	res := lspResponse{}
+
+	length := 0
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return res, err
+		}
+
+		if line == "\r\n" {
+			break
+		}
+
+		fmt.Sscanf(line, "Content-Length: %d", &length)
+	}
+
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return res, err
+	}
+
+	return res, json.Unmarshal(body, &res)
+}
+
+func TestLsp(t *testing.T) {
+	serverIn, clientOut := io.Pipe()
+	clientIn, serverOut := io.Pipe()
+
+	done := make(chan error)
+	go func() {
+		done <- lsp.NewServer(serverIn, serverOut).Run()
+	}()
+
+	client := &lspClient{t: t, in: clientOut, out: bufio.NewReader(clientIn)}
+
+	uri := "file:///tmp/og_lsp/main.og"
+	doc := map[string]interface{}{"uri": uri}
+
+	var init lsp.InitializeResult
+	client.request("initialize", map[string]interface{}{}, &init)
+
+	if !init.Capabilities.DocumentSymbolProvider || !init.Capabilities.DefinitionProvider {
+		t.Fatal(fmt.Sprint("Missing capabilities: ", init.Capabilities))
+	}
+
+	client.notify("initialized", map[string]interface{}{})
+	client.notify("textDocument/didOpen", map[string]interface{}{
+		"textDocument": map[string]interface{}{"uri": uri, "languageId": "og", "version": 1, "text": lspSource},
+	})
+
+	if diags := client.diagnostics(); len(diags) != 0 {
+		t.Fatal(fmt.Sprint("Unexpected diagnostics: ", diags))
+	}
+
+	var symbols []lsp.SymbolInformation
+	client.request("textDocument/documentSymbol", map[string]interface{}{"textDocument": doc}, &symbols)
+
+	expectedSymbols := []struct {
+		name      string
+		kind      int
+		container string
+		line      int
+	}{
+		{"Foo", lsp.SymbolKindStruct, "", 2},
+		{"Bar", lsp.SymbolKindMethod, "Foo", 4},
+		{"Getter", lsp.SymbolKindInterface, "", 6},
+		{"Baz", lsp.SymbolKindMethod, "Foo", 9},
+		{"main", lsp.SymbolKindFunction, "", 11},
+		{"helper", lsp.SymbolKindFunction, "", 15},
+		{"shadow", lsp.SymbolKindFunction, "", 17},
+		{"Other", lsp.SymbolKindStruct, "", 24},
+		{"Baz", lsp.SymbolKindMethod, "Other", 25},
+		{"other", lsp.SymbolKindFunction, "", 27},
+		{"baz", lsp.SymbolKindFunction, "", 32},
+	}
+
+	if len(symbols) != len(expectedSymbols) {
+		t.Fatal(fmt.Sprint("Expected ", len(expectedSymbols), " symbols, got ", symbols))
+	}
+
+	for i, expected := range expectedSymbols {
+		symbol := symbols[i]
+		if symbol.Name != expected.name || symbol.Kind != expected.kind || symbol.ContainerName != expected.container || symbol.Location.Range.Start.Line != expected.line {
+			t.Fatal(fmt.Sprint("Error: symbol ", i, "\nGot: ", symbol, "\nExpected: ", expected))
+		}
+	}
+
+	// The characters count the UTF-16 units, a method is looked for on the
+	// type of its receiver, or on any type when it is not known
+	definitions := []struct {
+		line, character int
+		expected        [][3]int // Line, start and end characters
+	}{
+		{13, 26, [][3]int{{15, 0, 6}}},
+		{30, 20, [][3]int{{15, 0, 6}}},
+		{13, 17, [][3]int{{9, 5, 8}}},
+		{29, 3, [][3]int{{25, 1, 4}}},
+		{32, 24, [][3]int{{9, 5, 8}, {25, 1, 4}}},
+		{21, 10, [][3]int{{20, 2, 8}}},
+		{22, 2, [][3]int{{18, 1, 7}}},
+	}
+
+	for _, def := range definitions {
+		var locations []lsp.Location
+		client.request("textDocument/definition", map[string]interface{}{
+			"textDocument": doc,
+			"position":     map[string]interface{}{"line": def.line, "character": def.character},
+		}, &locations)
+
+		if len(locations) != len(def.expected) {
+			t.Fatal(fmt.Sprint("Bad definitions: ", locations, "\nExpected: ", def))
+		}
+
+		for i, location := range locations {
+			start := location.Range.Start
+
+			if location.URI != uri || start.Line != def.expected[i][0] || start.Character != def.expected[i][1] || location.Range.End.Character != def.expected[i][2] {
+				t.Fatal(fmt.Sprint("Bad definition: ", location, "\nExpected: ", def))
+			}
+		}
+	}
+
+	// The error keeps its source line, with the blank lines and the tabs
+	client.notify("textDocument/didChange", map[string]interface{}{
+		"textDocument":   map[string]interface{}{"uri": uri, "version": 2},
+		"contentChanges": []map[string]interface{}{{"text": "!main\n\n\nmain ->\n\tfoo := )\n\tbar := ]\n"}},
+	})
+
+	diags := client.diagnostics()
+	if len(diags) != 2 {
+		t.Fatal(fmt.Sprint("Expected 2 syntax errors, got ", diags))
+	}
+
+	for i, line := range []int{4, 5} {
+		if diags[i].Range.Start.Line != line || diags[i].Range.Start.Character != 8 || diags[i].Severity != lsp.SeverityError {
+			t.Fatal(fmt.Sprint("Bad diagnostic: ", diags[i]))
+		}
+	}
+
+	// The last valid symbols are kept
+	client.request("textDocument/documentSymbol", map[string]interface{}{"textDocument": doc}, &symbols)
+
+	if len(symbols) != len(expectedSymbols) {
+		t.Fatal(fmt.Sprint("Expected the previous symbols, got ", symbols))
+	}
+
+	var shutdown interface{}
+	client.request("shutdown", nil, &shutdown)
+	client.notify("exit", nil)
+
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/champii/og/lib/ast/walker"
+)
+
+func TestMangle(t *testing.T) {
+	tests := []struct {
+		types    []string
+		expected string
+	}{
+		{[]string{"int"}, "pkg_foo_int"},
+		{[]string{"int", "string"}, "pkg_foo_int_string"},
+		{[]string{"my_type"}, "pkg_foo_my_0type"},
+		{[]string{"[]int"}, "pkg_foo__3_4int"},
+		{[]string{"[4]int"}, "pkg_foo__34_4int"},
+		{[]string{"[][]string"}, "pkg_foo__3_4_3_4string"},
+		{[]string{"map[string]int"}, "pkg_foo_map_3string_4int"},
+		{[]string{"map[string][]*int"}, "pkg_foo_map_3string_4_3_4_1int"},
+		{[]string{"*int"}, "pkg_foo__1int"},
+		{[]string{"**int"}, "pkg_foo__1_1int"},
+		{[]string{"chan int"}, "pkg_foo_chan_2int"},
+		{[]string{"<-chan int"}, "pkg_foo__6_92dchan_2int"},
+		{[]string{"chan<- int"}, "pkg_foo_chan_6_92d_2int"},
+		{[]string{"bar.Baz"}, "pkg_foo_bar_5Baz"},
+		{[]string{"*bar.Baz"}, "pkg_foo__1bar_5Baz"},
+		{[]string{"Box<int>"}, "pkg_foo_Box_6int_7"},
+		{[]string{"Pair<Box<int>,string>"}, "pkg_foo_Pair_6Box_6int_7_8string_7"},
+		{[]string{"func(int) string"}, "pkg_foo_func_928int_929_2string"},
+		{[]string{"interface{}"}, "pkg_foo_interface_97b_97d"},
+		{[]string{"héllo"}, "pkg_foo_héllo"},
+	}
+
+	names := map[string]bool{}
+
+	for _, test := range tests {
+		parts := append([]string{"pkg", "foo"}, test.types...)
+		name := walker.Mangle(parts...)
+
+		if name != test.expected {
+			t.Fatal("Bad name for", test.types, ":", name, "instead of", test.expected)
+		}
+
+		if !token.IsIdentifier(name) {
+			t.Fatal("Not an identifier:", name)
+		}
+
+		if names[name] {
+			t.Fatal("Collision:", name)
+		}
+
+		names[name] = true
+
+		back, err := walker.Demangle(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !reflect.DeepEqual(back, parts) {
+			t.Fatal("Bad parts for", name, ":", back, "instead of", parts)
+		}
+	}
+}
+
+func TestMangleCollisions(t *testing.T) {
+	pairs := [][2][]string{
+		{{"my_type"}, {"my", "type"}},
+		{{"_x"}, {"", "x"}},
+		{{"*int"}, {"", "1int"}},
+		{{"a.b"}, {"a", "b"}},
+		{{"map[a]b"}, {"map", "a", "b"}},
+		{{"[]int"}, {"", "", "int"}},
+	}
+
+	for _, pair := range pairs {
+		left := walker.Mangle(pair[0]...)
+		right := walker.Mangle(pair[1]...)
+
+		if left == right {
+			t.Fatal("Collision between", pair[0], "and", pair[1], ":", left)
+		}
+	}
+
+	// A part that starts with a digit is escaped, the names stay identifiers
+	if name := walker.Mangle("2d", "foo"); !token.IsIdentifier(name) {
+		t.Fatal("Not an identifier:", name)
+	}
+}
+
+func TestDemangleErrors(t *testing.T) {
+	for _, name := range []string{"foo_9", "foo_92", "foo_9zz"} {
+		if _, err := walker.Demangle(name); err == nil {
+			t.Fatal("Expected an error for", name)
+		}
+	}
+}
+
+// The templates are stored next to the package, from the folder of the build
+func TestRunCompositeTemplates(t *testing.T) {
+	dir := tempFiles(t, "og_mangle", map[string]string{
+		"prog.og": "!main\n\nimport\n  os\n  strings\n\nfirst<T>(arr []T): T -> arr[0]\n\nstruct Box<T>\n  Value T\n\nmain ->\n  nums := []int{1, 2}\n  a := first<[]int>([][]int{nums})\n  m := first<map[string]int>([]map[string]int{map[string]int{\"a\": 2}})\n  p := first([]*int{&nums[0]})\n  r := first<*strings.Reader>([]*strings.Reader{strings.NewReader(\"abc\")})\n  c := first<chan int>([]chan int{make(chan int)})\n  b := Box<[]string>\n    Value: []string{\"x\"}\n  os.Exit(a[1] + m[\"a\"] + *p + r.Len() + len(b.Value) + cap(c))\n",
+	})
+
+	runIn(t, dir, runConfig("prog.og"), 9)
+}
+
+// Two packages of the same name give different instances
+func TestMangleImportPaths(t *testing.T) {
+	a := walker.NewTemplate("Box", "example.com/a/util", []string{"T"}, nil).InstanceName([]string{"int"})
+	b := walker.NewTemplate("Box", "example.com/b/util", []string{"T"}, nil).InstanceName([]string{"int"})
+
+	if a == b {
+		t.Fatal("Collision between the packages:", a)
+	}
+
+	back, err := walker.Demangle(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(back, []string{"example.com/a/util", "Box", "int"}) {
+		t.Fatal("Bad parts for", a, ":", back)
+	}
+}
+
+func TestRunSamePackageNames(t *testing.T) {
+	dir := tempFiles(t, "og_mangle", map[string]string{
+		"app/go.mod":      "module example.com/app\n\ngo 1.18\n",
+		"app/a/util/a.og": "!util\n\nconst Base = 1\n\nstruct Box<T>\n  Value T\n",
+		"app/b/util/b.og": "!util\n\nconst Base = 2\n\nstruct Box<T>\n  Other T\n",
+		"app/main.og":     "!main\n\nimport\n  os\n  \"example.com/app/a/util\"\n  \"example.com/app/b/util\": butil\n\nmain ->\n  a := util.Box<int>\n    Value: util.Base\n  b := butil.Box<int>\n    Other: butil.Base\n  os.Exit(a.Value + b.Other)\n",
+	})
+
+	runIn(t, filepath.Join(dir, "app"), runConfig("."), 3)
+}
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		file := filepath.Join(dir, name)
+
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// A temporary folder with the files, removed at the end of the test
+func tempFiles(t *testing.T, prefix string, files map[string]string) string {
+	dir, err := ioutil.TempDir("", prefix)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	writeFiles(t, dir, files)
+
+	return dir
+}
+
+// Runs og from the folder `wd`, the program has to exit with `status`.
+// A status of 0 is a run without any error
+func runIn(t *testing.T, wd string, config *common.OgConfig, status int) {
+	back, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Chdir(wd); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		if err := os.Chdir(back); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	err = og.NewOg(config).Run()
+
+	if status == 0 {
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return
+	}
+
+	exitErr, ok := err.(*og.ExitError)
+	if !ok {
+		t.Fatal("Expected an exit error, got", err)
+	}
+
+	if exitErr.Status != status {
+		t.Fatal("Bad exit status", exitErr.Status)
+	}
+}
+
+// The config that runs the programs of `paths`
+func runConfig(paths ...string) *common.OgConfig {
+	config := common.NewOgConfig()
+
+	config.Quiet = true
+	config.Run = true
+	config.Paths = paths
+
+	return config
+}
+
+func TestModulePaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "og_module")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	os.Setenv("GOMODCACHE", "/cache")
+	defer os.Unsetenv("GOMODCACHE")
+
+	writeFiles(t, dir, map[string]string{
+		"app/go.mod": `module example.com/app // the app
+
+go 1.18
+
+require example.com/one v1.0.0
+
+require (
+	github.com/Foo/Bar v1.2.0
+	example.com/two v0.1.0
+	example.com/two/sub v0.2.0
+	example.com/three v0.3.0
+	example.com/four v0.5.0
+	example.com/five v1.0.0
+)
+
+replace example.com/two => ../two
+
+replace (
+	example.com/three v0.3.0 => example.com/fork v0.4.0
+	example.com/four v0.4.0 => ../four
+	example.com/five => ../five-any
+	example.com/five v1.0.0 => ../five-exact
+)
+`,
+	})
+
+	app := filepath.Join(dir, "app")
+
+	paths := map[string]string{
+		app:                                 "example.com/app",
+		filepath.Join(app, "lib", "inner"):  "example.com/app/lib/inner",
+		filepath.Join(dir, "two", "nested"): "nested",
+	}
+
+	for folder, expected := range paths {
+		if res := common.ImportPathOf(folder); res != expected {
+			t.Error("Bad import path of", folder, res, expected)
+		}
+	}
+
+	dirs := map[string]string{
+		"example.com/app/lib":     filepath.Join(app, "lib"),
+		"example.com/one/pkg":     "/cache/example.com/one@v1.0.0/pkg",
+		"github.com/Foo/Bar":      "/cache/github.com/!foo/!bar@v1.2.0",
+		"example.com/two/pkg":     filepath.Join(dir, "two", "pkg"),
+		"example.com/two/sub/pkg": "/cache/example.com/two/sub@v0.2.0/pkg",
+		"example.com/three":       "/cache/example.com/fork@v0.4.0",
+		"example.com/four":        "/cache/example.com/four@v0.5.0",
+		"example.com/five":        filepath.Join(dir, "five-exact"),
+	}
+
+	for importPath, expected := range dirs {
+		if res := common.PackageDir(filepath.Join(app, "lib"), importPath); res != expected {
+			t.Error("Bad folder of", importPath, res, expected)
+		}
+	}
+}
+
+// A template of a package of the module, and one of a replaced module
+// compiled before, are found from their import path
+func TestRunModuleTemplates(t *testing.T) {
+	dir := tempFiles(t, "og_module", map[string]string{
+		"dep/go.mod":     "module example.com/dep\n\ngo 1.18\n",
+		"dep/dep.og":     "!dep\n\nconst Base = 1\n\nstruct Pair<T>\n  Left  T\n  Right T\n",
+		"app/go.mod":     "module example.com/app\n\ngo 1.18\n\nrequire example.com/dep v0.0.0\n\nreplace example.com/dep => ../dep\n",
+		"app/lib/lib.og": "!lib\n\nconst Base = 2\n\nstruct Box<T>\n  Value T\n",
+		"app/main.og":    "!main\n\nimport\n  os\n  \"example.com/app/lib\"\n  \"example.com/dep\"\n\nmain ->\n  b := lib.Box<int>\n    Value: lib.Base\n  p := dep.Pair<int>\n    Left:  dep.Base\n    Right: 3\n  os.Exit(b.Value + p.Left + p.Right)\n",
+	})
+
+	config := common.NewOgConfig()
+
+	config.Quiet = true
+	config.NoBuild = true
+	config.Paths = []string{"."}
+
+	runIn(t, filepath.Join(dir, "dep"), config, 0)
+	runIn(t, filepath.Join(dir, "app"), runConfig("."), 6)
+
+	// Only the packages that were compiled have their templates written
+	if _, err := os.Stat(filepath.Join(dir, "app", "lib", ".og", "template")); err != nil {
+		t.Fatal("Missing the templates of the package", err)
+	}
+}
@@ -477,6 +477,112 @@ func voila() int {
 		return 2
 	}
 }
+func name(n int) string {
+	var (
+		a string = func() string {
+			switch n {
+			case 1:
+				return "one"
+			default:
+				return "many"
+			}
+		}()
+	)
+	switch a {
+	case "one":
+		return a
+	default:
+		{
+			b := a + "!"
+			return b
+		}
+	}
+}
+func kind(v interface{}, c chan int) string {
+	var (
+		a string = func() string {
+			switch v.(type) {
+			case int:
+				return "int"
+			default:
+				return "other"
+			}
+		}()
+	)
+	select {
+	case <-c:
+		return a
+	default:
+		return ""
+	}
+}
+func doubles(xs []int) []int {
+	var (
+		a []int = func() []int {
+			var (
+				__og_for []int
+			)
+			for _, x := range xs {
+				__og_for = append(__og_for, x*2)
+			}
+			return __og_for
+		}()
+	)
+	var (
+		__og_for []int
+	)
+	for _, x := range a {
+		if x > 2 {
+			__og_for = append(__og_for, x)
+		}
+	}
+	return __og_for
+}
+func firsts(c chan int) []int {
+	for {
+		return []int{<-c}
+	}
+}
+func show(xs []int) []int {
+	for _, x := range xs {
+		println(x)
+	}
+}
+func infer(n int, c chan int) int {
+	a := func() string {
+		switch n {
+		case 1:
+			return "one"
+		default:
+			return "many"
+		}
+	}()
+	b := func() int {
+		if n > 1 {
+			return n
+		} else {
+			return 0
+		}
+	}()
+	d := func() float64 {
+		select {
+		case <-c:
+			return 1.5
+		default:
+			return 2
+		}
+	}()
+	xs := func() []int {
+		var (
+			__og_for []int
+		)
+		for i := 0; i < n; i++ {
+			__og_for = append(__og_for, i*2)
+		}
+		return __og_for
+	}()
+	return len(a) + b + int(d) + len(xs)
+}
 `,
 		// generics.og
 		`package og
@@ -486,20 +592,472 @@ import (
 )
 
 func main() {
-	fmt.Println(exemples_a_int(1))
-	fmt.Println(exemples_a_string("a"))
-	a := exemples_Foo_int{bar: 1}
+	fmt.Println(github_5com_92fchampii_92fog_92ftests_92fexemples_a_int(1))
+	fmt.Println(github_5com_92fchampii_92fog_92ftests_92fexemples_a_string("a"))
+	a := github_5com_92fchampii_92fog_92ftests_92fexemples_Foo_int{bar: 1}
 }
-func exemples_a_int(t int) int {
+func github_5com_92fchampii_92fog_92ftests_92fexemples_a_int(t int) int {
 	return t
 }
-func exemples_a_string(t string) string {
+func github_5com_92fchampii_92fog_92ftests_92fexemples_a_string(t string) string {
 	return t
 }
 
-type exemples_Foo_int struct {
+type github_5com_92fchampii_92fog_92ftests_92fexemples_Foo_int struct {
 	bar int
 }
+
+//  b := bar.Foo<int>
+//    bar: 1
+`,
+		// template_inference.og
+		`package main
+
+func main() {
+	nums := []int{
+		1,
+		2,
+	}
+	a := github_5com_92fchampii_92fog_92ftests_92fexemples_first_int(nums)
+	b := github_5com_92fchampii_92fog_92ftests_92fexemples_first_string([]string{"b"})
+	c := github_5com_92fchampii_92fog_92ftests_92fexemples_get_string_float64(map[string]float64{"c": 1}, b)
+	github_5com_92fchampii_92fog_92ftests_92fexemples_first_bool([]bool{a > 1})
+	github_5com_92fchampii_92fog_92ftests_92fexemples_get_int_bool(map[int]bool{}, 1)
+	github_5com_92fchampii_92fog_92ftests_92fexemples_twice_int(a + 1)
+	switch b {
+	case "b":
+		{
+			a := c
+			github_5com_92fchampii_92fog_92ftests_92fexemples_twice_float64(a)
+		}
+	default:
+		{
+			github_5com_92fchampii_92fog_92ftests_92fexemples_twice_int(a * 2)
+		}
+	}
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_first_int(arr []int) int {
+	return arr[0]
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_first_string(arr []string) string {
+	return arr[0]
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_first_bool(arr []bool) bool {
+	return arr[0]
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_get_string_float64(m map[string]float64, k string) float64 {
+	return m[k]
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_get_int_bool(m map[int]bool, k int) bool {
+	return m[k]
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_twice_int(x int) int {
+	return x + x
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_twice_float64(x float64) float64 {
+	return x + x
+}
+`,
+		// template_constraint.og
+		`package main
+
+import (
+	"fmt"
+)
+
+func main() {
+	github_5com_92fchampii_92fog_92ftests_92fexemples_max_int(1, 2)
+	github_5com_92fchampii_92fog_92ftests_92fexemples_show_int_string(3, "a")
+	s := github_5com_92fchampii_92fog_92ftests_92fexemples_Set_string{items: map[string]bool{}}
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_max_int(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+func github_5com_92fchampii_92fog_92ftests_92fexemples_show_int_string(t int, u string) string {
+	return fmt.Sprint(t, u)
+}
+
+type github_5com_92fchampii_92fog_92ftests_92fexemples_Set_string struct {
+	items map[string]bool
+}
+`,
+		// comments.og
+		`// License header
+
+package main
+
+import (
+	"fmt" // Printing
+)
+
+// Foo does things
+type Foo struct {
+	// The name
+	Name string
+	Age  int // In years
+}
+
+// Says hello
+func (this *Foo) Hello() {
+	fmt.Println("hello", this.Name)
+}
+
+// Bar is an interface
+type Bar interface {
+	// Does bar
+	Bar() int
+}
+
+// Qux is a method
+func (this Foo) Qux() int {
+	// The answer
+	a := 42 /* always */
+	return a
+}
+func main() {
+	Foo{}.Hello()
+}
+`,
+		// directives.og
+		`//go:build linux
+
+package main
+
+import (
+	"embed"
+	_ "unsafe"
+	// #include <stdlib.h>
+	"C"
+)
+
+//go:generate stringer -type=Kind
+var (
+	//go:embed directives.og
+	source embed.FS
+)
+
+//go:linkname now time.now
+func now() int64
+
+//go:noinline
+func add(a, b int) int {
+	return a + b
+}
+
+//export answer
+func answer() C.int {
+	return 42
+}
+`,
+		// indent.og
+		`package main
+
+func main() {
+	a := ` + "`if this\n  for that\n\nelse => `" + `
+	if a != "for " {
+		b := "if "
+		// A comment out of the block
+		c := "else "
+	} else {
+		b := 1
+	}
+	for i := 0; i < 1; i++ {
+		d := 2
+	}
+}
+`,
+		// bubble.og
+		`package main
+
+import (
+	"strconv"
+)
+
+type Point struct {
+	X int
+	Y int
+}
+
+func parse(s string) (int, error) {
+	return strconv.Atoi(s)
+}
+func point(x, y string) (*Point, Point, error) {
+	// The first one
+	__og_res1, __og_err1 := parse(x)
+	if __og_err1 != nil {
+		return nil, *new(Point), __og_err1
+	}
+	a := __og_res1
+	__og_res2, __og_err2 := parse(y)
+	if __og_err2 != nil {
+		return nil, *new(Point), __og_err2
+	}
+	var (
+		b int = __og_res2
+	)
+	__og_res3, __og_err3 := parse(y)
+	if __og_err3 != nil {
+		return nil, *new(Point), __og_err3
+	}
+	if __og_res3 > 10 {
+		return nil, Point{}, nil
+	}
+	__og_err4 := store(a)
+	if __og_err4 != nil {
+		return nil, *new(Point), __og_err4
+	}
+	p := Point{
+		a,
+		b,
+	}
+	return &p, p, nil
+}
+func store(a int) error {
+	return nil
+}
+`,
+		// match.og
+		`package main
+
+import (
+	"fmt"
+)
+
+type Point struct {
+	X int
+	Y int
+}
+type Circle struct {
+	R int
+}
+type Shape interface{}
+
+func describe(n int) string {
+	switch n {
+	case 1, 2:
+		return "small"
+	case 3:
+		return "three"
+	default:
+		return "big"
+	}
+}
+func kind(s Shape) string {
+	switch __og_match := s.(type) {
+	case *Circle:
+		c := __og_match
+		_ = c
+		return fmt.Sprint("circle ", c.R)
+	case Point:
+		return "point"
+	default:
+		return "other"
+	}
+}
+func where(s Shape) string {
+	switch __og_match := s; {
+	case func() bool {
+		__og_value, __og_ok := __og_match.(Point)
+		return __og_ok && __og_value.X == 0 && __og_value.Y == 0
+	}():
+		return "origin"
+	case func() bool {
+		__og_value, __og_ok := __og_match.(Point)
+		return __og_ok && __og_value.X == 0
+	}():
+		p := __og_match.(Point)
+		_ = p
+		return fmt.Sprint("on y ", p.Y)
+	case func() bool {
+		p, __og_ok := __og_match.(Point)
+		_ = p
+		return __og_ok && p.X > 10
+	}():
+		p := __og_match.(Point)
+		_ = p
+		return "far"
+	case func() bool {
+		__og_value, __og_ok := __og_match.(*Circle)
+		return __og_ok && __og_value != nil && __og_value.R == 1
+	}():
+		return "unit"
+	default:
+		v := __og_match
+		_ = v
+		return fmt.Sprint("else ", v)
+	}
+}
+func sign(n int) string {
+	var (
+		res string = func() string {
+			switch __og_match := n; {
+			case __og_match == 0:
+				return "zero"
+			case n < 0:
+				return "negative"
+			default:
+				return "positive"
+			}
+		}()
+	)
+	return res
+}
+func along(s Shape) int {
+	switch __og_match := s; {
+	case func() bool {
+		__og_value, __og_ok := __og_match.(*Circle)
+		if !(__og_ok && __og_value != nil) {
+			return false
+		}
+		r := __og_value.R
+		_ = r
+		return r > 1
+	}():
+		r := __og_match.(*Circle).R
+		_ = r
+		return r * 2
+	case func() bool {
+		__og_value, __og_ok := __og_match.(Point)
+		return __og_ok && __og_value.X == 0
+	}():
+		y := __og_match.(Point).Y
+		_ = y
+		return y
+	case func() bool {
+		_, __og_ok := __og_match.(Point)
+		return __og_ok
+	}():
+		p := __og_match.(Point)
+		_ = p
+		x := p.X
+		_ = x
+		return x + p.Y
+	default:
+		return 0
+	}
+}
+`,
+		// data.og
+		`package main
+
+// A shape
+type Shape interface {
+	isShape()
+}
+type Circle struct {
+	R float64
+}
+
+func (this Circle) diameter() float64 {
+	return this.R + this.R
+}
+func (Circle) isShape() {}
+func NewCircle(R float64) Shape {
+	return Circle{R: R}
+}
+
+type Rect struct {
+	W, H float64
+}
+
+func (Rect) isShape() {}
+func NewRect(W float64, H float64) Shape {
+	return Rect{W: W, H: H}
+}
+
+type Empty struct {
+}
+
+func (Empty) isShape() {}
+func NewEmpty() Shape {
+	return Empty{}
+}
+func area(s Shape) float64 {
+	switch v := s.(type) {
+	case Circle:
+		return v.diameter()
+	case Rect:
+		return v.W * v.H
+	default:
+		return 0
+	}
+}
+`,
+		// enum.og
+		`package main
+
+import (
+	"fmt"
+)
+
+// A color
+type Color int
+
+const (
+	// The first one
+	Red Color = iota
+	Green
+	_
+	Blue
+)
+
+func (this Color) String() string {
+	switch {
+	case this == Red:
+		return "Red"
+	case this == Green:
+		return "Green"
+	case this == Blue:
+		return "Blue"
+	}
+	return fmt.Sprintf("Color(%d)", int(this))
+}
+func ParseColor(s string) (Color, error) {
+	switch s {
+	case "Red":
+		return Red, nil
+	case "Green":
+		return Green, nil
+	case "Blue":
+		return Blue, nil
+	}
+	return 0, fmt.Errorf("unknown Color %q", s)
+}
+func ColorValues() []Color {
+	return []Color{Red, Green, Blue}
+}
+
+type level int
+
+const (
+	Low level = iota + 1
+	High
+)
+
+func (this level) String() string {
+	switch {
+	case this == Low:
+		return "Low"
+	case this == High:
+		return "High"
+	}
+	return fmt.Sprintf("level(%d)", int(this))
+}
+func parseLevel(s string) (level, error) {
+	switch s {
+	case "Low":
+		return Low, nil
+	case "High":
+		return High, nil
+	}
+	return 0, fmt.Errorf("unknown level %q", s)
+}
+func levelValues() []level {
+	return []level{Low, High}
+}
 `,
 	}
 
@@ -536,11 +1094,22 @@ type exemples_Foo_int struct {
 		`bitwise`,
 		`assignable_stmt`,
 		`generics`,
+		`template_inference`,
+		`template_constraint`,
+		`comments`,
+		`directives`,
+		`indent`,
+		`bubble`,
+		`match`,
+		`data`,
+		`enum`,
 	}
 
 	config := common.NewOgConfig()
 
 	config.Force = true
+	// The exemples only show the syntax, they are not all valid programs
+	config.NoCheck = true
 
 	for _, p := range paths {
 		config.Paths = append(config.Paths, "./exemples/"+p+".og")
@@ -0,0 +1,248 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+)
+
+func newSession(t *testing.T) *og.Session {
+	session, err := og.NewSession()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return session
+}
+
+// Output of the statement run by the session
+func runStmt(t *testing.T, session *og.Session, code string) string {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout := os.Stdout
+	os.Stdout = w
+
+	err = session.Run(code)
+
+	os.Stdout = stdout
+	w.Close()
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, _ := ioutil.ReadAll(r)
+
+	return string(out)
+}
+
+func TestSessionCheck(t *testing.T) {
+	session := newSession(t)
+	defer session.Close()
+
+	session.AddImport("strings", `"strings"`)
+
+	if err := session.AddDecl("type Foo struct {\nX int\n}"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := session.AddDecl("func double(x int) int {\nreturn x * 2\n}"); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{
+		`double(2)`:             "int",
+		`Foo{X: 1}`:             "main.Foo",
+		`strings.ToUpper("og")`: "string",
+	}
+
+	for code, typeName := range expected {
+		typ, isExpr, err := session.Check(code, nil)
+
+		if err != nil {
+			t.Fatal(code, err)
+		}
+
+		if !isExpr || typ == nil || typ.String() != typeName {
+			t.Fatal("Bad type for", code, typ)
+		}
+	}
+
+	if _, isExpr, _ := session.Check("x := 1", []string{"x"}); isExpr {
+		t.Fatal("A declaration is not an expression")
+	}
+
+	if _, _, err := session.Check("undefinedName", nil); err == nil {
+		t.Fatal("Expected an undefined name error")
+	}
+}
+
+func TestSessionDecl(t *testing.T) {
+	session := newSession(t)
+	defer session.Close()
+
+	if err := session.AddDecl("func foo() int {\nreturn 1\n}"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Replaces the previous declaration
+	if err := session.AddDecl("func foo() string {\nreturn \"a\"\n}"); err != nil {
+		t.Fatal(err)
+	}
+
+	if typ, _, err := session.Check("foo()", nil); err != nil || typ.String() != "string" {
+		t.Fatal("The declaration has not been replaced", typ, err)
+	}
+
+	// An invalid declaration is not kept
+	if err := session.AddDecl("func bar() int {\nreturn \"a\"\n}"); err == nil {
+		t.Fatal("Expected a type error")
+	}
+
+	if _, _, err := session.Check("", nil); err != nil {
+		t.Fatal("The invalid declaration has been kept", err)
+	}
+}
+
+func TestSessionRun(t *testing.T) {
+	session := newSession(t)
+	defer session.Close()
+
+	if out := runStmt(t, session, "a := 2"); out != "" {
+		t.Fatal("A declaration prints nothing", out)
+	}
+
+	if out := runStmt(t, session, "a * 21"); out != "42\n" {
+		t.Fatal("Bad output", out)
+	}
+
+	// The state is kept and a name can be declared again
+	runStmt(t, session, "a += 1")
+
+	if out := runStmt(t, session, "a := \"og\""); out != "" {
+		t.Fatal("A declaration prints nothing", out)
+	}
+
+	if out := runStmt(t, session, "a + \"!\""); out != "og!\n" {
+		t.Fatal("Bad output", out)
+	}
+}
+
+func TestSessionState(t *testing.T) {
+	session := newSession(t)
+	defer session.Close()
+
+	session.AddImport("os", `"os"`)
+
+	if err := session.AddDecl("type point struct {\nx, y int\n}\ntype shape interface{}"); err != nil {
+		t.Fatal(err)
+	}
+
+	// A statement runs once, the next ones only see its variables
+	path := filepath.Join(t.TempDir(), "count")
+
+	runStmt(t, session, fmt.Sprintf("data, _ := os.ReadFile(%q)\nos.WriteFile(%q, append(data, 'x'), 0644)", path, path))
+	runStmt(t, session, "p := &point{1, 2}")
+	runStmt(t, session, "q := p")
+	runStmt(t, session, "q.x = 5")
+
+	runStmt(t, session, "var s shape = point{3, 4}")
+	runStmt(t, session, "m := map[string][]int{\"a\": {1, 2}}")
+
+	if content, _ := ioutil.ReadFile(path); string(content) != "x" {
+		t.Fatal("A statement ran again", string(content))
+	}
+
+	expected := map[string]string{
+		`p.x + p.y`:    "7\n",
+		`p == q`:       "true\n",
+		`s.(point).y`:  "4\n",
+		`len(m["a"])`:  "2\n",
+		`string(data)`: "\n",
+	}
+
+	for code, out := range expected {
+		if res := runStmt(t, session, code); res != out {
+			t.Fatal("Bad output for ", code, ": ", res)
+		}
+	}
+
+	// A function cannot be saved, it is reported and forgotten
+	err := session.Run("f := func() {}")
+	if errs, ok := err.(common.Errors); !ok || len(errs) != 1 || !errs[0].IsWarning() {
+		t.Fatal("Expected a warning", err)
+	}
+
+	if _, _, err := session.Check("f", nil); err == nil {
+		t.Fatal("The function has been kept")
+	}
+}
+
+// The unfinished code goes on with the next lines
+func TestReplInput(t *testing.T) {
+	config := common.NewOgConfig()
+	config.Interpreter = true
+
+	compiler := og.NewOgCompiler(config)
+
+	lines := []string{
+		"a := 1",
+		"var s string = switch a",
+		"  1 => \"one\"",
+		"  _ => \"other\"",
+		"",
+		"p := []int{",
+		"  1, 2,",
+		"}",
+		"data Shape",
+		"  Circle",
+		"    R int",
+		"",
+		"struct Foo",
+		"  X int",
+		"",
+		"import",
+		"  fmt",
+		"",
+		"double(x int): int ->",
+		"  y := x * 2",
+		"  y",
+		"",
+		":type a",
+	}
+
+	expected := []string{
+		"a := 1",
+		"var s string = switch a\n  1 => \"one\"\n  _ => \"other\"",
+		"p := []int{\n  1, 2,\n}",
+		"data Shape\n  Circle\n    R int",
+		"struct Foo\n  X int",
+		"import\n  fmt",
+		"double(x int): int ->\n  y := x * 2\n  y",
+		":type a",
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(strings.Join(lines, "\n")))
+
+	for _, exp := range expected {
+		input, ok := og.ReadInput(compiler, scanner)
+
+		if !ok || input != exp {
+			t.Fatalf("Expected %q, got %q", exp, input)
+		}
+	}
+
+	if _, ok := og.ReadInput(compiler, scanner); ok {
+		t.Fatal("Expected the end of the input")
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunSingleFile(t *testing.T) {
+	dir := tempFiles(t, "og_run", map[string]string{
+		"prog.og": "!main\n\nimport\n  os\n\nmain ->\n  os.WriteFile(os.Args[1], []byte(os.Args[0]), 0644)\n  if os.Args[2] == \"b\"\n    os.Exit(len(os.Args))\n",
+	})
+
+	// The binary writes its own path there
+	binaryFile := filepath.Join(dir, "binary")
+
+	config := runConfig(filepath.Join(dir, "prog.og"))
+
+	config.RunArgs = []string{binaryFile, "b"}
+
+	runIn(t, dir, config, 3)
+
+	binary, err := ioutil.ReadFile(binaryFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The temporary folder of the binary is removed once it exited
+	if _, err := os.Stat(filepath.Dir(string(binary))); !os.IsNotExist(err) {
+		t.Fatal("The folder of the binary has not been removed", string(binary))
+	}
+}
+
+// The embedded files are looked for from the folder of the build
+func TestRunDirectives(t *testing.T) {
+	dir := tempFiles(t, "og_run", map[string]string{
+		"data.txt": "abcd",
+		"prog.og":  "!main\n\nimport\n  os\n  \"embed\": _\n\n//go:embed data.txt\nvar data string\n\n//go:noinline\nsize: int -> len(data)\n\nmain -> os.Exit(size())\n",
+	})
+
+	runIn(t, dir, runConfig("prog.og"), 4)
+}
+
+// The exhaustive switches and matches end the functions with or without the checks
+func TestRunExhaustiveNoCheck(t *testing.T) {
+	dir := tempFiles(t, "og_run", map[string]string{
+		"prog.og": "!main\n\nimport\n  os\n\ndata Shape\n  Circle\n    R int\n  Empty\n\nsize(s Shape): int ->\n  switch v := s.(type)\n    Circle => v.R\n    Empty  => 0\n\nname(s Shape): int ->\n  match s\n    Circle{} => 1\n    Empty{}  => 2\n\nmain -> os.Exit(size(NewCircle(3)) + name(NewEmpty()))\n",
+	})
+
+	for _, noCheck := range []bool{false, true} {
+		config := runConfig("prog.og")
+
+		config.Force = true
+		config.NoCheck = noCheck
+
+		runIn(t, dir, config, 5)
+	}
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/champii/og/lib/common"
+	"github.com/champii/og/lib/og"
+)
+
+func compileSourceMap(t *testing.T, lineDirectives bool) string {
+	config := common.NewOgConfig()
+
+	config.Force = true
+	config.Quiet = true
+	config.LineDirectives = lineDirectives
+	config.Paths = []string{"./exemples/source_map.og"}
+
+	common.Print = common.NewPrinter(config)
+
+	if err := og.NewOgCompiler(config).Compile(); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := ioutil.ReadFile("./exemples/source_map.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return string(output)
+}
+
+// Compiles source_map.og and returns the generated line containing `code`
+func sourceMapLine(t *testing.T, code string) int {
+	output := compileSourceMap(t, false)
+
+	for i, line := range strings.Split(output, "\n") {
+		if strings.Contains(line, code) {
+			return i + 1
+		}
+	}
+
+	t.Fatal("Cannot find " + code + " in:\n" + output)
+
+	return 0
+}
+
+func TestSourceMap(t *testing.T) {
+	line := sourceMapLine(t, "fmt.Println(foo.Bar())")
+
+	sourceMap := common.LoadSourceMap("./exemples/source_map.go")
+	if sourceMap == nil || sourceMap.Source != "./exemples/source_map.og" {
+		t.Fatal(fmt.Sprint("Bad source map: ", sourceMap))
+	}
+
+	mapping := sourceMap.Lookup(line, 2)
+	if mapping == nil || mapping.Line != 13 || mapping.Col != 2 {
+		t.Fatal(fmt.Sprint("Bad mapping: ", mapping))
+	}
+
+	// The inline method body
+	mapping = sourceMap.Lookup(sourceMapLine(t, "return this.bar"), 2)
+	if mapping == nil || mapping.Line != 8 {
+		t.Fatal(fmt.Sprint("Bad mapping: ", mapping))
+	}
+}
+
+func TestSourceMapBuildErrors(t *testing.T) {
+	line := sourceMapLine(t, "fmt.Println(foo.Bar())")
+
+	output := fmt.Sprintf("# main\n./exemples/source_map.go:%d:18: foo.Bar undefined\n", line)
+
+	errs := og.NewSourceMaps().BuildErrors(output)
+
+	if len(errs) != 1 {
+		t.Fatal(fmt.Sprint("Expected 1 error, got ", errs))
+	}
+
+	err := errs[0]
+	if err.Path != "./exemples/source_map.og" || err.Line != 13 || err.Column != 18 || err.Msg2 != "Bar" {
+		t.Fatal(fmt.Sprint("Bad error: ", err))
+	}
+}
+
+func TestSourceMapTrace(t *testing.T) {
+	line := sourceMapLine(t, "fmt.Println(foo.Bar())")
+
+	goPath, _ := filepath.Abs("./exemples/source_map.go")
+
+	out := &bytes.Buffer{}
+	trace := og.NewTraceWriter(out)
+
+	fmt.Fprintf(trace, "panic: boom\n\ngoroutine 1 [running]:\nmain.main()\n\t%s:%d +0x1d\n", goPath, line)
+	trace.Flush()
+
+	if !strings.Contains(out.String(), "\t./exemples/source_map.og:13 +0x1d\n") {
+		t.Fatal("Bad trace:\n" + out.String())
+	}
+
+	if trace.Error == nil || trace.Error.Line != 13 || trace.Error.Msg != "panic: boom" {
+		t.Fatal(fmt.Sprint("Bad trace error: ", trace.Error))
+	}
+}
+
+func TestLineDirectives(t *testing.T) {
+	output := compileSourceMap(t, true)
+
+	expected := []string{
+		"//line source_map.og:8:14\n\treturn this.bar\n",
+		"//line source_map.og:10:1\nfunc main() {\n",
+		"//line source_map.og:13:2\n\tfmt.Println(foo.Bar())\n",
+	}
+
+	for _, directive := range expected {
+		if !strings.Contains(output, directive) {
+			t.Fatal("Missing " + directive + " in:\n" + output)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/champii/og/lib/og"
+)
+
+func TestWatcher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "og_watch")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "main.og")
+	ioutil.WriteFile(file, []byte("!main\n"), 0644)
+
+	watcher := og.NewWatcher([]string{dir})
+
+	if !watcher.Changed() {
+		t.Fatal("The first snapshot must see the existing files")
+	}
+
+	if watcher.Changed() {
+		t.Fatal("Nothing has changed")
+	}
+
+	// Other files are ignored
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0644)
+
+	if watcher.Changed() {
+		t.Fatal("Only .og files are watched")
+	}
+
+	later := time.Now().Add(time.Minute)
+	os.Chtimes(file, later, later)
+
+	if !watcher.Changed() {
+		t.Fatal("Expected a modification")
+	}
+
+	ioutil.WriteFile(filepath.Join(dir, "other.og"), []byte("!main\n"), 0644)
+
+	if !watcher.Changed() {
+		t.Fatal("Expected a new file")
+	}
+
+	os.Remove(file)
+
+	if !watcher.Changed() {
+		t.Fatal("Expected a removed file")
+	}
+}